@@ -0,0 +1,87 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+)
+
+func TestCachecluster(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create cachecluster id=my-cache engine=Redis type=cache.t2.micro subnetgroup=my-subnets securitygroups=sg-1234").
+			Mock(&elasticacheMock{
+				CreateCacheClusterFunc: func(param0 *elasticache.CreateCacheClusterInput) (*elasticache.CreateCacheClusterOutput, error) {
+					return &elasticache.CreateCacheClusterOutput{CacheCluster: &elasticache.CacheCluster{CacheClusterId: String("my-cache")}}, nil
+				},
+			}).ExpectInput("CreateCacheCluster", &elasticache.CreateCacheClusterInput{
+			CacheClusterId:       String("my-cache"),
+			Engine:               String("redis"),
+			CacheNodeType:        String("cache.t2.micro"),
+			NumCacheNodes:        Int64(1),
+			CacheSubnetGroupName: String("my-subnets"),
+			SecurityGroupIds:     []*string{String("sg-1234")},
+		}).ExpectCommandResult("my-cache").ExpectCalls("CreateCacheCluster").
+			ExpectRevert("delete cachecluster id=my-cache").Run(t)
+	})
+
+	t.Run("create with subnets", func(t *testing.T) {
+		Template("create cachecluster id=my-cache engine=memcached type=cache.t2.small nodes=3 subnets=[sub-1234,sub-5678]").
+			Mock(&elasticacheMock{
+				CreateCacheSubnetGroupFunc: func(param0 *elasticache.CreateCacheSubnetGroupInput) (*elasticache.CreateCacheSubnetGroupOutput, error) {
+					return nil, nil
+				},
+				CreateCacheClusterFunc: func(param0 *elasticache.CreateCacheClusterInput) (*elasticache.CreateCacheClusterOutput, error) {
+					return &elasticache.CreateCacheClusterOutput{CacheCluster: &elasticache.CacheCluster{CacheClusterId: String("my-cache")}}, nil
+				},
+			}).ExpectInput("CreateCacheSubnetGroup", &elasticache.CreateCacheSubnetGroupInput{
+			CacheSubnetGroupName:        String("my-cache"),
+			CacheSubnetGroupDescription: String("Subnet group of my-cache created by awless"),
+			SubnetIds:                   []*string{String("sub-1234"), String("sub-5678")},
+		}).ExpectInput("CreateCacheCluster", &elasticache.CreateCacheClusterInput{
+			CacheClusterId:       String("my-cache"),
+			Engine:               String("memcached"),
+			CacheNodeType:        String("cache.t2.small"),
+			NumCacheNodes:        Int64(3),
+			CacheSubnetGroupName: String("my-cache"),
+		}).ExpectCommandResult("my-cache").ExpectCalls("CreateCacheSubnetGroup", "CreateCacheCluster").
+			ExpectRevert("delete cachecluster id=my-cache\ncheck cachecluster id=my-cache state=not-found timeout=900\ndelete cachesubnetgroup name=my-cache").Run(t)
+	})
+
+	t.Run("create reusing subnet group with same subnets", func(t *testing.T) {
+		Template("create cachecluster id=my-cache engine=memcached type=cache.t2.small subnets=[sub-1234,sub-5678]").
+			Mock(&elasticacheMock{
+				CreateCacheSubnetGroupFunc: func(param0 *elasticache.CreateCacheSubnetGroupInput) (*elasticache.CreateCacheSubnetGroupOutput, error) {
+					return nil, awserr.New(elasticache.ErrCodeCacheSubnetGroupAlreadyExistsFault, "already exists", nil)
+				},
+				DescribeCacheSubnetGroupsFunc: func(param0 *elasticache.DescribeCacheSubnetGroupsInput) (*elasticache.DescribeCacheSubnetGroupsOutput, error) {
+					return &elasticache.DescribeCacheSubnetGroupsOutput{CacheSubnetGroups: []*elasticache.CacheSubnetGroup{
+						{CacheSubnetGroupName: String("my-cache"), Subnets: []*elasticache.Subnet{{SubnetIdentifier: String("sub-5678")}, {SubnetIdentifier: String("sub-1234")}}},
+					}}, nil
+				},
+				CreateCacheClusterFunc: func(param0 *elasticache.CreateCacheClusterInput) (*elasticache.CreateCacheClusterOutput, error) {
+					return &elasticache.CreateCacheClusterOutput{CacheCluster: &elasticache.CacheCluster{CacheClusterId: String("my-cache")}}, nil
+				},
+			}).IgnoreInput("CreateCacheSubnetGroup").
+			ExpectInput("DescribeCacheSubnetGroups", &elasticache.DescribeCacheSubnetGroupsInput{CacheSubnetGroupName: String("my-cache")}).
+			ExpectInput("CreateCacheCluster", &elasticache.CreateCacheClusterInput{
+				CacheClusterId:       String("my-cache"),
+				Engine:               String("memcached"),
+				CacheNodeType:        String("cache.t2.small"),
+				NumCacheNodes:        Int64(1),
+				CacheSubnetGroupName: String("my-cache"),
+			}).ExpectCommandResult("my-cache").ExpectCalls("CreateCacheSubnetGroup", "DescribeCacheSubnetGroups", "CreateCacheCluster").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete cachecluster id=my-cache snapshot=my-cache-final").
+			Mock(&elasticacheMock{
+				DeleteCacheClusterFunc: func(param0 *elasticache.DeleteCacheClusterInput) (*elasticache.DeleteCacheClusterOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteCacheCluster", &elasticache.DeleteCacheClusterInput{
+			CacheClusterId:          String("my-cache"),
+			FinalSnapshotIdentifier: String("my-cache-final"),
+		}).ExpectCalls("DeleteCacheCluster").Run(t)
+	})
+}
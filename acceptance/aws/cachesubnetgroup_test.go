@@ -0,0 +1,19 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+)
+
+func TestCachesubnetgroup(t *testing.T) {
+	t.Run("delete", func(t *testing.T) {
+		Template("delete cachesubnetgroup name=my-cache").
+			Mock(&elasticacheMock{
+				DeleteCacheSubnetGroupFunc: func(param0 *elasticache.DeleteCacheSubnetGroupInput) (*elasticache.DeleteCacheSubnetGroupOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteCacheSubnetGroup", &elasticache.DeleteCacheSubnetGroupInput{CacheSubnetGroupName: String("my-cache")}).
+			ExpectCalls("DeleteCacheSubnetGroup").Run(t)
+	})
+}
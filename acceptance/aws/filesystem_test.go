@@ -0,0 +1,40 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/efs"
+)
+
+func TestFilesystem(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create filesystem name=shared-data token=shared-data-token performancemode=maxio key=alias/prod").
+			Mock(&efsMock{
+				CreateFileSystemFunc: func(param0 *efs.CreateFileSystemInput) (*efs.FileSystemDescription, error) {
+					return &efs.FileSystemDescription{FileSystemId: String("fs-1234")}, nil
+				},
+				CreateTagsFunc: func(param0 *efs.CreateTagsInput) (*efs.CreateTagsOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("CreateFileSystem", &efs.CreateFileSystemInput{
+			CreationToken:   String("shared-data-token"),
+			PerformanceMode: String("maxIO"),
+			Encrypted:       Bool(true),
+			KmsKeyId:        String("alias/prod"),
+		}).ExpectInput("CreateTags", &efs.CreateTagsInput{
+			FileSystemId: String("fs-1234"),
+			Tags:         []*efs.Tag{{Key: String("Name"), Value: String("shared-data")}},
+		}).ExpectCommandResult("fs-1234").ExpectCalls("CreateFileSystem", "CreateTags").
+			ExpectRevert("delete filesystem id=fs-1234").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete filesystem id=fs-1234").
+			Mock(&efsMock{
+				DeleteFileSystemFunc: func(param0 *efs.DeleteFileSystemInput) (*efs.DeleteFileSystemOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteFileSystem", &efs.DeleteFileSystemInput{FileSystemId: String("fs-1234")}).
+			ExpectCalls("DeleteFileSystem").Run(t)
+	})
+}
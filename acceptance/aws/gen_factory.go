@@ -19,23 +19,30 @@ package awsat
 
 import (
 	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/wallix/awless/aws/spec"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
@@ -57,6 +64,12 @@ func NewAcceptanceFactory(mock interface{}, g cloud.GraphAPI, l ...*logger.Logge
 
 func (f *AcceptanceFactory) Build(key string) func() interface{} {
 	switch key {
+	case "acceptvpcpeering":
+		return func() interface{} {
+			cmd := awsspec.NewAcceptVpcpeering(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "attachalarm":
 		return func() interface{} {
 			cmd := awsspec.NewAttachAlarm(nil, f.Graph, f.Logger)
@@ -159,6 +172,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ecriface.ECRAPI))
 			return cmd
 		}
+	case "checkcachecluster":
+		return func() interface{} {
+			cmd := awsspec.NewCheckCachecluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
 	case "checkcertificate":
 		return func() interface{} {
 			cmd := awsspec.NewCheckCertificate(nil, f.Graph, f.Logger)
@@ -177,6 +196,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudfrontiface.CloudFrontAPI))
 			return cmd
 		}
+	case "checkfilesystem":
+		return func() interface{} {
+			cmd := awsspec.NewCheckFilesystem(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(efsiface.EFSAPI))
+			return cmd
+		}
 	case "checkinstance":
 		return func() interface{} {
 			cmd := awsspec.NewCheckInstance(nil, f.Graph, f.Logger)
@@ -189,6 +214,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(elbv2iface.ELBV2API))
 			return cmd
 		}
+	case "checkmounttarget":
+		return func() interface{} {
+			cmd := awsspec.NewCheckMounttarget(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(efsiface.EFSAPI))
+			return cmd
+		}
 	case "checknatgateway":
 		return func() interface{} {
 			cmd := awsspec.NewCheckNatgateway(nil, f.Graph, f.Logger)
@@ -201,6 +232,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "checkreplicationgroup":
+		return func() interface{} {
+			cmd := awsspec.NewCheckReplicationgroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
 	case "checkscalinggroup":
 		return func() interface{} {
 			cmd := awsspec.NewCheckScalinggroup(nil, f.Graph, f.Logger)
@@ -255,12 +292,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(applicationautoscalingiface.ApplicationAutoScalingAPI))
 			return cmd
 		}
+	case "createbackup":
+		return func() interface{} {
+			cmd := awsspec.NewCreateBackup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "createbucket":
 		return func() interface{} {
 			cmd := awsspec.NewCreateBucket(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(s3iface.S3API))
 			return cmd
 		}
+	case "createcachecluster":
+		return func() interface{} {
+			cmd := awsspec.NewCreateCachecluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
 	case "createcertificate":
 		return func() interface{} {
 			cmd := awsspec.NewCreateCertificate(nil, f.Graph, f.Logger)
@@ -303,6 +352,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createfilesystem":
+		return func() interface{} {
+			cmd := awsspec.NewCreateFilesystem(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(efsiface.EFSAPI))
+			return cmd
+		}
 	case "createfunction":
 		return func() interface{} {
 			cmd := awsspec.NewCreateFunction(nil, f.Graph, f.Logger)
@@ -315,6 +370,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "createhealthcheck":
+		return func() interface{} {
+			cmd := awsspec.NewCreateHealthcheck(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(route53iface.Route53API))
+			return cmd
+		}
 	case "createimage":
 		return func() interface{} {
 			cmd := awsspec.NewCreateImage(nil, f.Graph, f.Logger)
@@ -339,6 +400,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createkey":
+		return func() interface{} {
+			cmd := awsspec.NewCreateKey(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(kmsiface.KMSAPI))
+			return cmd
+		}
 	case "createkeypair":
 		return func() interface{} {
 			cmd := awsspec.NewCreateKeypair(nil, f.Graph, f.Logger)
@@ -375,6 +442,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "createmounttarget":
+		return func() interface{} {
+			cmd := awsspec.NewCreateMounttarget(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(efsiface.EFSAPI))
+			return cmd
+		}
 	case "createnatgateway":
 		return func() interface{} {
 			cmd := awsspec.NewCreateNatgateway(nil, f.Graph, f.Logger)
@@ -387,6 +460,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createparameter":
+		return func() interface{} {
+			cmd := awsspec.NewCreateParameter(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ssmiface.SSMAPI))
+			return cmd
+		}
 	case "createpolicy":
 		return func() interface{} {
 			cmd := awsspec.NewCreatePolicy(nil, f.Graph, f.Logger)
@@ -405,12 +484,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(route53iface.Route53API))
 			return cmd
 		}
+	case "createreplicationgroup":
+		return func() interface{} {
+			cmd := awsspec.NewCreateReplicationgroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
 	case "createrepository":
 		return func() interface{} {
 			cmd := awsspec.NewCreateRepository(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ecriface.ECRAPI))
 			return cmd
 		}
+	case "createrestapi":
+		return func() interface{} {
+			cmd := awsspec.NewCreateRestapi(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
 	case "createrole":
 		return func() interface{} {
 			cmd := awsspec.NewCreateRole(nil, f.Graph, f.Logger)
@@ -465,6 +556,18 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudformationiface.CloudFormationAPI))
 			return cmd
 		}
+	case "createstage":
+		return func() interface{} {
+			cmd := awsspec.NewCreateStage(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
+	case "createstatemachine":
+		return func() interface{} {
+			cmd := awsspec.NewCreateStatemachine(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sfniface.SFNAPI))
+			return cmd
+		}
 	case "createsubnet":
 		return func() interface{} {
 			cmd := awsspec.NewCreateSubnet(nil, f.Graph, f.Logger)
@@ -477,6 +580,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(snsiface.SNSAPI))
 			return cmd
 		}
+	case "createtable":
+		return func() interface{} {
+			cmd := awsspec.NewCreateTable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "createtag":
 		return func() interface{} {
 			cmd := awsspec.NewCreateTag(nil, f.Graph, f.Logger)
@@ -513,6 +622,18 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "createvpcendpoint":
+		return func() interface{} {
+			cmd := awsspec.NewCreateVpcendpoint(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "createvpcpeering":
+		return func() interface{} {
+			cmd := awsspec.NewCreateVpcpeering(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "createzone":
 		return func() interface{} {
 			cmd := awsspec.NewCreateZone(nil, f.Graph, f.Logger)
@@ -543,12 +664,30 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(applicationautoscalingiface.ApplicationAutoScalingAPI))
 			return cmd
 		}
+	case "deletebackup":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteBackup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "deletebucket":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteBucket(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(s3iface.S3API))
 			return cmd
 		}
+	case "deletecachecluster":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteCachecluster(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
+	case "deletecachesubnetgroup":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteCachesubnetgroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
 	case "deletecertificate":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteCertificate(nil, f.Graph, f.Logger)
@@ -597,6 +736,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deletefilesystem":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteFilesystem(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(efsiface.EFSAPI))
+			return cmd
+		}
 	case "deletefunction":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteFunction(nil, f.Graph, f.Logger)
@@ -609,6 +754,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "deletehealthcheck":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteHealthcheck(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(route53iface.Route53API))
+			return cmd
+		}
 	case "deleteimage":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteImage(nil, f.Graph, f.Logger)
@@ -633,6 +784,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deletekey":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteKey(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(kmsiface.KMSAPI))
+			return cmd
+		}
 	case "deletekeypair":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteKeypair(nil, f.Graph, f.Logger)
@@ -669,6 +826,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "deletemounttarget":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteMounttarget(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(efsiface.EFSAPI))
+			return cmd
+		}
 	case "deletenatgateway":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteNatgateway(nil, f.Graph, f.Logger)
@@ -681,6 +844,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deleteparameter":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteParameter(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ssmiface.SSMAPI))
+			return cmd
+		}
 	case "deletepolicy":
 		return func() interface{} {
 			cmd := awsspec.NewDeletePolicy(nil, f.Graph, f.Logger)
@@ -699,12 +868,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(route53iface.Route53API))
 			return cmd
 		}
+	case "deletereplicationgroup":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteReplicationgroup(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(elasticacheiface.ElastiCacheAPI))
+			return cmd
+		}
 	case "deleterepository":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteRepository(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(ecriface.ECRAPI))
 			return cmd
 		}
+	case "deleterestapi":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteRestapi(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
 	case "deleterole":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteRole(nil, f.Graph, f.Logger)
@@ -759,6 +940,18 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(cloudformationiface.CloudFormationAPI))
 			return cmd
 		}
+	case "deletestage":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteStage(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(apigatewayiface.APIGatewayAPI))
+			return cmd
+		}
+	case "deletestatemachine":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteStatemachine(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(sfniface.SFNAPI))
+			return cmd
+		}
 	case "deletesubnet":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteSubnet(nil, f.Graph, f.Logger)
@@ -771,6 +964,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(snsiface.SNSAPI))
 			return cmd
 		}
+	case "deletetable":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteTable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "deletetag":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteTag(nil, f.Graph, f.Logger)
@@ -807,6 +1006,18 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "deletevpcendpoint":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteVpcendpoint(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
+	case "deletevpcpeering":
+		return func() interface{} {
+			cmd := awsspec.NewDeleteVpcpeering(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ec2iface.EC2API))
+			return cmd
+		}
 	case "deletezone":
 		return func() interface{} {
 			cmd := awsspec.NewDeleteZone(nil, f.Graph, f.Logger)
@@ -1005,12 +1216,24 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "updatekey":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateKey(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(kmsiface.KMSAPI))
+			return cmd
+		}
 	case "updateloginprofile":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateLoginprofile(nil, f.Graph, f.Logger)
 			cmd.SetApi(f.Mock.(iamiface.IAMAPI))
 			return cmd
 		}
+	case "updateparameter":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateParameter(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(ssmiface.SSMAPI))
+			return cmd
+		}
 	case "updatepolicy":
 		return func() interface{} {
 			cmd := awsspec.NewUpdatePolicy(nil, f.Graph, f.Logger)
@@ -1053,6 +1276,12 @@ func (f *AcceptanceFactory) Build(key string) func() interface{} {
 			cmd.SetApi(f.Mock.(ec2iface.EC2API))
 			return cmd
 		}
+	case "updatetable":
+		return func() interface{} {
+			cmd := awsspec.NewUpdateTable(nil, f.Graph, f.Logger)
+			cmd.SetApi(f.Mock.(dynamodbiface.DynamoDBAPI))
+			return cmd
+		}
 	case "updatetargetgroup":
 		return func() interface{} {
 			cmd := awsspec.NewUpdateTargetgroup(nil, f.Graph, f.Logger)
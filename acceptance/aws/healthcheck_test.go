@@ -0,0 +1,46 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+func TestHealthcheck(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create healthcheck name=www-blue type=http ip=52.18.0.12 port=80 path=/health interval=10 threshold=2 callerreference=www-blue-ref").
+			Mock(&route53Mock{
+				CreateHealthCheckFunc: func(param0 *route53.CreateHealthCheckInput) (*route53.CreateHealthCheckOutput, error) {
+					return &route53.CreateHealthCheckOutput{HealthCheck: &route53.HealthCheck{Id: String("hc-1234")}}, nil
+				},
+				ChangeTagsForResourceFunc: func(param0 *route53.ChangeTagsForResourceInput) (*route53.ChangeTagsForResourceOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("CreateHealthCheck", &route53.CreateHealthCheckInput{
+			CallerReference: String("www-blue-ref"),
+			HealthCheckConfig: &route53.HealthCheckConfig{
+				Type:             String("HTTP"),
+				IPAddress:        String("52.18.0.12"),
+				Port:             Int64(80),
+				ResourcePath:     String("/health"),
+				RequestInterval:  Int64(10),
+				FailureThreshold: Int64(2),
+			},
+		}).ExpectInput("ChangeTagsForResource", &route53.ChangeTagsForResourceInput{
+			ResourceId:   String("hc-1234"),
+			ResourceType: String("healthcheck"),
+			AddTags:      []*route53.Tag{{Key: String("Name"), Value: String("www-blue")}},
+		}).ExpectCommandResult("hc-1234").ExpectCalls("CreateHealthCheck", "ChangeTagsForResource").
+			ExpectRevert("delete healthcheck id=hc-1234").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete healthcheck id=hc-1234").
+			Mock(&route53Mock{
+				DeleteHealthCheckFunc: func(param0 *route53.DeleteHealthCheckInput) (*route53.DeleteHealthCheckOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteHealthCheck", &route53.DeleteHealthCheckInput{HealthCheckId: String("hc-1234")}).
+			ExpectCalls("DeleteHealthCheck").Run(t)
+	})
+}
@@ -0,0 +1,78 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+func TestKey(t *testing.T) {
+	_, polFilePath, polClean := generateTmpFile("key policy content")
+	defer polClean()
+
+	t.Run("create", func(t *testing.T) {
+		Template("create key description='encrypts backups' alias=backups rotation=true policy-file="+polFilePath).
+			Mock(&kmsMock{
+				CreateKeyFunc: func(param0 *kms.CreateKeyInput) (*kms.CreateKeyOutput, error) {
+					return &kms.CreateKeyOutput{KeyMetadata: &kms.KeyMetadata{KeyId: String("1234abcd-12ab-34cd-56ef-1234567890ab")}}, nil
+				},
+				CreateAliasFunc: func(param0 *kms.CreateAliasInput) (*kms.CreateAliasOutput, error) {
+					return nil, nil
+				},
+				EnableKeyRotationFunc: func(param0 *kms.EnableKeyRotationInput) (*kms.EnableKeyRotationOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("CreateKey", &kms.CreateKeyInput{
+			Description: String("encrypts backups"),
+			Policy:      String("key policy content"),
+		}).ExpectInput("CreateAlias", &kms.CreateAliasInput{
+			AliasName:   String("alias/backups"),
+			TargetKeyId: String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+		}).ExpectInput("EnableKeyRotation", &kms.EnableKeyRotationInput{KeyId: String("1234abcd-12ab-34cd-56ef-1234567890ab")}).
+			ExpectCommandResult("1234abcd-12ab-34cd-56ef-1234567890ab").ExpectCalls("CreateKey", "CreateAlias", "EnableKeyRotation").
+			ExpectRevert("delete key id=1234abcd-12ab-34cd-56ef-1234567890ab").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update key id=1234abcd-12ab-34cd-56ef-1234567890ab description='old backups' enabled=false rotation=false").
+			Mock(&kmsMock{
+				UpdateKeyDescriptionFunc: func(param0 *kms.UpdateKeyDescriptionInput) (*kms.UpdateKeyDescriptionOutput, error) {
+					return nil, nil
+				},
+				DisableKeyFunc: func(param0 *kms.DisableKeyInput) (*kms.DisableKeyOutput, error) {
+					return nil, nil
+				},
+				DisableKeyRotationFunc: func(param0 *kms.DisableKeyRotationInput) (*kms.DisableKeyRotationOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("UpdateKeyDescription", &kms.UpdateKeyDescriptionInput{
+			KeyId:       String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+			Description: String("old backups"),
+		}).ExpectInput("DisableKey", &kms.DisableKeyInput{KeyId: String("1234abcd-12ab-34cd-56ef-1234567890ab")}).
+			ExpectInput("DisableKeyRotation", &kms.DisableKeyRotationInput{KeyId: String("1234abcd-12ab-34cd-56ef-1234567890ab")}).
+			ExpectCalls("UpdateKeyDescription", "DisableKey", "DisableKeyRotation").Run(t)
+
+		Template("update key id=1234abcd-12ab-34cd-56ef-1234567890ab policy-file="+polFilePath).
+			Mock(&kmsMock{
+				PutKeyPolicyFunc: func(param0 *kms.PutKeyPolicyInput) (*kms.PutKeyPolicyOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("PutKeyPolicy", &kms.PutKeyPolicyInput{
+			KeyId:      String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+			PolicyName: String("default"),
+			Policy:     String("key policy content"),
+		}).ExpectCalls("PutKeyPolicy").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete key id=1234abcd-12ab-34cd-56ef-1234567890ab pending-days=7").
+			Mock(&kmsMock{
+				ScheduleKeyDeletionFunc: func(param0 *kms.ScheduleKeyDeletionInput) (*kms.ScheduleKeyDeletionOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("ScheduleKeyDeletion", &kms.ScheduleKeyDeletionInput{
+			KeyId:               String("1234abcd-12ab-34cd-56ef-1234567890ab"),
+			PendingWindowInDays: Int64(7),
+		}).ExpectCalls("ScheduleKeyDeletion").Run(t)
+	})
+}
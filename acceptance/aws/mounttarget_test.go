@@ -0,0 +1,33 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/efs"
+)
+
+func TestMounttarget(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create mounttarget filesystem=fs-1234 subnet=sub-1234 securitygroups=[sg-1234,sg-5678]").
+			Mock(&efsMock{
+				CreateMountTargetFunc: func(param0 *efs.CreateMountTargetInput) (*efs.MountTargetDescription, error) {
+					return &efs.MountTargetDescription{MountTargetId: String("fsmt-1234")}, nil
+				},
+			}).ExpectInput("CreateMountTarget", &efs.CreateMountTargetInput{
+			FileSystemId:   String("fs-1234"),
+			SubnetId:       String("sub-1234"),
+			SecurityGroups: []*string{String("sg-1234"), String("sg-5678")},
+		}).ExpectCommandResult("fsmt-1234").ExpectCalls("CreateMountTarget").
+			ExpectRevert("delete mounttarget id=fsmt-1234").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete mounttarget id=fsmt-1234").
+			Mock(&efsMock{
+				DeleteMountTargetFunc: func(param0 *efs.DeleteMountTargetInput) (*efs.DeleteMountTargetOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteMountTarget", &efs.DeleteMountTargetInput{MountTargetId: String("fsmt-1234")}).
+			ExpectCalls("DeleteMountTarget").Run(t)
+	})
+}
@@ -0,0 +1,80 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+func TestParameter(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create parameter name=/golden/ami/latest type=string value=ami-1234abcd description='latest golden image'").
+			Mock(&ssmMock{
+				PutParameterFunc: func(param0 *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					return &ssm.PutParameterOutput{Version: Int64(1)}, nil
+				},
+			}).ExpectInput("PutParameter", &ssm.PutParameterInput{
+			Name:        String("/golden/ami/latest"),
+			Type:        String("String"),
+			Value:       String("ami-1234abcd"),
+			Description: String("latest golden image"),
+		}).ExpectCommandResult("/golden/ami/latest").ExpectCalls("PutParameter").
+			ExpectRevert("delete parameter name=/golden/ami/latest").Run(t)
+
+		Template("create parameter name=/net/subnets type=StringList value=subnet-1,subnet-2").
+			Mock(&ssmMock{
+				PutParameterFunc: func(param0 *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					return &ssm.PutParameterOutput{Version: Int64(1)}, nil
+				},
+			}).ExpectInput("PutParameter", &ssm.PutParameterInput{
+			Name:  String("/net/subnets"),
+			Type:  String("StringList"),
+			Value: String("subnet-1,subnet-2"),
+		}).ExpectCommandResult("/net/subnets").ExpectCalls("PutParameter").Run(t)
+
+		Template("create parameter name=/prod/db/password type=SecureString value=s3cr3t key=alias/prod").
+			Mock(&ssmMock{
+				PutParameterFunc: func(param0 *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					return &ssm.PutParameterOutput{Version: Int64(1)}, nil
+				},
+			}).ExpectInput("PutParameter", &ssm.PutParameterInput{
+			Name:  String("/prod/db/password"),
+			Type:  String("SecureString"),
+			Value: String("s3cr3t"),
+			KeyId: String("alias/prod"),
+		}).ExpectCommandResult("/prod/db/password").ExpectCalls("PutParameter").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update parameter name=/prod/db/password value=n3ws3cr3t").
+			Mock(&ssmMock{
+				DescribeParametersFunc: func(param0 *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+					return &ssm.DescribeParametersOutput{Parameters: []*ssm.ParameterMetadata{
+						{Name: String("/prod/db/password"), Type: String("SecureString"), KeyId: String("alias/prod"), Description: String("db password")},
+					}}, nil
+				},
+				PutParameterFunc: func(param0 *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					return &ssm.PutParameterOutput{Version: Int64(2)}, nil
+				},
+			}).ExpectInput("DescribeParameters", &ssm.DescribeParametersInput{
+			Filters: []*ssm.ParametersFilter{{Key: String("Name"), Values: []*string{String("/prod/db/password")}}},
+		}).ExpectInput("PutParameter", &ssm.PutParameterInput{
+			Name:        String("/prod/db/password"),
+			Type:        String("SecureString"),
+			Value:       String("n3ws3cr3t"),
+			KeyId:       String("alias/prod"),
+			Description: String("db password"),
+			Overwrite:   Bool(true),
+		}).ExpectCalls("DescribeParameters", "PutParameter").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete parameter name=/golden/ami/latest").
+			Mock(&ssmMock{
+				DeleteParameterFunc: func(param0 *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteParameter", &ssm.DeleteParameterInput{Name: String("/golden/ami/latest")}).
+			ExpectCalls("DeleteParameter").Run(t)
+	})
+}
@@ -38,6 +38,36 @@ func TestRecord(t *testing.T) {
 			}).ExpectCommandResult("change-id").ExpectCalls("ChangeResourceRecordSets").Run(t)
 		})
 
+		t.Run("with routing policy", func(t *testing.T) {
+			Template("create record zone=/hostedzone/1234ABCD name=www.domain.com type=A value=1.2.3.4 ttl=60 set-identifier=primary failover=primary healthcheck=hc-1234").
+				Mock(&route53Mock{
+					ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("change-id")}}, nil
+					},
+				}).ExpectInput("ChangeResourceRecordSets", &route53.ChangeResourceRecordSetsInput{
+				HostedZoneId: String("/hostedzone/1234ABCD"),
+				ChangeBatch: &route53.ChangeBatch{
+					Changes: []*route53.Change{
+						{
+							ResourceRecordSet: &route53.ResourceRecordSet{
+								ResourceRecords: []*route53.ResourceRecord{
+									{Value: String("1.2.3.4")},
+								},
+								Name:          String("www.domain.com"),
+								Type:          String("A"),
+								TTL:           Int64(60),
+								SetIdentifier: String("primary"),
+								Failover:      String("PRIMARY"),
+								HealthCheckId: String("hc-1234"),
+							},
+							Action: String("CREATE"),
+						},
+					},
+				},
+			}).ExpectCommandResult("change-id").ExpectCalls("ChangeResourceRecordSets").
+				ExpectRevert("delete record failover=primary healthcheck=hc-1234 name=www.domain.com set-identifier=primary ttl=60 type=A values=1.2.3.4 zone=/hostedzone/1234ABCD").Run(t)
+		})
+
 		t.Run("with values", func(t *testing.T) {
 			Template("create record zone=/hostedzone/1234ABCD name=my.domain2.com type=A values=1.2.3.4,2.3.4.5 ttl=60 comment='this is my localhost record'").
 				Mock(&route53Mock{
@@ -70,10 +100,20 @@ func TestRecord(t *testing.T) {
 	t.Run("update", func(t *testing.T) {
 		Template("update record zone=/hostedzone/1234ABCD name=myupdated.domain.com type=A value=127.0.0.1 ttl=60").
 			Mock(&route53Mock{
+				ListResourceRecordSetsFunc: func(param0 *route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error) {
+					return &route53.ListResourceRecordSetsOutput{ResourceRecordSets: []*route53.ResourceRecordSet{
+						{Name: String("myupdated.domain.com."), Type: String("A"), TTL: Int64(300), ResourceRecords: []*route53.ResourceRecord{{Value: String("10.0.0.1")}, {Value: String("10.0.0.2")}}},
+					}}, nil
+				},
 				ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
 					return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("updated-id")}}, nil
 				},
-			}).ExpectInput("ChangeResourceRecordSets", &route53.ChangeResourceRecordSetsInput{
+			}).ExpectInput("ListResourceRecordSets", &route53.ListResourceRecordSetsInput{
+			HostedZoneId:    String("/hostedzone/1234ABCD"),
+			StartRecordName: String("myupdated.domain.com"),
+			StartRecordType: String("A"),
+			MaxItems:        String("1"),
+		}).ExpectInput("ChangeResourceRecordSets", &route53.ChangeResourceRecordSetsInput{
 			HostedZoneId: String("/hostedzone/1234ABCD"),
 			ChangeBatch: &route53.ChangeBatch{
 				Changes: []*route53.Change{
@@ -90,7 +130,66 @@ func TestRecord(t *testing.T) {
 					},
 				},
 			},
-		}).ExpectCommandResult("updated-id").ExpectCalls("ChangeResourceRecordSets").Run(t)
+		}).ExpectCommandResult("name=myupdated.domain.com ttl=300 type=A values=[10.0.0.1,10.0.0.2] zone=/hostedzone/1234ABCD").
+			ExpectCalls("ListResourceRecordSets", "ChangeResourceRecordSets").
+			ExpectRevert("update record name=myupdated.domain.com ttl=300 type=A values=[10.0.0.1,10.0.0.2] zone=/hostedzone/1234ABCD").Run(t)
+	})
+
+	t.Run("update routing policy", func(t *testing.T) {
+		Template("update record zone=/hostedzone/1234ABCD name=www.domain.com type=A value=1.2.3.4 ttl=60 set-identifier=blue weight=0").
+			Mock(&route53Mock{
+				ListResourceRecordSetsFunc: func(param0 *route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error) {
+					return &route53.ListResourceRecordSetsOutput{ResourceRecordSets: []*route53.ResourceRecordSet{
+						{Name: String("www.domain.com."), Type: String("A"), TTL: Int64(60), SetIdentifier: String("blue"), Weight: Int64(100), HealthCheckId: String("hc-1234"), ResourceRecords: []*route53.ResourceRecord{{Value: String("1.2.3.4")}}},
+					}}, nil
+				},
+				ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+					return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("updated-id")}}, nil
+				},
+			}).ExpectInput("ListResourceRecordSets", &route53.ListResourceRecordSetsInput{
+			HostedZoneId:          String("/hostedzone/1234ABCD"),
+			StartRecordName:       String("www.domain.com"),
+			StartRecordType:       String("A"),
+			StartRecordIdentifier: String("blue"),
+			MaxItems:              String("1"),
+		}).ExpectInput("ChangeResourceRecordSets", &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: String("/hostedzone/1234ABCD"),
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: []*route53.Change{
+					{
+						ResourceRecordSet: &route53.ResourceRecordSet{
+							ResourceRecords: []*route53.ResourceRecord{
+								{Value: String("1.2.3.4")},
+							},
+							Name:          String("www.domain.com"),
+							Type:          String("A"),
+							TTL:           Int64(60),
+							SetIdentifier: String("blue"),
+							Weight:        Int64(0),
+						},
+						Action: String("UPSERT"),
+					},
+				},
+			},
+		}).ExpectCommandResult("healthcheck=hc-1234 name=www.domain.com set-identifier=blue ttl=60 type=A values=[1.2.3.4] weight=100 zone=/hostedzone/1234ABCD").
+			ExpectCalls("ListResourceRecordSets", "ChangeResourceRecordSets").
+			ExpectRevert("update record healthcheck=hc-1234 name=www.domain.com set-identifier=blue ttl=60 type=A values=[1.2.3.4] weight=100 zone=/hostedzone/1234ABCD").Run(t)
+	})
+
+	t.Run("update creating the record", func(t *testing.T) {
+		Template("update record zone=/hostedzone/1234ABCD name=www.domain.com type=A value=5.6.7.8 ttl=60 set-identifier=green weight=10").
+			Mock(&route53Mock{
+				ListResourceRecordSetsFunc: func(param0 *route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error) {
+					return &route53.ListResourceRecordSetsOutput{ResourceRecordSets: []*route53.ResourceRecordSet{
+						{Name: String("xyz.domain.com."), Type: String("A"), TTL: Int64(60), ResourceRecords: []*route53.ResourceRecord{{Value: String("1.2.3.4")}}},
+					}}, nil
+				},
+				ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+					return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("updated-id")}}, nil
+				},
+			}).IgnoreInput("ListResourceRecordSets", "ChangeResourceRecordSets").ExpectCommandResult("").
+			ExpectCalls("ListResourceRecordSets", "ChangeResourceRecordSets").
+			ExpectRevert("delete record name=www.domain.com set-identifier=green ttl=60 type=A values=5.6.7.8 weight=10 zone=/hostedzone/1234ABCD").Run(t)
 	})
 
 	t.Run("delete", func(t *testing.T) {
@@ -125,6 +224,41 @@ func TestRecord(t *testing.T) {
 			}).Graph(g).ExpectCommandResult("deleted-id").ExpectCalls("ChangeResourceRecordSets").Run(t)
 		})
 
+		t.Run("from awless-id with routing policy", func(t *testing.T) {
+			g := graph.NewGraph()
+			zone := resourcetest.Zone("/hostedzone/1234ABCD").Build()
+			record := resourcetest.Record("awls-rec").Prop(properties.Name, "www.domain.com").Prop(properties.Type, "A").Prop(properties.Records, []string{"1.2.3.4"}).Prop(properties.TTL, 60).
+				Prop(properties.Set, "blue").Prop(properties.Weight, 100).Prop(properties.HealthCheck, "hc-1234").Build()
+			g.AddResource(zone, record)
+			g.AddParentRelation(zone, record)
+			Template("delete record id=awls-rec").
+				Mock(&route53Mock{
+					ChangeResourceRecordSetsFunc: func(param0 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return &route53.ChangeResourceRecordSetsOutput{ChangeInfo: &route53.ChangeInfo{Id: String("deleted-id")}}, nil
+					},
+				}).ExpectInput("ChangeResourceRecordSets", &route53.ChangeResourceRecordSetsInput{
+				HostedZoneId: String("/hostedzone/1234ABCD"),
+				ChangeBatch: &route53.ChangeBatch{
+					Changes: []*route53.Change{
+						{
+							ResourceRecordSet: &route53.ResourceRecordSet{
+								ResourceRecords: []*route53.ResourceRecord{
+									{Value: String("1.2.3.4")},
+								},
+								Name:          String("www.domain.com"),
+								Type:          String("A"),
+								TTL:           Int64(60),
+								SetIdentifier: String("blue"),
+								Weight:        Int64(100),
+								HealthCheckId: String("hc-1234"),
+							},
+							Action: String("DELETE"),
+						},
+					},
+				},
+			}).Graph(g).ExpectCommandResult("deleted-id").ExpectCalls("ChangeResourceRecordSets").Run(t)
+		})
+
 		t.Run("with all params", func(t *testing.T) {
 			Template("delete record zone=/hostedzone/1234ABCD name=mydeleted.domain.com type=A value=127.0.0.1 ttl=60").
 				Mock(&route53Mock{
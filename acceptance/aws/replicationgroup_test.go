@@ -0,0 +1,52 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+)
+
+func TestReplicationgroup(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create replicationgroup id=my-redis description=sessions engine=redis type=cache.t2.small failover=true subnetgroup=my-subnets").
+			Mock(&elasticacheMock{
+				CreateReplicationGroupFunc: func(param0 *elasticache.CreateReplicationGroupInput) (*elasticache.CreateReplicationGroupOutput, error) {
+					return &elasticache.CreateReplicationGroupOutput{ReplicationGroup: &elasticache.ReplicationGroup{ReplicationGroupId: String("my-redis")}}, nil
+				},
+			}).ExpectInput("CreateReplicationGroup", &elasticache.CreateReplicationGroupInput{
+			ReplicationGroupId:          String("my-redis"),
+			ReplicationGroupDescription: String("sessions"),
+			Engine:                      String("redis"),
+			CacheNodeType:               String("cache.t2.small"),
+			AutomaticFailoverEnabled:    Bool(true),
+			NumCacheClusters:            Int64(2),
+			CacheSubnetGroupName:        String("my-subnets"),
+		}).ExpectCommandResult("my-redis").ExpectCalls("CreateReplicationGroup").
+			ExpectRevert("delete replicationgroup id=my-redis").Run(t)
+	})
+
+	t.Run("create from primary", func(t *testing.T) {
+		Template("create replicationgroup id=my-redis description=sessions primary=my-cache").
+			Mock(&elasticacheMock{
+				CreateReplicationGroupFunc: func(param0 *elasticache.CreateReplicationGroupInput) (*elasticache.CreateReplicationGroupOutput, error) {
+					return &elasticache.CreateReplicationGroupOutput{ReplicationGroup: &elasticache.ReplicationGroup{ReplicationGroupId: String("my-redis")}}, nil
+				},
+			}).ExpectInput("CreateReplicationGroup", &elasticache.CreateReplicationGroupInput{
+			ReplicationGroupId:          String("my-redis"),
+			ReplicationGroupDescription: String("sessions"),
+			PrimaryClusterId:            String("my-cache"),
+		}).ExpectCommandResult("my-redis").ExpectCalls("CreateReplicationGroup").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete replicationgroup id=my-redis retain-primary=true").
+			Mock(&elasticacheMock{
+				DeleteReplicationGroupFunc: func(param0 *elasticache.DeleteReplicationGroupInput) (*elasticache.DeleteReplicationGroupOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteReplicationGroup", &elasticache.DeleteReplicationGroupInput{
+			ReplicationGroupId:   String("my-redis"),
+			RetainPrimaryCluster: Bool(true),
+		}).ExpectCalls("DeleteReplicationGroup").Run(t)
+	})
+}
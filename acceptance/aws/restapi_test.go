@@ -0,0 +1,89 @@
+package awsat
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigateway"
+)
+
+func TestRestapi(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create restapi name=shop-api description='shop backend' version=v1").
+			Mock(&apigatewayMock{
+				CreateRestApiFunc: func(param0 *apigateway.CreateRestApiInput) (*apigateway.RestApi, error) {
+					return &apigateway.RestApi{Id: String("api-1234")}, nil
+				},
+			}).ExpectInput("CreateRestApi", &apigateway.CreateRestApiInput{
+			Name:        String("shop-api"),
+			Description: String("shop backend"),
+			Version:     String("v1"),
+		}).ExpectCommandResult("api-1234").ExpectCalls("CreateRestApi").
+			ExpectRevert("delete restapi id=api-1234").Run(t)
+	})
+
+	t.Run("create with lambda proxy", func(t *testing.T) {
+		fnArn := "arn:aws:lambda:eu-west-1:123456789012:function:shop"
+		uri := "arn:aws:apigateway:eu-west-1:lambda:path/2015-03-31/functions/arn:aws:lambda:eu-west-1:123456789012:function:shop/invocations"
+		var methodResources, integrationResources []string
+		Template("create restapi name=shop-api function="+fnArn+" role=arn:aws:iam::123456789012:role/invoke").
+			Mock(&apigatewayMock{
+				CreateRestApiFunc: func(param0 *apigateway.CreateRestApiInput) (*apigateway.RestApi, error) {
+					return &apigateway.RestApi{Id: String("api-1234")}, nil
+				},
+				GetResourcesFunc: func(param0 *apigateway.GetResourcesInput) (*apigateway.GetResourcesOutput, error) {
+					return &apigateway.GetResourcesOutput{Items: []*apigateway.Resource{{Id: String("root-1234"), Path: String("/")}}}, nil
+				},
+				CreateResourceFunc: func(param0 *apigateway.CreateResourceInput) (*apigateway.Resource, error) {
+					return &apigateway.Resource{Id: String("proxy-1234"), Path: String("/{proxy+}")}, nil
+				},
+				PutMethodFunc: func(param0 *apigateway.PutMethodInput) (*apigateway.Method, error) {
+					if got, want := StringValue(param0.HttpMethod), "ANY"; got != want {
+						t.Fatalf("got %s, want %s", got, want)
+					}
+					methodResources = append(methodResources, StringValue(param0.ResourceId))
+					return nil, nil
+				},
+				PutIntegrationFunc: func(param0 *apigateway.PutIntegrationInput) (*apigateway.Integration, error) {
+					if got, want := StringValue(param0.Type), "AWS_PROXY"; got != want {
+						t.Fatalf("got %s, want %s", got, want)
+					}
+					if got, want := StringValue(param0.Uri), uri; got != want {
+						t.Fatalf("got %s, want %s", got, want)
+					}
+					if got, want := StringValue(param0.Credentials), "arn:aws:iam::123456789012:role/invoke"; got != want {
+						t.Fatalf("got %s, want %s", got, want)
+					}
+					integrationResources = append(integrationResources, StringValue(param0.ResourceId))
+					return nil, nil
+				},
+			}).ExpectInput("CreateRestApi", &apigateway.CreateRestApiInput{
+			Name: String("shop-api"),
+		}).ExpectInput("GetResources", &apigateway.GetResourcesInput{
+			RestApiId: String("api-1234"),
+		}).ExpectInput("CreateResource", &apigateway.CreateResourceInput{
+			RestApiId: String("api-1234"),
+			ParentId:  String("root-1234"),
+			PathPart:  String("{proxy+}"),
+		}).IgnoreInput("PutMethod", "PutIntegration").ExpectCommandResult("api-1234").
+			ExpectCalls("CreateRestApi", "GetResources", "CreateResource", "PutMethod", "PutMethod", "PutIntegration", "PutIntegration").
+			ExpectRevert("delete restapi id=api-1234").Run(t)
+
+		if got, want := methodResources, []string{"root-1234", "proxy-1234"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+		if got, want := integrationResources, []string{"root-1234", "proxy-1234"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete restapi id=api-1234").
+			Mock(&apigatewayMock{
+				DeleteRestApiFunc: func(param0 *apigateway.DeleteRestApiInput) (*apigateway.DeleteRestApiOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteRestApi", &apigateway.DeleteRestApiInput{RestApiId: String("api-1234")}).
+			ExpectCalls("DeleteRestApi").Run(t)
+	})
+}
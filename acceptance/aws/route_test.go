@@ -20,6 +20,19 @@ func TestRoute(t *testing.T) {
 		}).ExpectCalls("CreateRoute").Run(t)
 	})
 
+	t.Run("create to peering", func(t *testing.T) {
+		Template("create route table=table-id cidr=10.1.0.0/16 peering=pcx-id").
+			Mock(&ec2Mock{
+				CreateRouteFunc: func(param0 *ec2.CreateRouteInput) (*ec2.CreateRouteOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("CreateRoute", &ec2.CreateRouteInput{
+			RouteTableId:           String("table-id"),
+			DestinationCidrBlock:   String("10.1.0.0/16"),
+			VpcPeeringConnectionId: String("pcx-id"),
+		}).ExpectCalls("CreateRoute").ExpectRevert("delete route cidr=10.1.0.0/16 table=table-id").Run(t)
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		Template("delete route table=table-id cidr=10.0.0.0/16").
 			Mock(&ec2Mock{
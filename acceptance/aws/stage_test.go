@@ -0,0 +1,41 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+)
+
+func TestStage(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create stage restapi=api-1234 name=prod description='production' deployment-description='first release'").
+			Mock(&apigatewayMock{
+				GetStageFunc: func(param0 *apigateway.GetStageInput) (*apigateway.Stage, error) {
+					return nil, awserr.New(apigateway.ErrCodeNotFoundException, "Invalid stage identifier specified", nil)
+				},
+				CreateDeploymentFunc: func(param0 *apigateway.CreateDeploymentInput) (*apigateway.Deployment, error) {
+					return &apigateway.Deployment{Id: String("dep-1234")}, nil
+				},
+			}).ExpectInput("GetStage", &apigateway.GetStageInput{
+			RestApiId: String("api-1234"),
+			StageName: String("prod"),
+		}).ExpectInput("CreateDeployment", &apigateway.CreateDeploymentInput{
+			RestApiId:        String("api-1234"),
+			StageName:        String("prod"),
+			StageDescription: String("production"),
+			Description:      String("first release"),
+		}).ExpectCommandResult("prod").ExpectCalls("GetStage", "CreateDeployment").
+			ExpectRevert("delete stage name=prod restapi=api-1234").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete stage restapi=api-1234 name=prod").
+			Mock(&apigatewayMock{
+				DeleteStageFunc: func(param0 *apigateway.DeleteStageInput) (*apigateway.DeleteStageOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteStage", &apigateway.DeleteStageInput{RestApiId: String("api-1234"), StageName: String("prod")}).
+			ExpectCalls("DeleteStage").Run(t)
+	})
+}
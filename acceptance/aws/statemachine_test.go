@@ -0,0 +1,36 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+)
+
+func TestStatemachine(t *testing.T) {
+	definition := `{"StartAt":"Order","States":{"Order":{"Type":"Task","Resource":"arn:aws:lambda:eu-west-1:123456789012:function:order","End":true}}}`
+
+	t.Run("create", func(t *testing.T) {
+		Template("create statemachine name=orders role=arn:aws:iam::123456789012:role/sfn definition={def}").
+			Mock(&sfnMock{
+				CreateStateMachineFunc: func(param0 *sfn.CreateStateMachineInput) (*sfn.CreateStateMachineOutput, error) {
+					return &sfn.CreateStateMachineOutput{StateMachineArn: String("arn:aws:states:eu-west-1:123456789012:stateMachine:orders")}, nil
+				},
+			}).ExpectInput("CreateStateMachine", &sfn.CreateStateMachineInput{
+			Name:       String("orders"),
+			RoleArn:    String("arn:aws:iam::123456789012:role/sfn"),
+			Definition: String(definition),
+		}).Fillers(map[string]string{"def": definition}).
+			ExpectCommandResult("arn:aws:states:eu-west-1:123456789012:stateMachine:orders").ExpectCalls("CreateStateMachine").
+			ExpectRevert("delete statemachine id=arn:aws:states:eu-west-1:123456789012:stateMachine:orders").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete statemachine id=arn:aws:states:eu-west-1:123456789012:stateMachine:orders").
+			Mock(&sfnMock{
+				DeleteStateMachineFunc: func(param0 *sfn.DeleteStateMachineInput) (*sfn.DeleteStateMachineOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteStateMachine", &sfn.DeleteStateMachineInput{StateMachineArn: String("arn:aws:states:eu-west-1:123456789012:stateMachine:orders")}).
+			ExpectCalls("DeleteStateMachine").Run(t)
+	})
+}
@@ -0,0 +1,88 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+func TestTable(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create table name=events hash-key=device:S range-key=timestamp:n read-capacity=5 write-capacity=10 stream=new_and_old_images").
+			Mock(&dynamodbMock{
+				CreateTableFunc: func(param0 *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error) {
+					return &dynamodb.CreateTableOutput{TableDescription: &dynamodb.TableDescription{TableName: String("events")}}, nil
+				},
+			}).ExpectInput("CreateTable", &dynamodb.CreateTableInput{
+			TableName: String("events"),
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: String("device"), KeyType: String("HASH")},
+				{AttributeName: String("timestamp"), KeyType: String("RANGE")},
+			},
+			AttributeDefinitions: []*dynamodb.AttributeDefinition{
+				{AttributeName: String("device"), AttributeType: String("S")},
+				{AttributeName: String("timestamp"), AttributeType: String("N")},
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughput{ReadCapacityUnits: Int64(5), WriteCapacityUnits: Int64(10)},
+			StreamSpecification:   &dynamodb.StreamSpecification{StreamEnabled: Bool(true), StreamViewType: String("NEW_AND_OLD_IMAGES")},
+		}).
+			ExpectCommandResult("events").ExpectCalls("CreateTable").ExpectRevert("delete table name=events").Run(t)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		Template("update table name=events read-capacity=20 write-capacity=30").
+			Mock(&dynamodbMock{
+				UpdateTableFunc: func(param0 *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+					return &dynamodb.UpdateTableOutput{TableDescription: &dynamodb.TableDescription{TableName: String("events")}}, nil
+				},
+			}).ExpectInput("UpdateTable", &dynamodb.UpdateTableInput{
+			TableName:             String("events"),
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughput{ReadCapacityUnits: Int64(20), WriteCapacityUnits: Int64(30)},
+		}).
+			ExpectCommandResult("events").ExpectCalls("UpdateTable").Run(t)
+
+		Template("update table name=events stream=disabled").
+			Mock(&dynamodbMock{
+				UpdateTableFunc: func(param0 *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+					return &dynamodb.UpdateTableOutput{TableDescription: &dynamodb.TableDescription{TableName: String("events")}}, nil
+				},
+			}).ExpectInput("UpdateTable", &dynamodb.UpdateTableInput{
+			TableName:           String("events"),
+			StreamSpecification: &dynamodb.StreamSpecification{StreamEnabled: Bool(false)},
+		}).
+			ExpectCommandResult("events").ExpectCalls("UpdateTable").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete table name=events").
+			Mock(&dynamodbMock{
+				DeleteTableFunc: func(param0 *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteTable", &dynamodb.DeleteTableInput{TableName: String("events")}).
+			ExpectCalls("DeleteTable").Run(t)
+	})
+}
+
+func TestBackup(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create backup table=events name=events-backup").
+			Mock(&dynamodbMock{
+				CreateBackupFunc: func(param0 *dynamodb.CreateBackupInput) (*dynamodb.CreateBackupOutput, error) {
+					return &dynamodb.CreateBackupOutput{BackupDetails: &dynamodb.BackupDetails{BackupArn: String("arn:aws:dynamodb:eu-west-1:123456789012:table/events/backup/01234567890123-abcdefgh")}}, nil
+				},
+			}).ExpectInput("CreateBackup", &dynamodb.CreateBackupInput{TableName: String("events"), BackupName: String("events-backup")}).
+			ExpectCommandResult("arn:aws:dynamodb:eu-west-1:123456789012:table/events/backup/01234567890123-abcdefgh").ExpectCalls("CreateBackup").
+			ExpectRevert("delete backup arn=arn:aws:dynamodb:eu-west-1:123456789012:table/events/backup/01234567890123-abcdefgh").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete backup arn=arn:aws:dynamodb:eu-west-1:123456789012:table/events/backup/01234567890123-abcdefgh").
+			Mock(&dynamodbMock{
+				DeleteBackupFunc: func(param0 *dynamodb.DeleteBackupInput) (*dynamodb.DeleteBackupOutput, error) {
+					return nil, nil
+				},
+			}).ExpectInput("DeleteBackup", &dynamodb.DeleteBackupInput{BackupArn: String("arn:aws:dynamodb:eu-west-1:123456789012:table/events/backup/01234567890123-abcdefgh")}).
+			ExpectCalls("DeleteBackup").Run(t)
+	})
+}
@@ -0,0 +1,47 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestVpcendpoint(t *testing.T) {
+	t.Run("create gateway", func(t *testing.T) {
+		Template("create vpcendpoint vpc=vpc-1234 type=gateway service=com.amazonaws.eu-west-1.s3 routetables=[rtb-1,rtb-2]").Mock(&ec2Mock{
+			CreateVpcEndpointFunc: func(input *ec2.CreateVpcEndpointInput) (*ec2.CreateVpcEndpointOutput, error) {
+				return &ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2.VpcEndpoint{VpcEndpointId: String("vpce-new")}}, nil
+			}}).
+			ExpectInput("CreateVpcEndpoint", &ec2.CreateVpcEndpointInput{
+				VpcId:           String("vpc-1234"),
+				VpcEndpointType: String("Gateway"),
+				ServiceName:     String("com.amazonaws.eu-west-1.s3"),
+				RouteTableIds:   []*string{String("rtb-1"), String("rtb-2")},
+			}).ExpectCommandResult("vpce-new").ExpectCalls("CreateVpcEndpoint").
+			ExpectRevert("delete vpcendpoint id=vpce-new").Run(t)
+	})
+
+	t.Run("create interface", func(t *testing.T) {
+		Template("create vpcendpoint vpc=vpc-1234 type=Interface service=com.amazonaws.eu-west-1.ec2 subnets=[sub-1,sub-2] securitygroups=sg-1 private-dns=true").Mock(&ec2Mock{
+			CreateVpcEndpointFunc: func(input *ec2.CreateVpcEndpointInput) (*ec2.CreateVpcEndpointOutput, error) {
+				return &ec2.CreateVpcEndpointOutput{VpcEndpoint: &ec2.VpcEndpoint{VpcEndpointId: String("vpce-new")}}, nil
+			}}).
+			ExpectInput("CreateVpcEndpoint", &ec2.CreateVpcEndpointInput{
+				VpcId:             String("vpc-1234"),
+				VpcEndpointType:   String("Interface"),
+				ServiceName:       String("com.amazonaws.eu-west-1.ec2"),
+				SubnetIds:         []*string{String("sub-1"), String("sub-2")},
+				SecurityGroupIds:  []*string{String("sg-1")},
+				PrivateDnsEnabled: Bool(true),
+			}).ExpectCommandResult("vpce-new").ExpectCalls("CreateVpcEndpoint").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete vpcendpoint id=vpce-1234").Mock(&ec2Mock{
+			DeleteVpcEndpointsFunc: func(input *ec2.DeleteVpcEndpointsInput) (*ec2.DeleteVpcEndpointsOutput, error) {
+				return &ec2.DeleteVpcEndpointsOutput{}, nil
+			}}).
+			ExpectInput("DeleteVpcEndpoints", &ec2.DeleteVpcEndpointsInput{VpcEndpointIds: []*string{String("vpce-1234")}}).
+			ExpectCalls("DeleteVpcEndpoints").Run(t)
+	})
+}
@@ -0,0 +1,66 @@
+package awsat
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestVpcpeering(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		Template("create vpcpeering vpc=vpc-1234 peer-vpc=vpc-5678").Mock(&ec2Mock{
+			CreateVpcPeeringConnectionFunc: func(input *ec2.CreateVpcPeeringConnectionInput) (*ec2.CreateVpcPeeringConnectionOutput, error) {
+				return &ec2.CreateVpcPeeringConnectionOutput{VpcPeeringConnection: &ec2.VpcPeeringConnection{VpcPeeringConnectionId: String("pcx-new")}}, nil
+			}}).
+			ExpectInput("CreateVpcPeeringConnection", &ec2.CreateVpcPeeringConnectionInput{
+				VpcId:     String("vpc-1234"),
+				PeerVpcId: String("vpc-5678"),
+			}).ExpectCommandResult("pcx-new").ExpectCalls("CreateVpcPeeringConnection").
+			ExpectRevert("delete vpcpeering id=pcx-new").Run(t)
+	})
+
+	t.Run("create cross account and region with name", func(t *testing.T) {
+		Template("create vpcpeering vpc=vpc-1234 peer-vpc=vpc-5678 peer-owner=123456789012 peer-region=eu-west-3 name=mypeering").Mock(&ec2Mock{
+			CreateVpcPeeringConnectionFunc: func(input *ec2.CreateVpcPeeringConnectionInput) (*ec2.CreateVpcPeeringConnectionOutput, error) {
+				return &ec2.CreateVpcPeeringConnectionOutput{VpcPeeringConnection: &ec2.VpcPeeringConnection{VpcPeeringConnectionId: String("pcx-new")}}, nil
+			},
+			CreateTagsRequestFunc: func(input *ec2.CreateTagsInput) (req *request.Request, output *ec2.CreateTagsOutput) {
+				output = &ec2.CreateTagsOutput{}
+				req = request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{}, input, output)
+				return
+			}}).
+			ExpectInput("CreateVpcPeeringConnection", &ec2.CreateVpcPeeringConnectionInput{
+				VpcId:       String("vpc-1234"),
+				PeerVpcId:   String("vpc-5678"),
+				PeerOwnerId: String("123456789012"),
+				PeerRegion:  String("eu-west-3"),
+			}).
+			ExpectInput("CreateTagsRequest", &ec2.CreateTagsInput{
+				Resources: []*string{String("pcx-new")},
+				Tags: []*ec2.Tag{
+					{Key: String("Name"), Value: String("mypeering")},
+				},
+			}).ExpectCommandResult("pcx-new").ExpectCalls("CreateVpcPeeringConnection", "CreateTagsRequest").Run(t)
+	})
+
+	t.Run("accept", func(t *testing.T) {
+		Template("accept vpcpeering id=pcx-1234").Mock(&ec2Mock{
+			AcceptVpcPeeringConnectionFunc: func(input *ec2.AcceptVpcPeeringConnectionInput) (*ec2.AcceptVpcPeeringConnectionOutput, error) {
+				return &ec2.AcceptVpcPeeringConnectionOutput{VpcPeeringConnection: &ec2.VpcPeeringConnection{VpcPeeringConnectionId: String("pcx-1234")}}, nil
+			}}).
+			ExpectInput("AcceptVpcPeeringConnection", &ec2.AcceptVpcPeeringConnectionInput{VpcPeeringConnectionId: String("pcx-1234")}).
+			ExpectCommandResult("pcx-1234").ExpectCalls("AcceptVpcPeeringConnection").Run(t)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		Template("delete vpcpeering id=pcx-1234").Mock(&ec2Mock{
+			DeleteVpcPeeringConnectionFunc: func(input *ec2.DeleteVpcPeeringConnectionInput) (*ec2.DeleteVpcPeeringConnectionOutput, error) {
+				return &ec2.DeleteVpcPeeringConnectionOutput{}, nil
+			}}).
+			ExpectInput("DeleteVpcPeeringConnection", &ec2.DeleteVpcPeeringConnectionInput{VpcPeeringConnectionId: String("pcx-1234")}).
+			ExpectCalls("DeleteVpcPeeringConnection").Run(t)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	"runtime"
@@ -134,6 +135,44 @@ func allRegions() []string {
 	return regions
 }
 
+// MatchRegions returns the sorted regions matching the given region names or glob patterns (ex: eu-*).
+// 'all' matches all the regions of the standard AWS partition.
+func MatchRegions(patterns ...string) ([]string, error) {
+	unique := make(map[string]bool)
+	for _, pattern := range patterns {
+		var matched bool
+		switch {
+		case pattern == "all":
+			for id := range endpoints.AwsPartition().Regions() {
+				unique[id] = true
+			}
+			matched = true
+		case strings.ContainsAny(pattern, "*?["):
+			for _, r := range allRegions() {
+				if ok, err := path.Match(pattern, r); err != nil {
+					return nil, fmt.Errorf("invalid region pattern '%s': %s", pattern, err)
+				} else if ok {
+					unique[r] = true
+					matched = true
+				}
+			}
+		case IsValidRegion(pattern):
+			unique[pattern] = true
+			matched = true
+		}
+		if !matched {
+			return nil, fmt.Errorf("no region matching '%s'", pattern)
+		}
+	}
+
+	var regions []string
+	for r := range unique {
+		regions = append(regions, r)
+	}
+	sort.Strings(regions)
+	return regions, nil
+}
+
 func IsValidProfile(given string) bool {
 	return stringInSlice(given, AllProfiles())
 }
@@ -96,3 +96,37 @@ func TestInstanceTypeValid(t *testing.T) {
 		}
 	}
 }
+
+func TestMatchRegions(t *testing.T) {
+	regions, err := MatchRegions("eu-west-*", "us-east-1", "eu-west-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := stringInSlice("eu-west-2", regions), true; got != want {
+		t.Fatalf("got %t, want %t", got, want)
+	}
+	if got, want := stringInSlice("eu-central-1", regions), false; got != want {
+		t.Fatalf("got %t, want %t", got, want)
+	}
+	if got, want := regions[len(regions)-1], "us-east-1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	all, err := MatchRegions("all")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := stringInSlice("cn-north-1", all), false; got != want {
+		t.Fatalf("got %t, want %t", got, want)
+	}
+	if got, want := stringInSlice("ap-south-1", all), true; got != want {
+		t.Fatalf("got %t, want %t", got, want)
+	}
+
+	if _, err := MatchRegions("mars-*"); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err := MatchRegions("eu-test"); err == nil {
+		t.Fatal("expected error")
+	}
+}
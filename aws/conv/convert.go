@@ -31,21 +31,29 @@ import (
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/graph"
@@ -89,6 +97,10 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Snapshot, awssdk.StringValue(ss.SnapshotId))
 	case *ec2.NetworkInterface:
 		res = graph.InitResource(cloud.NetworkInterface, awssdk.StringValue(ss.NetworkInterfaceId))
+	case *ec2.VpcPeeringConnection:
+		res = graph.InitResource(cloud.VpcPeering, awssdk.StringValue(ss.VpcPeeringConnectionId))
+	case *ec2.VpcEndpoint:
+		res = graph.InitResource(cloud.VpcEndpoint, awssdk.StringValue(ss.VpcEndpointId))
 	// Loadbalancer
 	case *elb.LoadBalancerDescription:
 		res = graph.InitResource(cloud.ClassicLoadBalancer, awssdk.StringValue(ss.LoadBalancerName))
@@ -103,6 +115,11 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Database, awssdk.StringValue(ss.DBInstanceIdentifier))
 	case *rds.DBSubnetGroup:
 		res = graph.InitResource(cloud.DbSubnetGroup, awssdk.StringValue(ss.DBSubnetGroupArn))
+		// Cache
+	case *elasticache.CacheCluster:
+		res = graph.InitResource(cloud.CacheCluster, awssdk.StringValue(ss.CacheClusterId))
+	case *elasticache.ReplicationGroup:
+		res = graph.InitResource(cloud.ReplicationGroup, awssdk.StringValue(ss.ReplicationGroupId))
 		// Autoscaling
 	case *autoscaling.LaunchConfiguration:
 		res = graph.InitResource(cloud.LaunchConfiguration, awssdk.StringValue(ss.LaunchConfigurationARN))
@@ -148,6 +165,11 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Bucket, awssdk.StringValue(ss.Name))
 	case *s3.Object:
 		res = graph.InitResource(cloud.S3Object, awssdk.StringValue(ss.Key))
+	// EFS
+	case *efs.FileSystemDescription:
+		res = graph.InitResource(cloud.FileSystem, awssdk.StringValue(ss.FileSystemId))
+	case *efs.MountTargetDescription:
+		res = graph.InitResource(cloud.MountTarget, awssdk.StringValue(ss.MountTargetId))
 	//SNS
 	case *sns.Subscription:
 		res = graph.InitResource(cloud.Subscription, awssdk.StringValue(ss.Endpoint))
@@ -158,22 +180,47 @@ func InitResource(source interface{}) (*graph.Resource, error) {
 		res = graph.InitResource(cloud.Zone, awssdk.StringValue(ss.Id))
 	case *route53.ResourceRecordSet:
 		id := HashFields(awssdk.StringValue(ss.Name), awssdk.StringValue(ss.Type))
+		if ss.SetIdentifier != nil { // records of a weighted, latency or failover routing policy share their name and type
+			id = HashFields(awssdk.StringValue(ss.Name), awssdk.StringValue(ss.Type), awssdk.StringValue(ss.SetIdentifier))
+		}
 		res = graph.InitResource(cloud.Record, id)
+	case *route53.HealthCheck:
+		res = graph.InitResource(cloud.HealthCheck, awssdk.StringValue(ss.Id))
 		// Lambda
 	case *lambda.FunctionConfiguration:
 		res = graph.InitResource(cloud.Function, awssdk.StringValue(ss.FunctionArn))
+	case *apigateway.RestApi:
+		res = graph.InitResource(cloud.RestApi, awssdk.StringValue(ss.Id))
+	case *apigateway.Stage:
+		id := HashFields(awssdk.StringValue(ss.DeploymentId), awssdk.StringValue(ss.StageName))
+		res = graph.InitResource(cloud.Stage, id)
+	case *sfn.DescribeStateMachineOutput:
+		res = graph.InitResource(cloud.StateMachine, awssdk.StringValue(ss.StateMachineArn))
 		// Monitoring
 	case *cloudwatch.Metric:
 		id := HashFields(awssdk.StringValue(ss.Namespace), awssdk.StringValue(ss.MetricName))
 		res = graph.InitResource(cloud.Metric, id)
 	case *cloudwatch.MetricAlarm:
 		res = graph.InitResource(cloud.Alarm, awssdk.StringValue(ss.AlarmArn))
+		// Logs
+	case *cloudwatchlogs.LogGroup:
+		res = graph.InitResource(cloud.LogGroup, awssdk.StringValue(ss.LogGroupName))
 		// cdn
 	case *cloudfront.DistributionSummary:
 		res = graph.InitResource(cloud.Distribution, awssdk.StringValue(ss.Id))
 		// cloudformation
 	case *cloudformation.Stack:
 		res = graph.InitResource(cloud.Stack, awssdk.StringValue(ss.StackId))
+		// dynamodb
+	case *dynamodb.TableDescription:
+		res = graph.InitResource(cloud.Table, awssdk.StringValue(ss.TableName))
+	case *dynamodb.GlobalTableDescription:
+		res = graph.InitResource(cloud.GlobalTable, awssdk.StringValue(ss.GlobalTableArn))
+		// security
+	case *kms.KeyMetadata:
+		res = graph.InitResource(cloud.Key, awssdk.StringValue(ss.KeyId))
+	case *ssm.ParameterMetadata:
+		res = graph.InitResource(cloud.Parameter, awssdk.StringValue(ss.Name))
 	default:
 		return nil, fmt.Errorf("Unknown type of resource %T", source)
 	}
@@ -319,6 +366,18 @@ var extractTimeWithZSuffixFn = func(i interface{}) (interface{}, error) {
 	return nil, fmt.Errorf("extract time: expected time pointer, got: %T", i)
 }
 
+// Extract time given as a number of milliseconds since epoch (i.e. CloudWatch Logs)
+var extractMillisecondsTimeFn = func(i interface{}) (interface{}, error) {
+	ms, ok := i.(*int64)
+	if !ok {
+		return nil, fmt.Errorf("extract time: expected int64 pointer, got: %T", i)
+	}
+	if ms == nil {
+		return nil, nil
+	}
+	return time.Unix(0, awssdk.Int64Value(ms)*int64(time.Millisecond)).UTC(), nil
+}
+
 var extractIpPermissionSliceFn = func(i interface{}) (interface{}, error) {
 	if _, ok := i.([]*ec2.IpPermission); !ok {
 		return nil, fmt.Errorf("extract ip permission: not a permission slice but a %T", i)
@@ -634,6 +693,32 @@ var extractStackParametersFn = func(i interface{}) (interface{}, error) {
 	return keyVals, nil
 }
 
+var extractKeySchemaFn = func(keyType string) transformFn {
+	return func(i interface{}) (interface{}, error) {
+		if _, ok := i.([]*dynamodb.KeySchemaElement); !ok {
+			return nil, fmt.Errorf("extract key schema: not a key schema slice but a %T", i)
+		}
+		for _, key := range i.([]*dynamodb.KeySchemaElement) {
+			if awssdk.StringValue(key.KeyType) == keyType {
+				return awssdk.StringValue(key.AttributeName), nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+// Tables without provisioned throughput are billed on demand
+var extractBillingModeFn = func(i interface{}) (interface{}, error) {
+	throughput, ok := i.(*dynamodb.ProvisionedThroughputDescription)
+	if !ok {
+		return nil, fmt.Errorf("extract billing mode: not a provisioned throughput but a %T", i)
+	}
+	if awssdk.Int64Value(throughput.ReadCapacityUnits) > 0 || awssdk.Int64Value(throughput.WriteCapacityUnits) > 0 {
+		return "PROVISIONED", nil
+	}
+	return "PAY_PER_REQUEST", nil
+}
+
 var extractContainersImagesFn = func(i interface{}) (interface{}, error) {
 	if _, ok := i.([]*ecs.ContainerDefinition); !ok {
 		return nil, fmt.Errorf("extract containers images, not a container definition slice but a %T", i)
@@ -83,9 +83,11 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.State:            {name: "State", transform: extractValueFn},
 		properties.Size:             {name: "Size", transform: extractValueFn},
 		properties.Encrypted:        {name: "Encrypted", transform: extractValueFn},
+		properties.EncryptionKey:    {name: "KmsKeyId", transform: extractValueFn},
 		properties.Created:          {name: "CreateTime", transform: extractTimeFn},
 		properties.AvailabilityZone: {name: "AvailabilityZone", transform: extractValueFn},
 		properties.Instances:        {name: "Attachments", transform: extractStringSliceValues("InstanceId")},
+		properties.IOPS:             {name: "Iops", transform: extractValueFn},
 		properties.Tags:             {name: "Tags", transform: extractTagsFn},
 	},
 	cloud.Snapshot: {
@@ -179,6 +181,31 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Vpc:              {name: "VpcId", transform: extractValueFn},
 		properties.Tags:             {name: "TagSet", transform: extractTagsFn},
 	},
+	cloud.VpcPeering: {
+		properties.Name:         {name: "Tags", transform: extractTagFn("Name")},
+		properties.Vpc:          {name: "RequesterVpcInfo", transform: extractFieldFn("VpcId")},
+		properties.CIDR:         {name: "RequesterVpcInfo", transform: extractFieldFn("CidrBlock")},
+		properties.Owner:        {name: "RequesterVpcInfo", transform: extractFieldFn("OwnerId")},
+		properties.PeerVpc:      {name: "AccepterVpcInfo", transform: extractFieldFn("VpcId")},
+		properties.PeerCIDR:     {name: "AccepterVpcInfo", transform: extractFieldFn("CidrBlock")},
+		properties.PeerOwner:    {name: "AccepterVpcInfo", transform: extractFieldFn("OwnerId")},
+		properties.PeerRegion:   {name: "AccepterVpcInfo", transform: extractFieldFn("Region")},
+		properties.State:        {name: "Status", transform: extractFieldFn("Code")},
+		properties.StateMessage: {name: "Status", transform: extractFieldFn("Message")},
+		properties.Tags:         {name: "Tags", transform: extractTagsFn},
+	},
+	cloud.VpcEndpoint: {
+		properties.Vpc:               {name: "VpcId", transform: extractValueFn},
+		properties.Service:           {name: "ServiceName", transform: extractValueFn},
+		properties.Type:              {name: "VpcEndpointType", transform: extractValueFn},
+		properties.State:             {name: "State", transform: extractValueFn},
+		properties.Created:           {name: "CreationTimestamp", transform: extractTimeFn},
+		properties.Document:          {name: "PolicyDocument", transform: extractValueFn},
+		properties.RouteTables:       {name: "RouteTableIds", transform: extractStringPointerSliceValues},
+		properties.Subnets:           {name: "SubnetIds", transform: extractStringPointerSliceValues},
+		properties.SecurityGroups:    {name: "Groups", transform: extractStringSliceValues("GroupId")},
+		properties.NetworkInterfaces: {name: "NetworkInterfaceIds", transform: extractStringPointerSliceValues},
+	},
 	// LoadBalancer
 	cloud.LoadBalancer: {
 		properties.Name:              {name: "LoadBalancerName", transform: extractValueFn},
@@ -258,6 +285,7 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.EngineVersion:             {name: "EngineVersion", transform: extractValueFn},
 		properties.Created:                   {name: "InstanceCreateTime", transform: extractValueFn},
 		properties.IOPS:                      {name: "Iops", transform: extractValueFn},
+		properties.EncryptionKey:             {name: "KmsKeyId", transform: extractValueFn},
 		properties.LatestRestorableTime:      {name: "LatestRestorableTime", transform: extractValueFn},
 		properties.License:                   {name: "LicenseModel", transform: extractValueFn},
 		properties.Username:                  {name: "MasterUsername", transform: extractValueFn},
@@ -283,6 +311,34 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Subnets:     {name: "Subnets", transform: extractStringSliceValues("SubnetIdentifier")},
 		properties.Vpc:         {name: "VpcId", transform: extractValueFn},
 	},
+	//Cache
+	cloud.CacheCluster: {
+		properties.AutoUpgrade:              {name: "AutoMinorVersionUpgrade", transform: extractValueFn},
+		properties.Encrypted:                {name: "AtRestEncryptionEnabled", transform: extractValueFn},
+		properties.Created:                  {name: "CacheClusterCreateTime", transform: extractTimeFn},
+		properties.State:                    {name: "CacheClusterStatus", transform: extractValueFn},
+		properties.Class:                    {name: "CacheNodeType", transform: extractValueFn},
+		properties.CacheSubnetGroup:         {name: "CacheSubnetGroupName", transform: extractValueFn},
+		properties.PublicDNS:                {name: "ConfigurationEndpoint", transform: extractFieldFn("Address")},
+		properties.Port:                     {name: "ConfigurationEndpoint", transform: extractFieldFn("Port")},
+		properties.Engine:                   {name: "Engine", transform: extractValueFn},
+		properties.EngineVersion:            {name: "EngineVersion", transform: extractValueFn},
+		properties.NodeCount:                {name: "NumCacheNodes", transform: extractValueFn},
+		properties.AvailabilityZone:         {name: "PreferredAvailabilityZone", transform: extractValueFn},
+		properties.PreferredMaintenanceDate: {name: "PreferredMaintenanceWindow", transform: extractValueFn},
+		properties.ReplicationGroup:         {name: "ReplicationGroupId", transform: extractValueFn},
+		properties.SecurityGroups:           {name: "SecurityGroups", transform: extractStringSliceValues("SecurityGroupId")},
+	},
+	cloud.ReplicationGroup: {
+		properties.Encrypted:   {name: "AtRestEncryptionEnabled", transform: extractValueFn},
+		properties.Failover:    {name: "AutomaticFailover", transform: extractValueFn},
+		properties.Class:       {name: "CacheNodeType", transform: extractValueFn},
+		properties.PublicDNS:   {name: "ConfigurationEndpoint", transform: extractFieldFn("Address")},
+		properties.Port:        {name: "ConfigurationEndpoint", transform: extractFieldFn("Port")},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Members:     {name: "MemberClusters", transform: extractValueFn},
+		properties.State:       {name: "Status", transform: extractValueFn},
+	},
 	//Autoscaling
 	cloud.LaunchConfiguration: {
 		properties.Name:           {name: "LaunchConfigurationName", transform: extractValueFn},
@@ -425,6 +481,25 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Size:     {name: "Size", transform: extractValueFn},
 		properties.Class:    {name: "StorageClass", transform: extractValueFn},
 	},
+	//EFS
+	cloud.FileSystem: {
+		properties.Created:          {name: "CreationTime", transform: extractTimeFn},
+		properties.Encrypted:        {name: "Encrypted", transform: extractValueFn},
+		properties.EncryptionKey:    {name: "KmsKeyId", transform: extractValueFn},
+		properties.State:            {name: "LifeCycleState", transform: extractValueFn},
+		properties.Name:             {name: "Name", transform: extractValueFn},
+		properties.MountTargetCount: {name: "NumberOfMountTargets", transform: extractValueFn},
+		properties.Owner:            {name: "OwnerId", transform: extractValueFn},
+		properties.PerformanceMode:  {name: "PerformanceMode", transform: extractValueFn},
+		properties.Size:             {name: "SizeInBytes", transform: extractFieldFn("Value")},
+	},
+	cloud.MountTarget: {
+		properties.FileSystem: {name: "FileSystemId", transform: extractValueFn},
+		properties.PrivateIP:  {name: "IpAddress", transform: extractValueFn},
+		properties.State:      {name: "LifeCycleState", transform: extractValueFn},
+		properties.Owner:      {name: "OwnerId", transform: extractValueFn},
+		properties.Subnet:     {name: "SubnetId", transform: extractValueFn},
+	},
 	//Notification
 	cloud.Subscription: {
 		properties.Endpoint: {name: "Endpoint", transform: extractValueFn},
@@ -460,6 +535,17 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Type:                  {name: "Type", transform: extractValueFn},
 		properties.Weight:                {name: "Weight", transform: extractValueFn},
 	},
+	cloud.HealthCheck: {
+		properties.Type:                    {name: "HealthCheckConfig", transform: extractFieldFn("Type")},
+		properties.PublicIP:                {name: "HealthCheckConfig", transform: extractFieldFn("IPAddress")},
+		properties.Host:                    {name: "HealthCheckConfig", transform: extractFieldFn("FullyQualifiedDomainName")},
+		properties.Port:                    {name: "HealthCheckConfig", transform: extractFieldFn("Port")},
+		properties.CheckPath:               {name: "HealthCheckConfig", transform: extractFieldFn("ResourcePath")},
+		properties.CheckInterval:           {name: "HealthCheckConfig", transform: extractFieldFn("RequestInterval")},
+		properties.UnhealthyThresholdCount: {name: "HealthCheckConfig", transform: extractFieldFn("FailureThreshold")},
+		properties.CallerReference:         {name: "CallerReference", transform: extractValueFn},
+		properties.Version:                 {name: "HealthCheckVersion", transform: extractValueFn},
+	},
 	// Lambda
 	cloud.Function: {
 		properties.Arn:         {name: "FunctionArn", transform: extractValueFn},
@@ -475,6 +561,25 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Timeout:     {name: "Timeout", transform: extractValueFn},
 		properties.Version:     {name: "Version", transform: extractValueFn},
 	},
+	cloud.RestApi: {
+		properties.Name:        {name: "Name", transform: extractValueFn},
+		properties.Created:     {name: "CreatedDate", transform: extractTimeFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Version:     {name: "Version", transform: extractValueFn},
+	},
+	cloud.Stage: {
+		properties.Name:        {name: "StageName", transform: extractValueFn},
+		properties.Created:     {name: "CreatedDate", transform: extractTimeFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Modified:    {name: "LastUpdatedDate", transform: extractTimeFn},
+	},
+	cloud.StateMachine: {
+		properties.Arn:     {name: "StateMachineArn", transform: extractValueFn},
+		properties.Name:    {name: "Name", transform: extractValueFn},
+		properties.Created: {name: "CreationDate", transform: extractTimeFn},
+		properties.Role:    {name: "RoleArn", transform: extractValueFn},
+		properties.State:   {name: "Status", transform: extractValueFn},
+	},
 	// Monitoring
 	cloud.Metric: {
 		properties.Name:       {name: "MetricName", transform: extractValueFn},
@@ -495,6 +600,15 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Updated:                 {name: "StateUpdatedTimestamp", transform: extractValueFn},
 		properties.State:                   {name: "StateValue", transform: extractValueFn},
 	},
+	// Logs
+	cloud.LogGroup: {
+		properties.Name:          {name: "LogGroupName", transform: extractValueFn},
+		properties.Arn:           {name: "Arn", transform: extractValueFn},
+		properties.Created:       {name: "CreationTime", transform: extractMillisecondsTimeFn},
+		properties.RetentionDays: {name: "RetentionInDays", transform: extractValueFn},
+		properties.Size:          {name: "StoredBytes", transform: extractValueFn},
+		properties.EncryptionKey: {name: "KmsKeyId", transform: extractValueFn},
+	},
 	// CDN
 	cloud.Distribution: {
 		properties.Arn:                {name: "ARN", transform: extractValueFn},
@@ -530,6 +644,46 @@ var awsResourcesDef = map[string]map[string]*propertyTransform{
 		properties.Parameters:      {name: "Parameters", transform: extractStackParametersFn},
 		properties.Outputs:         {name: "Outputs", transform: extractStackOutputsFn},
 	},
+	// DynamoDB
+	cloud.Table: {
+		properties.Name:          {name: "TableName", transform: extractValueFn},
+		properties.Arn:           {name: "TableArn", transform: extractValueFn},
+		properties.BillingMode:   {name: "ProvisionedThroughput", transform: extractBillingModeFn},
+		properties.Created:       {name: "CreationDateTime", transform: extractValueFn},
+		properties.HashKey:       {name: "KeySchema", transform: extractKeySchemaFn("HASH")},
+		properties.ItemCount:     {name: "ItemCount", transform: extractValueFn},
+		properties.RangeKey:      {name: "KeySchema", transform: extractKeySchemaFn("RANGE")},
+		properties.ReadCapacity:  {name: "ProvisionedThroughput", transform: extractFieldFn("ReadCapacityUnits")},
+		properties.Size:          {name: "TableSizeBytes", transform: extractValueFn},
+		properties.State:         {name: "TableStatus", transform: extractValueFn},
+		properties.StreamArn:     {name: "LatestStreamArn", transform: extractValueFn},
+		properties.WriteCapacity: {name: "ProvisionedThroughput", transform: extractFieldFn("WriteCapacityUnits")},
+	},
+	cloud.GlobalTable: {
+		properties.Name:    {name: "GlobalTableName", transform: extractValueFn},
+		properties.Arn:     {name: "GlobalTableArn", transform: extractValueFn},
+		properties.Created: {name: "CreationDateTime", transform: extractValueFn},
+		properties.Regions: {name: "ReplicationGroup", transform: extractStringSliceValues("RegionName")},
+		properties.State:   {name: "GlobalTableStatus", transform: extractValueFn},
+	},
+	// Security
+	cloud.Key: {
+		properties.Arn:         {name: "Arn", transform: extractValueFn},
+		properties.Created:     {name: "CreationDate", transform: extractTimeFn},
+		properties.Description: {name: "Description", transform: extractValueFn},
+		properties.Enabled:     {name: "Enabled", transform: extractValueFn},
+		properties.KeyManager:  {name: "KeyManager", transform: extractValueFn},
+		properties.State:       {name: "KeyState", transform: extractValueFn},
+		properties.KeyUsage:    {name: "KeyUsage", transform: extractValueFn},
+	},
+	cloud.Parameter: {
+		properties.Name:          {name: "Name", transform: extractValueFn},
+		properties.Type:          {name: "Type", transform: extractValueFn},
+		properties.Description:   {name: "Description", transform: extractValueFn},
+		properties.EncryptionKey: {name: "KeyId", transform: extractValueFn},
+		properties.Modified:      {name: "LastModifiedDate", transform: extractTimeFn},
+		properties.Version:       {name: "Version", transform: extractValueAsStringFn},
+	},
 	//Queue
 	cloud.Queue: {}, //Manually set
 }
@@ -37,6 +37,9 @@ func exampleDoc(key string) string {
 }
 
 var cliExamplesDoc = map[string][]string{
+	"accept.vpcpeering": {
+		"awless accept vpcpeering id=pcx-1234abcd",
+	},
 	"attach.alarm":         {},
 	"attach.containertask": {},
 	"attach.elasticip": {
@@ -75,21 +78,33 @@ var cliExamplesDoc = map[string][]string{
 	"authenticate.registry": {
 		"awless authenticate registry",
 	},
+	"check.cachecluster": {
+		"awless check cachecluster id=my-cache state=available timeout=900",
+	},
 	"check.database": {
 		"awless check database id=@mydb state=available timeout=180",
 	},
 	"check.distribution": {
 		"awless check distribution id=@mydistr state=Deployed timeout=180",
 	},
+	"check.filesystem": {
+		"awless check filesystem id=fs-1234abcd state=available timeout=180",
+	},
 	"check.instance": {
 		"awless check instance id=@redis state=running timeout=180",
 	},
 	"check.loadbalancer": {
 		"awless check loadbalancer id=@myloadb state=active timeout=180",
 	},
+	"check.mounttarget": {
+		"awless check mounttarget id=fsmt-1234abcd state=available timeout=180",
+	},
 	"check.natgateway": {
 		"awless check natgateway id=@mynat state=active timeout=180",
 	},
+	"check.replicationgroup": {
+		"awless check replicationgroup id=my-redis state=available timeout=900",
+	},
 	"check.scalinggroup": {
 		"awless check scalinggroup name=MyAutoScalingGroup count=3 timeout=180",
 	},
@@ -117,9 +132,16 @@ var cliExamplesDoc = map[string][]string{
 	"create.appscalingtarget": {
 		"awless create appscalingtarget dimension=ecs:service:DesiredCount min-capacity=2 max-capacity=10 resource=service/my-ecs-cluster/my-service-deployment-nameource role=arn:aws:iam::519101889238:role/ecsAutoscaleRole service-namespace=ecs",
 	},
+	"create.backup": {
+		"awless create backup table=users name=users-backup",
+	},
 	"create.bucket": {
 		"awless create bucket name=my-bucket-name acl=public-read",
 	},
+	"create.cachecluster": {
+		"awless create cachecluster id=my-cache engine=redis type=cache.t2.micro subnets=[@sub1,@sub2] securitygroups=@cache-sg",
+		"awless create cachecluster id=sessions engine=memcached type=cache.t2.small nodes=3 subnetgroup=my-cache-subnets",
+	},
 	"create.containercluster": {
 		"awless create containercluster name=mycluster",
 	},
@@ -140,10 +162,18 @@ var cliExamplesDoc = map[string][]string{
 	"create.elasticip": {
 		"awless create elasticip domain=vpc",
 	},
+	"create.filesystem": {
+		"awless create filesystem name=shared-data",
+		"awless create filesystem name=secrets performancemode=maxIO key=alias/prod",
+	},
 	"create.function": {},
 	"create.group": {
 		"awless create name=admins",
 	},
+	"create.healthcheck": {
+		"awless create healthcheck name=www-blue type=HTTP ip=52.18.0.12 port=80 path=/health",
+		"awless create healthcheck name=www-green type=HTTPS fqdn=green.example.com port=443 interval=10 threshold=2",
+	},
 	"create.image": {
 		"awless create image instance=@my-instance-name name=redis-image description='redis prod image'",
 		"awless create image instance=i-0ee436a45561c04df name=redis-image reboot=true",
@@ -164,85 +194,149 @@ var cliExamplesDoc = map[string][]string{
 		"awless create instance distro=amazonlinux:::::instance-store",
 		"awless create instance distro=amazonlinux:amzn2",
 	},
-	"create.instanceprofile":     {},
-	"create.internetgateway":     {},
+	"create.instanceprofile": {},
+	"create.internetgateway": {},
+	"create.key": {
+		"awless create key description='encrypts backups' alias=backups rotation=true",
+		"awless create key description='production secrets' policy-file=./key-policy.json",
+	},
 	"create.keypair":             {},
 	"create.launchconfiguration": {},
 	"create.listener":            {},
 	"create.loadbalancer":        {},
 	"create.loginprofile":        {},
-	"create.natgateway":          {},
-	"create.policy":              {},
-	"create.queue":               {},
-	"create.record":              {},
-	"create.repository":          {},
-	"create.role":                {},
-	"create.route":               {},
-	"create.routetable":          {},
-	"create.s3object":            {},
-	"create.scalinggroup":        {},
-	"create.scalingpolicy":       {},
+	"create.mounttarget": {
+		"awless create mounttarget filesystem=@shared-data subnet=@my-subnet securitygroups=@nfs-sg",
+	},
+	"create.natgateway": {},
+	"create.parameter": {
+		"awless create parameter name=/golden/ami/latest type=String value=ami-1234abcd",
+		"awless create parameter name=/prod/db/password type=SecureString value=s3cr3t key=alias/prod",
+		"awless create instance image={ssm:/golden/ami/latest} name=web",
+	},
+	"create.policy": {},
+	"create.queue":  {},
+	"create.record": {
+		"awless create record zone=Z1JZ7WHJ5N0C6M name=www.example.com type=A value=52.18.0.12 ttl=60",
+		"awless create record zone=Z1JZ7WHJ5N0C6M name=www.example.com type=A value=52.18.0.12 ttl=60 set-identifier=blue weight=90 healthcheck=@www-blue",
+		"awless create record zone=Z1JZ7WHJ5N0C6M name=www.example.com type=A value=52.18.0.13 ttl=60 set-identifier=backup failover=SECONDARY",
+	},
+	"create.replicationgroup": {
+		"awless create replicationgroup id=my-redis description='sessions store' engine=redis type=cache.t2.small failover=true subnets=[@sub1,@sub2]",
+		"awless create replicationgroup id=my-redis description='sessions store' primary=my-cache",
+	},
+	"create.repository": {},
+	"create.restapi": {
+		"awless create restapi name=shop-api",
+		"awless create restapi name=shop-api function=@shop-backend role=arn:aws:iam::123456789012:role/apigateway-invoke",
+	},
+	"create.role": {},
+	"create.route": {
+		"awless create route table=@my-routetable cidr=0.0.0.0/0 gateway=@my-igw",
+		"awless create route table=@my-routetable cidr=10.1.0.0/16 peering=pcx-1234abcd",
+	},
+	"create.routetable":    {},
+	"create.s3object":      {},
+	"create.scalinggroup":  {},
+	"create.scalingpolicy": {},
 	"create.securitygroup": {
 		"awless create securitygroup vpc=@myvpc name=ssh-only description=ssh-access",
 		"(... see more params at `awless update securitygroup -h`)",
 	},
-	"create.snapshot":            {},
-	"create.stack":               {},
-	"create.subnet":              {},
-	"create.subscription":        {},
-	"create.tag":                 {},
-	"create.targetgroup":         {},
-	"create.topic":               {},
-	"create.user":                {},
-	"create.volume":              {},
-	"create.vpc":                 {},
-	"create.zone":                {},
-	"delete.accesskey":           {},
-	"delete.alarm":               {},
-	"delete.appscalingpolicy":    {},
-	"delete.appscalingtarget":    {},
-	"delete.bucket":              {},
-	"delete.containercluster":    {},
-	"delete.containertask":       {},
-	"delete.database":            {},
-	"delete.dbsubnetgroup":       {},
-	"delete.distribution":        {},
-	"delete.elasticip":           {},
-	"delete.function":            {},
-	"delete.group":               {},
-	"delete.image":               {},
-	"delete.instance":            {},
-	"delete.instanceprofile":     {},
-	"delete.internetgateway":     {},
+	"create.snapshot": {},
+	"create.stack":    {},
+	"create.stage": {
+		"awless create stage restapi=@shop-api name=prod description='production'",
+	},
+	"create.statemachine": {
+		"awless create statemachine name=orders role=arn:aws:iam::123456789012:role/sfn-orders definition-file=./orders.asl.json",
+	},
+	"create.subnet":       {},
+	"create.subscription": {},
+	"create.tag":          {},
+	"create.table": {
+		"awless create table name=users hash-key=id:S read-capacity=5 write-capacity=5",
+		"awless create table name=events hash-key=device:S range-key=timestamp:N read-capacity=10 write-capacity=10 stream=NEW_AND_OLD_IMAGES",
+	},
+	"create.targetgroup": {},
+	"create.topic":       {},
+	"create.user":        {},
+	"create.volume":      {},
+	"create.vpc":         {},
+	"create.vpcendpoint": {
+		"awless create vpcendpoint vpc=@my-vpc type=gateway service=com.amazonaws.us-west-2.s3 routetables=[@my-routetable]",
+		"awless create vpcendpoint vpc=@my-vpc type=interface service=com.amazonaws.us-west-2.ec2 subnets=[@priv-subnet1,@priv-subnet2] securitygroups=@https-only private-dns=true",
+	},
+	"create.vpcpeering": {
+		"awless create vpcpeering vpc=@my-vpc peer-vpc=@other-vpc name=my-peering",
+		"awless create vpcpeering vpc=@my-vpc peer-vpc=vpc-1234abcd peer-owner=123456789012 peer-region=eu-west-1",
+	},
+	"create.zone":             {},
+	"delete.accesskey":        {},
+	"delete.alarm":            {},
+	"delete.appscalingpolicy": {},
+	"delete.appscalingtarget": {},
+	"delete.backup":           {},
+	"delete.bucket":           {},
+	"delete.cachecluster":     {},
+	"delete.cachesubnetgroup": {},
+	"delete.containercluster": {},
+	"delete.containertask":    {},
+	"delete.database":         {},
+	"delete.dbsubnetgroup":    {},
+	"delete.distribution":     {},
+	"delete.elasticip":        {},
+	"delete.filesystem":       {},
+	"delete.function":         {},
+	"delete.group":            {},
+	"delete.healthcheck":      {},
+	"delete.image":            {},
+	"delete.instance":         {},
+	"delete.instanceprofile":  {},
+	"delete.internetgateway":  {},
+	"delete.key": {
+		"awless delete key id=1234abcd-12ab-34cd-56ef-1234567890ab pending-days=7",
+	},
 	"delete.keypair":             {},
 	"delete.launchconfiguration": {},
 	"delete.listener":            {},
 	"delete.loadbalancer":        {},
 	"delete.loginprofile":        {},
+	"delete.mounttarget":         {},
 	"delete.natgateway":          {},
-	"delete.policy":              {},
-	"delete.queue":               {},
-	"delete.record":              {},
-	"delete.repository":          {},
-	"delete.role":                {},
-	"delete.route":               {},
-	"delete.routetable":          {},
-	"delete.s3object":            {},
-	"delete.scalinggroup":        {},
-	"delete.scalingpolicy":       {},
-	"delete.securitygroup":       {},
-	"delete.snapshot":            {},
-	"delete.stack":               {},
-	"delete.subnet":              {},
-	"delete.subscription":        {},
-	"delete.tag":                 {},
-	"delete.targetgroup":         {},
-	"delete.topic":               {},
+	"delete.parameter": {
+		"awless delete parameter name=/golden/ami/latest",
+	},
+	"delete.policy":           {},
+	"delete.queue":            {},
+	"delete.record":           {},
+	"delete.replicationgroup": {},
+	"delete.repository":       {},
+	"delete.restapi":          {},
+	"delete.role":             {},
+	"delete.route":            {},
+	"delete.routetable":       {},
+	"delete.s3object":         {},
+	"delete.scalinggroup":     {},
+	"delete.scalingpolicy":    {},
+	"delete.securitygroup":    {},
+	"delete.snapshot":         {},
+	"delete.stack":            {},
+	"delete.stage":            {},
+	"delete.statemachine":     {},
+	"delete.subnet":           {},
+	"delete.subscription":     {},
+	"delete.tag":              {},
+	"delete.table":            {},
+	"delete.targetgroup":      {},
+	"delete.topic":            {},
 	"delete.user": {
 		"awless delete user name=john",
 	},
 	"delete.volume":          {},
 	"delete.vpc":             {},
+	"delete.vpcendpoint":     {},
+	"delete.vpcpeering":      {},
 	"delete.zone":            {},
 	"detach.alarm":           {},
 	"detach.containertask":   {},
@@ -277,16 +371,29 @@ var cliExamplesDoc = map[string][]string{
 		"awless update image id=@my-image accounts=3456728198326 operation=add # Grants launch permission to an AWS account",
 		"awless update image id=@my-image accounts=[3456728198326,546371829387] operation=remove  # Remove launch permission to multiple AWS accounts",
 	},
+	"update.key": {
+		"awless update key id=1234abcd-12ab-34cd-56ef-1234567890ab rotation=true",
+		"awless update key id=1234abcd-12ab-34cd-56ef-1234567890ab enabled=false",
+	},
 	"update.loginprofile": {},
-	"update.policy":       {},
-	"update.record":       {},
+	"update.parameter": {
+		"awless update parameter name=/golden/ami/latest value=ami-5678efgh",
+	},
+	"update.policy": {},
+	"update.record": {
+		"awless update record zone=Z1JZ7WHJ5N0C6M name=www.example.com type=A value=52.18.0.12 ttl=60 set-identifier=blue weight=10",
+	},
 	"update.s3object":     {},
 	"update.scalinggroup": {},
 	"update.securitygroup": {
 		"awless update securitygroup id=@ssh-only inbound=authorize protocol=tcp cidr=0.0.0.0/0 portrange=26257",
 		"awless update securitygroup id=@ssh-only inbound=authorize protocol=tcp securitygroup=sg-123457 portrange=8080",
 	},
-	"update.stack":       {},
-	"update.subnet":      {},
+	"update.stack":  {},
+	"update.subnet": {},
+	"update.table": {
+		"awless update table name=users read-capacity=10 write-capacity=20",
+		"awless update table name=users stream=disabled",
+	},
 	"update.targetgroup": {},
 }
@@ -8,7 +8,7 @@ import (
 var (
 	timeouts      = []string{"10", "60", "180", "300", "600", "900"}
 	boolean       = []string{"true", "false"}
-	services      = []string{"iam", "ec2", "s3", "route53", "elbv2", "rds", "autoscaling", "lambda", "sns", "sqs", "cloudwatch", "cloudfront", "ecr", "ecs", "applicationautoscaling", "acm", "sts", "cloudformation"}
+	services      = []string{"iam", "ec2", "s3", "route53", "elbv2", "rds", "autoscaling", "lambda", "sns", "sqs", "cloudwatch", "cloudfront", "ecr", "ecs", "applicationautoscaling", "acm", "sts", "cloudformation", "dynamodb", "kms", "ssm"}
 	instanceTypes = []string{"t2.nano", "t2.micro", "t2.small", "t2.medium", "t2.large", "t2.xlarge", "t2.2xlarge", "m4.large", "m4.xlarge", "c4.large", "c4.xlarge"}
 	s3ACLs        = []string{"private", "public-read", "public-read-write", "aws-exec-read", "authenticated-read", "bucket-owner-read", "bucket-owner-full-control", "log-delivery-write"}
 	distros       = []string{"amazonlinux", "canonical:ubuntu", "redhat:rhel", "debian:debian", "centos:centos", "coreos:coreos", "suselinux", "windows:server"}
@@ -24,6 +24,9 @@ var EnumDoc = map[string][]string{
 	"attach.policy.access":  {"readonly", "full"},
 	"attach.policy.service": services,
 
+	"check.cachecluster.state":   {"available", "creating", "deleting", "incompatible-network", "modifying", "rebooting cluster nodes", "restore-failed", "snapshotting", "not-found"},
+	"check.cachecluster.timeout": timeouts,
+
 	"check.database.state":   {"available", "backing-up", "creating", "deleting", "failed", "maintenance", "modifying", "rebooting", "renaming", "resetting-master-credentials", "restore-error", "storage-full", "upgrading", "not-found"},
 	"check.database.timeout": timeouts,
 
@@ -33,18 +36,27 @@ var EnumDoc = map[string][]string{
 	"check.distribution.state":   {"Deployed", "InProgress", "not-found"},
 	"check.distribution.timeout": timeouts,
 
+	"check.filesystem.state":   {"creating", "available", "deleting", "deleted", "not-found"},
+	"check.filesystem.timeout": timeouts,
+
 	"check.instance.state":   {"pending", "running", "shutting-down", "terminated", "stopping", "stopped", "not-found"},
 	"check.instance.timeout": timeouts,
 
 	"check.loadbalancer.state":   {"provisioning", "active", "failed", "not-found"},
 	"check.loadbalancer.timeout": timeouts,
 
+	"check.mounttarget.state":   {"creating", "available", "deleting", "deleted", "not-found"},
+	"check.mounttarget.timeout": timeouts,
+
 	"check.natgateway.state":   {"pending", "failed", "available", "deleting", "deleted", "not-found"},
 	"check.natgateway.timeout": timeouts,
 
 	"check.networkinterface.state":   {"available", "attaching", "detaching", "in-use", "not-found"},
 	"check.networkinterface.timeout": timeouts,
 
+	"check.replicationgroup.state":   {"available", "create-failed", "creating", "deleting", "modifying", "snapshotting", "not-found"},
+	"check.replicationgroup.timeout": timeouts,
+
 	"check.scalinggroup.count":   {"0"},
 	"check.scalinggroup.timeout": timeouts,
 
@@ -72,6 +84,10 @@ var EnumDoc = map[string][]string{
 
 	"create.bucket.acl": s3ACLs,
 
+	"create.cachecluster.engine":      {"memcached", "redis"},
+	"create.cachecluster.autoupgrade": boolean,
+	"create.cachecluster.type":        {"cache.t2.micro", "cache.t2.small", "cache.t2.medium", "cache.m4.large", "cache.m4.xlarge", "cache.r4.large", "cache.r4.xlarge"},
+
 	"create.database.engine":             {"mysql", "mariadb", "postgres", "aurora", "oracle-se1", "oracle-se2", "oracle-se", "oracle-ee", "sqlserver-ee", "sqlserver-se", "sqlserver-ex", "sqlserver-web"},
 	"create.database.copytagstosnapshot": boolean,
 	"create.database.encrypted":          boolean,
@@ -90,8 +106,14 @@ var EnumDoc = map[string][]string{
 
 	"create.elasticip.domain": {"vpc", "ec2-classic"},
 
+	"create.filesystem.performancemode": {"generalPurpose", "maxIO"},
+	"create.filesystem.encrypted":       boolean,
+
 	"create.function.runtime": {"nodejs", "nodejs4.3", "nodejs6.10", "java8", "python2.7", "python3.6", "dotnetcore1.0", "nodejs4.3-edge"},
 
+	"create.healthcheck.type":     {"HTTP", "HTTPS", "HTTP_STR_MATCH", "HTTPS_STR_MATCH", "TCP"},
+	"create.healthcheck.interval": {"10", "30"},
+
 	"create.instance.distro":   distros,
 	"create.instance.type":     instanceTypes,
 	"create.instance.lock":     boolean,
@@ -110,11 +132,18 @@ var EnumDoc = map[string][]string{
 	"create.listener.protocol":   {"HTTP", "HTTPS"},
 	"create.listener.sslpolicy":  {"ELBSecurityPolicy-2016-08", "ELBSecurityPolicy-TLS-1-2-2017-01", "ELBSecurityPolicy-TLS-1-1-2017-01", "ELBSecurityPolicy-2015-05", "ELBSecurityPolicy-TLS-1-0-2015-04"},
 
+	"create.parameter.type": {"String", "StringList", "SecureString"},
+
 	"create.policy.action":   {""},
 	"create.policy.effect":   {"Allow", "Deny"},
 	"create.policy.resource": {"*"},
 
-	"create.record.type": {"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"},
+	"create.record.type":     {"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"},
+	"create.record.failover": {"PRIMARY", "SECONDARY"},
+
+	"create.replicationgroup.engine":    {"redis"},
+	"create.replicationgroup.failover":  boolean,
+	"create.replicationgroup.encrypted": boolean,
 
 	"create.s3object.acl": s3ACLs,
 
@@ -123,12 +152,16 @@ var EnumDoc = map[string][]string{
 	"create.scalingpolicy.adjustment-type": {"ChangeInCapacity", "ExactCapacity", "PercentChangeInCapacity"},
 
 	"create.stack.capabilities": {"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"},
+	"create.table.stream":       {"NEW_IMAGE", "OLD_IMAGE", "NEW_AND_OLD_IMAGES", "KEYS_ONLY"},
 	"create.stack.on-failure":   {"DO_NOTHING", "ROLLBACK", "DELETE"},
 
 	"create.subnet.public": boolean,
 
 	"create.subscription.protocol": {"http", "https", "email", "email-json", "sms", "sqs", "lambda"},
 
+	"create.vpcendpoint.type":        {"gateway", "interface"},
+	"create.vpcendpoint.private-dns": boolean,
+
 	"create.zone.isprivate": boolean,
 
 	"copy.image.source-id":     {""},
@@ -142,7 +175,10 @@ var EnumDoc = map[string][]string{
 
 	"delete.policy.all-versions": boolean,
 
-	"delete.record.type": {"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"},
+	"delete.replicationgroup.retain-primary": boolean,
+
+	"delete.record.type":     {"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"},
+	"delete.record.failover": {"PRIMARY", "SECONDARY"},
 
 	"detach.networkinterface.force": boolean,
 
@@ -184,12 +220,14 @@ var EnumDoc = map[string][]string{
 	"update.securitygroup.portrange": {""},
 
 	"update.stack.capabilities": {"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM"},
+	"update.table.stream":       {"NEW_IMAGE", "OLD_IMAGE", "NEW_AND_OLD_IMAGES", "KEYS_ONLY", "disabled"},
 
 	"update.targetgroup.stickiness": boolean,
 
 	"update.subnet.public": boolean,
 
-	"update.record.type": {"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"},
+	"update.record.type":     {"A", "AAAA", "CNAME", "MX", "NAPTR", "NS", "PTR", "SOA", "SPF", "SRV", "TXT"},
+	"update.record.failover": {"PRIMARY", "SECONDARY"},
 }
 
 type ParamType struct {
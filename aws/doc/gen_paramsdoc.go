@@ -18,6 +18,9 @@ limitations under the License.
 package awsdoc
 
 var generatedParamsDoc = map[string]map[string]string{
+	"accept.vpcpeering": {
+		"id": "The ID of the VPC peering connection",
+	},
 	"attach.alarm":               {},
 	"attach.classicloadbalancer": {},
 	"attach.containertask":       {},
@@ -70,13 +73,17 @@ var generatedParamsDoc = map[string]map[string]string{
 		"instance": "The ID of the instance",
 	},
 	"authenticate.registry":  {},
+	"check.cachecluster":     {},
 	"check.certificate":      {},
 	"check.database":         {},
 	"check.distribution":     {},
+	"check.filesystem":       {},
 	"check.instance":         {},
 	"check.loadbalancer":     {},
+	"check.mounttarget":      {},
 	"check.natgateway":       {},
 	"check.networkinterface": {},
+	"check.replicationgroup": {},
 	"check.scalinggroup":     {},
 	"check.securitygroup":    {},
 	"check.volume":           {},
@@ -128,11 +135,16 @@ var generatedParamsDoc = map[string]map[string]string{
 		"role":              "The ARN of an IAM role that allows Application Auto Scaling to modify the scalable target on your behalf",
 		"service-namespace": "The namespace of the AWS service",
 	},
+	"create.backup": {
+		"name":  "Specified name for the backup",
+		"table": "The name of the table",
+	},
 	"create.bucket": {
 		"acl":  "The canned ACL to apply to the bucket",
 		"name": "",
 	},
-	"create.certificate": {},
+	"create.cachecluster": {},
+	"create.certificate":  {},
 	"create.classicloadbalancer": {
 		"scheme":         "The nodes of an Internet-facing load balancer have public IP addresses",
 		"securitygroups": "[Application Load Balancers] The IDs of the security groups to assign to the load balancer",
@@ -148,6 +160,12 @@ var generatedParamsDoc = map[string]map[string]string{
 	"create.elasticip": {
 		"domain": "Set to vpc to allocate the address for use with instances in a VPC",
 	},
+	"create.filesystem": {
+		"encrypted":       "A boolean value that, if true, creates an encrypted file system",
+		"key":             "The id of the AWS KMS CMK that will be used to protect the encrypted file system",
+		"performancemode": "The PerformanceMode of the file system",
+		"token":           "String of up to 64 ASCII characters",
+	},
 	"create.function": {
 		"description": "A short, user-defined function description",
 		"handler":     "The function within your code that Lambda calls to begin execution",
@@ -161,6 +179,9 @@ var generatedParamsDoc = map[string]map[string]string{
 	"create.group": {
 		"name": "The name of the group to create",
 	},
+	"create.healthcheck": {
+		"callerreference": "A unique string that identifies the request and that allows you to retry a failed CreateHealthCheck request without the risk of creating two identical health checks:   If you send a CreateHealthCheck request with the same CallerReference and settings as a previous request, and if the health check doesn't exist, Amazon Route 53 creates the health check",
+	},
 	"create.image": {
 		"description": "A description for the new image",
 		"instance":    "The ID of the instance",
@@ -181,6 +202,7 @@ var generatedParamsDoc = map[string]map[string]string{
 		"name": "The name of the instance profile to create",
 	},
 	"create.internetgateway": {},
+	"create.key":             {},
 	"create.keypair": {
 		"name": "A unique name for the key pair",
 	},
@@ -216,6 +238,12 @@ var generatedParamsDoc = map[string]map[string]string{
 		"username":       "The name of the IAM user to create a password for",
 	},
 	"create.mfadevice": {},
+	"create.mounttarget": {
+		"filesystem":     "ID of the file system for which to create the mount target",
+		"ip":             "Valid IPv4 address within the address range of the specified subnet",
+		"securitygroups": "Up to five VPC security group IDs, of the form sg-xxxxxxxx",
+		"subnet":         "ID of the subnet to add the mount target in",
+	},
 	"create.natgateway": {
 		"elasticip-id": "The allocation ID of an Elastic IP address to associate with the NAT gateway",
 		"subnet":       "The subnet in which to create the NAT gateway",
@@ -226,6 +254,13 @@ var generatedParamsDoc = map[string]map[string]string{
 		"securitygroups": "The IDs of one or more security groups",
 		"subnet":         "The ID of the subnet to associate with the network interface",
 	},
+	"create.parameter": {
+		"description": "Information about the parameter that you want to add to the system",
+		"key":         "The KMS Key ID that you want to use to encrypt a parameter when you choose the SecureString data type",
+		"name":        "The fully qualified name of the parameter that you want to add to the system",
+		"type":        "The type of parameter that you want to add to the system",
+		"value":       "The parameter value that you want to add to the system",
+	},
 	"create.policy": {
 		"description": "A friendly description of the policy",
 		"name":        "The friendly name of the policy",
@@ -233,14 +268,21 @@ var generatedParamsDoc = map[string]map[string]string{
 	"create.queue": {
 		"name": "The name of the new queue",
 	},
-	"create.record": {},
+	"create.record":           {},
+	"create.replicationgroup": {},
 	"create.repository": {
 		"name": "The name to use for the repository",
 	},
+	"create.restapi": {
+		"description": "The description of the RestApi",
+		"name":        "The name of the RestApi",
+		"version":     "A version identifier for the API",
+	},
 	"create.role": {},
 	"create.route": {
 		"cidr":    "The IPv4 CIDR address block used for the destination match",
 		"gateway": "The ID of an Internet gateway or virtual private gateway attached to your VPC",
+		"peering": "The ID of a VPC peering connection",
 		"table":   "The ID of the route table for the route",
 	},
 	"create.routetable": {
@@ -291,6 +333,18 @@ var generatedParamsDoc = map[string]map[string]string{
 		"template-file":    "Structure containing the template body with a minimum length of 1 byte and a maximum length of 51,200 bytes",
 		"timeout":          "The amount of time that can pass before the stack status becomes CREATE_FAILED; if DisableRollback is not set or is set to false, the stack will be rolled back",
 	},
+	"create.stage": {
+		"deployment-description": "The description for the Deployment resource to create",
+		"description":            "The description of the Stage resource for the Deployment resource to create",
+		"name":                   "The name of the Stage resource for the Deployment resource to create",
+		"restapi":                "The string identifier of the associated RestApi",
+	},
+	"create.statemachine": {
+		"definition":      "The Amazon States Language definition of the state machine",
+		"definition-file": "The Amazon States Language definition of the state machine",
+		"name":            "The name of the state machine",
+		"role":            "The Amazon Resource Name (ARN) of the IAM role to use for this state machine",
+	},
 	"create.subnet": {
 		"availabilityzone": "The Availability Zone for the subnet",
 		"cidr":             "The IPv4 network range for the subnet, in CIDR notation",
@@ -301,7 +355,8 @@ var generatedParamsDoc = map[string]map[string]string{
 		"protocol": "The protocol you want to use",
 		"topic":    "The ARN of the topic you want to subscribe to",
 	},
-	"create.tag": {},
+	"create.table": {},
+	"create.tag":   {},
 	"create.targetgroup": {
 		"healthcheckinterval": "The approximate amount of time, in seconds, between health checks of an individual target",
 		"healthcheckpath":     "[HTTP/HTTPS health checks] The ping path that is the destination on the targets for health checks",
@@ -328,6 +383,22 @@ var generatedParamsDoc = map[string]map[string]string{
 	"create.vpc": {
 		"cidr": "The IPv4 network range for the VPC, in CIDR notation",
 	},
+	"create.vpcendpoint": {
+		"policy":         "(Gateway endpoint) A policy to attach to the endpoint that controls access to the service",
+		"private-dns":    "(Interface endpoint) Indicate whether to associate a private hosted zone with the specified VPC",
+		"routetables":    "(Gateway endpoint) One or more route table IDs",
+		"securitygroups": "(Interface endpoint) The ID of one or more security groups to associate with the endpoint network interface",
+		"service":        "The service name",
+		"subnets":        "(Interface endpoint) The ID of one or more subnets in which to create an endpoint network interface",
+		"type":           "The type of endpoint",
+		"vpc":            "The ID of the VPC in which the endpoint will be used",
+	},
+	"create.vpcpeering": {
+		"peer-owner":  "The AWS account ID of the owner of the accepter VPC",
+		"peer-region": "The region code for the accepter VPC, if the accepter VPC is located in a region other than the region in which you make the request",
+		"peer-vpc":    "The ID of the VPC with which you are creating the VPC peering connection",
+		"vpc":         "The ID of the requester VPC",
+	},
 	"create.zone": {
 		"callerreference": "A unique string that identifies the request and that allows failed CreateHostedZone requests to be retried without the risk of executing the operation twice",
 		"delegationsetid": "If you want to associate a reusable delegation set with this hosted zone, the ID that Amazon Route 53 assigned to the reusable delegation set when you created it",
@@ -351,9 +422,14 @@ var generatedParamsDoc = map[string]map[string]string{
 		"resource":          "The identifier of the resource associated with the scalable target",
 		"service-namespace": "The namespace of the AWS service",
 	},
+	"delete.backup": {
+		"arn": "The ARN associated with the backup",
+	},
 	"delete.bucket": {
 		"name": "",
 	},
+	"delete.cachecluster":     {},
+	"delete.cachesubnetgroup": {},
 	"delete.certificate": {
 		"arn": "String that contains the ARN of the ACM Certificate to be deleted",
 	},
@@ -371,6 +447,9 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id": "The allocation ID",
 		"ip": "The Elastic IP address",
 	},
+	"delete.filesystem": {
+		"id": "ID of the file system you want to delete",
+	},
 	"delete.function": {
 		"id":      "The Lambda function to delete",
 		"version": "Using this optional parameter you can specify a function version (but not the $LATEST version) to direct AWS Lambda to delete a specific function version",
@@ -378,6 +457,9 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.group": {
 		"name": "The name of the IAM group to delete",
 	},
+	"delete.healthcheck": {
+		"id": "The ID of the health check that you want to delete",
+	},
 	"delete.image": {},
 	"delete.instance": {
 		"ids": "One or more instance IDs",
@@ -388,6 +470,10 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.internetgateway": {
 		"id": "The ID of the Internet gateway",
 	},
+	"delete.key": {
+		"id":           "The unique identifier of the customer master key (CMK) to delete",
+		"pending-days": "The waiting period, specified in number of days",
+	},
 	"delete.keypair": {
 		"name": "The name of the key pair",
 	},
@@ -404,24 +490,34 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.mfadevice": {
 		"id": "The serial number that uniquely identifies the MFA device",
 	},
+	"delete.mounttarget": {
+		"id": "ID of the mount target to delete (String)",
+	},
 	"delete.natgateway": {
 		"id": "The ID of the NAT gateway",
 	},
 	"delete.networkinterface": {
 		"id": "The ID of the network interface",
 	},
+	"delete.parameter": {
+		"name": "The name of the parameter to delete",
+	},
 	"delete.policy": {
 		"arn": "The Amazon Resource Name (ARN) of the IAM policy you want to delete",
 	},
 	"delete.queue": {
 		"url": "The URL of the Amazon SQS queue to delete",
 	},
-	"delete.record": {},
+	"delete.record":           {},
+	"delete.replicationgroup": {},
 	"delete.repository": {
 		"account": "The AWS account ID associated with the registry that contains the repository to delete",
 		"force":   "If a repository contains images, forces the deletion",
 		"name":    "The name of the repository to delete",
 	},
+	"delete.restapi": {
+		"id": "The string identifier of the associated RestApi",
+	},
 	"delete.role": {},
 	"delete.route": {
 		"cidr":  "The IPv4 CIDR range for the route",
@@ -451,12 +547,22 @@ var generatedParamsDoc = map[string]map[string]string{
 		"name":             "The name or the unique stack ID that is associated with the stack",
 		"retain-resources": "For stacks in the DELETE_FAILED state, a list of resource logical IDs that are associated with the resources you want to retain",
 	},
+	"delete.stage": {
+		"name":    "The name of the Stage resource to delete",
+		"restapi": "The string identifier of the associated RestApi",
+	},
+	"delete.statemachine": {
+		"id": "The Amazon Resource Name (ARN) of the state machine to delete",
+	},
 	"delete.subnet": {
 		"id": "The ID of the subnet",
 	},
 	"delete.subscription": {
 		"id": "The ARN of the subscription to be deleted",
 	},
+	"delete.table": {
+		"name": "The name of the table to delete",
+	},
 	"delete.tag": {},
 	"delete.targetgroup": {
 		"id": "The Amazon Resource Name (ARN) of the target group",
@@ -473,6 +579,12 @@ var generatedParamsDoc = map[string]map[string]string{
 	"delete.vpc": {
 		"id": "The ID of the VPC",
 	},
+	"delete.vpcendpoint": {
+		"id": "One or more VPC endpoint IDs",
+	},
+	"delete.vpcpeering": {
+		"id": "The ID of the VPC peering connection",
+	},
 	"delete.zone": {
 		"id": "The ID of the hosted zone you want to delete",
 	},
@@ -563,11 +675,13 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id":   "The ID of the instance",
 		"lock": "If the value is true, you can't terminate the instance using the Amazon EC2 console, CLI, or API; otherwise, you can",
 	},
+	"update.key": {},
 	"update.loginprofile": {
 		"password":       "The new password for the specified IAM user",
 		"password-reset": "Allows this new password to be used only once by requiring the specified IAM user to set a new password on next sign-in",
 		"username":       "The name of the user whose password you want to update",
 	},
+	"update.parameter": {},
 	"update.policy": {
 		"arn": "The Amazon Resource Name (ARN) of the IAM policy to which you want to add a new version",
 	},
@@ -608,5 +722,8 @@ var generatedParamsDoc = map[string]map[string]string{
 		"id":     "The ID of the subnet",
 		"public": "Specify true to indicate that network interfaces created in the specified subnet should be assigned a public IPv4 address",
 	},
+	"update.table": {
+		"name": "The name of the table to be updated",
+	},
 	"update.targetgroup": {},
 }
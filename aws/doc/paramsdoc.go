@@ -74,6 +74,11 @@ var manualParamsDoc = map[string]map[string]string{
 		"no-confirm":      "Do not ask confirmation before effectively running `docker login` command",
 		"no-docker-login": "Set to 'true' to disable the prompt and automatic execution of `docker login` command",
 	},
+	"check.cachecluster": {
+		"id":      "The ID of the ElastiCache cluster to check",
+		"state":   "The state of the ElastiCache cluster to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
 	"check.certificate": {
 		"arn":     "The Amazon Resource Name (ARN) of the certificate to check",
 		"state":   "The state of the certificate to reach",
@@ -89,6 +94,11 @@ var manualParamsDoc = map[string]map[string]string{
 		"state":   "The state of the CloudFront Distribution to reach",
 		"timeout": "The time (in seconds) after which the check is failed",
 	},
+	"check.filesystem": {
+		"id":      "The ID of the EFS filesystem to check",
+		"state":   "The state of the EFS filesystem to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
 	"check.instance": {
 		"id":      "The ID of the EC2 Instance to check",
 		"state":   "The state of the EC2 Instance to reach",
@@ -99,6 +109,11 @@ var manualParamsDoc = map[string]map[string]string{
 		"state":   "The state of the ELBv2 Loadbalancer to reach",
 		"timeout": "The time (in seconds) after which the check is failed",
 	},
+	"check.mounttarget": {
+		"id":      "The ID of the EFS mount target to check",
+		"state":   "The state of the EFS mount target to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
 	"check.natgateway": {
 		"id":      "The ID of the NAT Gateway to check",
 		"state":   "The state of the NAT Gateway to reach",
@@ -109,6 +124,11 @@ var manualParamsDoc = map[string]map[string]string{
 		"state":   "The state of the Network Interface to reach",
 		"timeout": "The time (in seconds) after which the check is failed",
 	},
+	"check.replicationgroup": {
+		"id":      "The ID of the ElastiCache replication group to check",
+		"state":   "The state of the ElastiCache replication group to reach",
+		"timeout": "The time (in seconds) after which the check is failed",
+	},
 	"check.scalinggroup": {
 		"name":    "The name of the AutoScaling Group to check",
 		"count":   "The number of Instances + Loadbalancers + TargetGroups in the AutoScaling Group to reach",
@@ -154,6 +174,20 @@ var manualParamsDoc = map[string]map[string]string{
 		"acl":  "The canned ACL to apply to the bucket",
 		"name": "The name of bucket to create",
 	},
+	"create.cachecluster": {
+		"autoupgrade":       "Set to true to indicate that minor engine upgrades are applied automatically during the maintenance window",
+		"availabilityzone":  "The Availability Zone in which the cache nodes are created",
+		"engine":            "The cache engine: 'memcached' or 'redis'",
+		"id":                "The identifier of the cache cluster (lowercase, 1 to 20 alphanumeric characters or hyphens)",
+		"maintenancewindow": "The weekly time range (in UTC) during which system maintenance can occur (format ddd:hh24:mi-ddd:hh24:mi)",
+		"nodes":             "The number of cache nodes (defaults to 1, must be 1 for redis)",
+		"port":              "The port number on which each cache node accepts connections",
+		"securitygroups":    "One or more VPC security groups associated with the cache cluster",
+		"subnetgroup":       "The name of an existing cache subnet group to use for the cache cluster",
+		"subnets":           "The subnets of the cache cluster: a cache subnet group named after the cluster is created from them (or reused when it has the same subnets) and deleted on revert",
+		"type":              "The compute and memory capacity of the nodes (ex: cache.t2.micro)",
+		"version":           "The version number of the cache engine",
+	},
 	"create.certificate": {
 		"domains":            "Main and Additional Fully qualified domain names (FQDNs) to be included in the Certificate name and Subject Alternative Name of the ACM Certificate",
 		"validation-domains": "The domain name that you want ACM to use to send you validation emails. This domain name is the suffix of the email addresses that you want ACM to use. This must be the same as the DomainName value or a superdomain of the domain value",
@@ -221,6 +255,13 @@ var manualParamsDoc = map[string]map[string]string{
 	"create.elasticip": {
 		"domain": "Set to vpc to allocate the address for use with instances in a VPC else the address is for use with instances in EC2-Classic",
 	},
+	"create.filesystem": {
+		"encrypted":       "Set to true to create an encrypted filesystem (implied when a key is given)",
+		"key":             "The ID or ARN of the KMS key protecting the encrypted filesystem (defaults to the aws/elasticfilesystem key)",
+		"name":            "The 'Name' Tag for the filesystem to create",
+		"performancemode": "The performance mode of the filesystem: 'generalPurpose' (default) or 'maxIO'",
+		"token":           "A unique idempotency token for the creation (generated when not given)",
+	},
 	"create.function": {
 		"bucket":        "Amazon S3 bucket name where the .zip file containing your deployment package is stored. This bucket must reside in the same AWS region where you are creating the Lambda function",
 		"object":        "The Amazon S3 object (the deployment package) key name you want to upload",
@@ -231,6 +272,16 @@ var manualParamsDoc = map[string]map[string]string{
 	"create.group": {
 		"name": "The name of the group to create",
 	},
+	"create.healthcheck": {
+		"type":      "The type of the health check",
+		"ip":        "The IPv4 address of the endpoint to check",
+		"fqdn":      "The fully qualified domain name of the endpoint to check (sent as Host header for HTTP checks)",
+		"port":      "The port of the endpoint to check",
+		"path":      "The path requested for HTTP and HTTPS checks (e.g. /health)",
+		"interval":  "The number of seconds between two checks (10 or 30)",
+		"threshold": "The number of consecutive checks that an endpoint must pass or fail to change its status",
+		"name":      "The name of the health check (set as its 'Name' tag)",
+	},
 	"create.instance": {
 		"count":  "The number of instances to launch",
 		"name":   "The name of the instance to launch",
@@ -241,6 +292,12 @@ var manualParamsDoc = map[string]map[string]string{
 	"create.image": {
 		"reboot": "True to shut down and reboot the instance before creating the image, otherwise no reboot and file system integrity on the created image cannot be guaranteed",
 	},
+	"create.key": {
+		"description": "A description of the key (ex: what the key is used for)",
+		"alias":       "An alias to refer to the key (the 'alias/' prefix is added when missing)",
+		"policy-file": "The path to the file containing the key policy (default: the key is manageable by the account)",
+		"rotation":    "Set to 'true' to enable the yearly automatic rotation of the key material",
+	},
 	"create.keypair": {
 		"name":      "The name of the keypair to create (it will also be the name of the file stored in ~/.awless/keys)",
 		"encrypted": "Set to 'true' if you want to encrypt the keypair"},
@@ -258,6 +315,19 @@ var manualParamsDoc = map[string]map[string]string{
 	"create.mfadevice": {
 		"name": "The name of the virtual MFA device",
 	},
+	"create.mounttarget": {
+		"filesystem":     "The ID of the filesystem for which to create the mount target",
+		"ip":             "A private IP address within the range of the subnet (picked automatically by default)",
+		"securitygroups": "Up to five VPC security groups for the mount target (defaults to the default security group of the VPC)",
+		"subnet":         "The ID of the subnet in which to create the mount target",
+	},
+	"create.parameter": {
+		"name":        "The name of the parameter, possibly hierarchical (ex: /golden/ami/latest)",
+		"type":        "The type of the parameter: String, StringList (comma separated values) or SecureString (encrypted with a KMS key)",
+		"value":       "The value of the parameter",
+		"description": "A description of the parameter",
+		"key":         "The ID, ARN or alias of the KMS key encrypting a SecureString parameter (default: the account default key for SSM)",
+	},
 	"create.policy": {
 		"name":        "The friendly name of the policy",
 		"description": "A friendly description of the policy",
@@ -276,13 +346,37 @@ var manualParamsDoc = map[string]map[string]string{
 		"visibility-timeout": "The visibility timeout for the queue. Valid values: An integer from 0 to 43200 (12 hours). The default is 30",
 	},
 	"create.record": {
-		"zone":    "The ID of the hosted zone that contains the resource record sets that you want to change",
-		"name":    "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
-		"type":    "The DNS record type",
-		"value":   "The new DNS record value",
-		"values":  "The new DNS record value(s)",
-		"ttl":     "The resource record cache time to live (TTL), in seconds",
-		"comment": "Any comments you want to include about a change batch request",
+		"zone":           "The ID of the hosted zone that contains the resource record sets that you want to change",
+		"name":           "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
+		"type":           "The DNS record type",
+		"value":          "The new DNS record value",
+		"values":         "The new DNS record value(s)",
+		"ttl":            "The resource record cache time to live (TTL), in seconds",
+		"comment":        "Any comments you want to include about a change batch request",
+		"set-identifier": "An identifier differentiating the records sharing the same name and type with a weighted, latency or failover routing policy",
+		"weight":         "The weight of the record among the records sharing the same name and type (weighted routing policy, requires a set-identifier)",
+		"region":         "The AWS region of the resource the record points to (latency routing policy, requires a set-identifier)",
+		"failover":       "Whether the record is the PRIMARY or the SECONDARY one (failover routing policy, requires a set-identifier)",
+		"healthcheck":    "The ID of a health check determining whether the record is healthy enough to be answered",
+	},
+	"create.replicationgroup": {
+		"description":    "The description of the replication group",
+		"encrypted":      "Set to true to enable encryption at rest (redis 3.2.6 and later)",
+		"engine":         "The cache engine of the clusters in the replication group (only 'redis')",
+		"failover":       "Set to true to promote a read replica automatically when the primary fails (needs at least 2 nodes)",
+		"id":             "The identifier of the replication group (lowercase, 1 to 20 alphanumeric characters or hyphens)",
+		"nodes":          "The number of clusters (primary and replicas) in the replication group (defaults to 2 with failover, 1 otherwise)",
+		"port":           "The port number on which each member of the replication group accepts connections",
+		"primary":        "The ID of an existing redis cache cluster to use as the primary of the replication group",
+		"securitygroups": "One or more VPC security groups associated with the replication group",
+		"subnetgroup":    "The name of an existing cache subnet group to use for the replication group",
+		"subnets":        "The subnets of the replication group: a cache subnet group named after the group is created from them (or reused when it has the same subnets) and deleted on revert",
+		"type":           "The compute and memory capacity of the nodes (ex: cache.t2.micro)",
+		"version":        "The version number of the cache engine",
+	},
+	"create.restapi": {
+		"function": "The ARN of a lambda function to which all the requests on the API are proxied (on the root resource and on a greedy {proxy+} resource)",
+		"role":     "The ARN of an IAM role that API Gateway assumes to invoke the lambda function (required with 'function')",
 	},
 	"create.role": {
 		"conditions":        "List of conditions necessary for the policy to be in effect (e.g. [aws:UserAgent!=My user agent,s3:prefix=~home/,aws:CurrentTime>=2013-06-30T00:00:00Z,aws:SourceIp!=203.0.113.0/24,aws:SourceArn==arn:aws:sns:eu-west-1:*:*])",
@@ -315,6 +409,20 @@ var manualParamsDoc = map[string]map[string]string{
 		"rollback-triggers":       "List of CloudWatch Alarm ARNs to monitor during and after creation",
 		"rollback-monitoring-min": "Time to monitor rollback-triggers during and after creation",
 	},
+	"create.stage": {
+		"name": "The name of the stage to create: a deployment of the current state of the API is created and published to it",
+	},
+	"create.statemachine": {
+		"definition-file": "The path to the file containing the Amazon States Language definition of the state machine",
+	},
+	"create.table": {
+		"name":           "The name of the table to create",
+		"hash-key":       "The partition key of the table given as name:type where type is S (string), N (number) or B (binary). Ex: id:S",
+		"range-key":      "The sort key of the table given as name:type where type is S (string), N (number) or B (binary). Ex: timestamp:N",
+		"read-capacity":  "The maximum number of strongly consistent reads consumed per second before DynamoDB returns a ThrottlingException",
+		"write-capacity": "The maximum number of writes consumed per second before DynamoDB returns a ThrottlingException",
+		"stream":         "Enable DynamoDB Streams on the table with the given view type of the information written to the stream",
+	},
 	"create.subnet": {
 		"name":   "The 'Name' Tag for the subnet to create",
 		"public": "A value (true) to indicate that network interfaces created in this subnet should be assigned a public IPv4 address (instances, etc.)",
@@ -335,6 +443,16 @@ var manualParamsDoc = map[string]map[string]string{
 	"create.vpc": {
 		"name": "The 'Name' Tag for the VPC to create",
 	},
+	"create.vpcendpoint": {
+		"type":        "The type of endpoint: 'gateway' (S3 and DynamoDB, routed through route tables) or 'interface' (network interfaces in subnets)",
+		"routetables": "(Gateway endpoints only) One or more route tables to update with a route to the service",
+		"policy":      "(Gateway endpoints only) A JSON policy document that controls access to the service from the VPC",
+	},
+	"create.vpcpeering": {
+		"name":        "The 'Name' Tag for the VPC peering connection to create",
+		"peer-owner":  "The AWS account ID of the owner of the accepter VPC (defaults to your account ID)",
+		"peer-region": "The region code for the accepter VPC (defaults to the current region)",
+	},
 	"create.zone": {
 		"comment":   "Any comments that you want to include about the hosted zone",
 		"isprivate": "A value that indicates whether this is a private hosted zone",
@@ -350,6 +468,13 @@ var manualParamsDoc = map[string]map[string]string{
 	"delete.bucket": {
 		"name": "The name of the bucket to be deleted",
 	},
+	"delete.cachecluster": {
+		"id":       "The identifier of the cache cluster to delete",
+		"snapshot": "The name of a final redis snapshot to create before deleting the cluster",
+	},
+	"delete.cachesubnetgroup": {
+		"name": "The name of the cache subnet group to be deleted",
+	},
 	"delete.containertask": {
 		"name":         "The name of the containertask to be deleted",
 		"all-versions": "Set to 'true' to delete all existing versions of the containertask to be deleted",
@@ -379,6 +504,10 @@ var manualParamsDoc = map[string]map[string]string{
 	"delete.internetgateway": {
 		"id": "The ID of the Internet gateway to be deleted",
 	},
+	"delete.key": {
+		"id":           "The ID or ARN of the key to be deleted",
+		"pending-days": "The waiting period in days (7 to 30, default: 30) before the key is deleted. Deletion can be canceled during this period",
+	},
 	"delete.keypair": {
 		"name": "The name of the key pair to be deleted",
 	},
@@ -388,17 +517,30 @@ var manualParamsDoc = map[string]map[string]string{
 	"delete.classicloadbalancer": {
 		"name": "The name of the Classic load balancer",
 	},
+	"delete.parameter": {
+		"name": "The name of the parameter to be deleted",
+	},
 	"delete.policy": {
 		"all-versions": "Set to 'true' to delete all existing versions of the policy to be deleted",
 	},
 	"delete.record": {
-		"id":     "The awless id (cf `awless list records`) of the record to delete",
-		"zone":   "The ID of the hosted zone that contains the resource record sets that you want to delete",
-		"name":   "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
-		"type":   "The DNS record type",
-		"value":  "The DNS record value to delete",
-		"values": "The DNS record value(s) to delete",
-		"ttl":    "The resource record cache time to live (TTL), in seconds",
+		"id":             "The awless id (cf `awless list records`) of the record to delete",
+		"zone":           "The ID of the hosted zone that contains the resource record sets that you want to delete",
+		"name":           "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
+		"type":           "The DNS record type",
+		"value":          "The DNS record value to delete",
+		"values":         "The DNS record value(s) to delete",
+		"ttl":            "The resource record cache time to live (TTL), in seconds",
+		"set-identifier": "An identifier differentiating the records sharing the same name and type with a weighted, latency or failover routing policy",
+		"weight":         "The weight of the record among the records sharing the same name and type (weighted routing policy, requires a set-identifier)",
+		"region":         "The AWS region of the resource the record points to (latency routing policy, requires a set-identifier)",
+		"failover":       "Whether the record is the PRIMARY or the SECONDARY one (failover routing policy, requires a set-identifier)",
+		"healthcheck":    "The ID of a health check determining whether the record is healthy enough to be answered",
+	},
+	"delete.replicationgroup": {
+		"id":             "The identifier of the replication group to delete",
+		"retain-primary": "Set to true to delete all the read replicas but keep the primary cluster",
+		"snapshot":       "The name of a final redis snapshot to create before deleting the replication group",
 	},
 	"delete.role": {
 		"name": "The name of the role to be deleted",
@@ -529,6 +671,18 @@ var manualParamsDoc = map[string]map[string]string{
 	"update.instance": {
 		"type": "Changes the instance type to the specified value",
 	},
+	"update.key": {
+		"id":          "The ID or ARN of the key to be updated",
+		"description": "The new description of the key",
+		"enabled":     "Set to 'false' to disable the key, or 'true' to enable it back",
+		"policy-file": "The path to the file containing the new key policy",
+		"rotation":    "Set to 'true' or 'false' to enable or disable the yearly automatic rotation of the key material",
+	},
+	"update.parameter": {
+		"name":        "The name of the parameter to be updated",
+		"value":       "The new value of the parameter (its type and key are unchanged)",
+		"description": "The new description of the parameter",
+	},
 	"update.policy": {
 		"arn":        "The Amazon Resource Name (ARN) of the IAM policy you want to attach",
 		"effect":     "The Effect element is required and specifies whether the policy will result in an allow or an explicit deny",
@@ -537,13 +691,18 @@ var manualParamsDoc = map[string]map[string]string{
 		"conditions": "List of conditions necessary for the policy to be in effect (e.g. [aws:UserAgent!=My user agent,s3:prefix=~home/,aws:CurrentTime>=2013-06-30T00:00:00Z,aws:SourceIp!=203.0.113.0/24,aws:SourceArn==arn:aws:sns:eu-west-1:*:*])",
 	},
 	"update.record": {
-		"zone":    "The ID of the hosted zone that contains the resource record sets that you want to change",
-		"name":    "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
-		"type":    "The DNS record type",
-		"value":   "The current or new DNS record value",
-		"values":  "The current or new DNS record value(s)",
-		"ttl":     "The resource record cache time to live (TTL), in seconds",
-		"comment": "Any comments you want to include about a change batch request",
+		"zone":           "The ID of the hosted zone that contains the resource record sets that you want to change",
+		"name":           "The name of the domain you want to perform the action on. Enter a fully qualified domain name, for example, www.example.com. You can optionally include a trailing dot",
+		"type":           "The DNS record type",
+		"value":          "The current or new DNS record value",
+		"values":         "The current or new DNS record value(s)",
+		"ttl":            "The resource record cache time to live (TTL), in seconds",
+		"comment":        "Any comments you want to include about a change batch request",
+		"set-identifier": "An identifier differentiating the records sharing the same name and type with a weighted, latency or failover routing policy",
+		"weight":         "The weight of the record among the records sharing the same name and type (weighted routing policy, requires a set-identifier)",
+		"region":         "The AWS region of the resource the record points to (latency routing policy, requires a set-identifier)",
+		"failover":       "Whether the record is the PRIMARY or the SECONDARY one (failover routing policy, requires a set-identifier)",
+		"healthcheck":    "The ID of a health check determining whether the record is healthy enough to be answered",
 	},
 	"update.s3object": {
 		"acl":     "The canned ACL to apply to the bucket",
@@ -570,6 +729,11 @@ var manualParamsDoc = map[string]map[string]string{
 		"rollback-triggers":       "List of CloudWatch Alarm ARNs to monitor during and after update",
 		"rollback-monitoring-min": "Time to monitor rollback-triggers during and after update",
 	},
+	"update.table": {
+		"read-capacity":  "The new maximum number of strongly consistent reads consumed per second (to be set with write-capacity)",
+		"write-capacity": "The new maximum number of writes consumed per second (to be set with read-capacity)",
+		"stream":         "Enable DynamoDB Streams on the table with the given view type, or disable them with 'disabled'",
+	},
 	"update.targetgroup": {
 		"id": "The Amazon Resource Name (ARN) of the target group",
 		"deregistrationdelay": "The amount time for Elastic Load Balancing to wait before changing the state of a deregistering target from draining to unused. The range is 0-3600 seconds. The default value is 300 seconds",
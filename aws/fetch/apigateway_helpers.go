@@ -0,0 +1,59 @@
+package awsfetch
+
+import (
+	"regexp"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/wallix/awless/fetch"
+)
+
+func getAllRestApis(cache fetch.Cache, api apigatewayiface.APIGatewayAPI) ([]*apigateway.RestApi, error) {
+	var restApis []*apigateway.RestApi
+	val, err := cache.Get("getAllRestApis", func() (interface{}, error) {
+		err := api.GetRestApisPages(&apigateway.GetRestApisInput{}, func(out *apigateway.GetRestApisOutput, lastPage bool) (shouldContinue bool) {
+			restApis = append(restApis, out.Items...)
+			return out.Position != nil
+		})
+		return restApis, err
+	})
+	if err != nil {
+		return restApis, err
+	}
+	if v, ok := val.([]*apigateway.RestApi); ok {
+		restApis = v
+	}
+	return restApis, nil
+}
+
+// getRestApiFunctions returns the Lambda functions invoked by the integrations of a REST API
+func getRestApiFunctions(api apigatewayiface.APIGatewayAPI, restApiID *string) ([]string, error) {
+	var functions []string
+	input := &apigateway.GetResourcesInput{RestApiId: restApiID, Embed: []*string{awssdk.String("methods")}}
+	err := api.GetResourcesPages(input, func(out *apigateway.GetResourcesOutput, lastPage bool) (shouldContinue bool) {
+		for _, resource := range out.Items {
+			for _, method := range resource.ResourceMethods {
+				if method == nil || method.MethodIntegration == nil || method.MethodIntegration.Uri == nil {
+					continue
+				}
+				for _, arn := range lambdaFunctionArns(*method.MethodIntegration.Uri) {
+					functions = appendIfNotInSlice(functions, arn)
+				}
+			}
+		}
+		return out.Position != nil
+	})
+	return functions, err
+}
+
+var lambdaFunctionArnRegex = regexp.MustCompile(`arn:aws[a-z-]*:lambda:[a-z0-9-]+:[0-9]{12}:function:[a-zA-Z0-9_-]+`)
+
+// lambdaFunctionArns extracts the unqualified ARNs of the Lambda functions referenced in a text,
+// such as an integration URI or a state machine definition.
+func lambdaFunctionArns(text string) (arns []string) {
+	for _, arn := range lambdaFunctionArnRegex.FindAllString(text, -1) {
+		arns = appendIfNotInSlice(arns, arn)
+	}
+	return
+}
@@ -4,23 +4,32 @@ import (
 	"reflect"
 
 	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 
 	"github.com/wallix/awless/logger"
@@ -32,20 +41,29 @@ type AWSAPI struct {
 	Elbv2                  elbv2iface.ELBV2API
 	Elb                    elbiface.ELBAPI
 	Rds                    rdsiface.RDSAPI
+	Elasticache            elasticacheiface.ElastiCacheAPI
 	Autoscaling            autoscalingiface.AutoScalingAPI
 	Ecr                    ecriface.ECRAPI
 	Ecs                    ecsiface.ECSAPI
 	Applicationautoscaling applicationautoscalingiface.ApplicationAutoScalingAPI
 	Sts                    stsiface.STSAPI
 	S3                     s3iface.S3API
+	Efs                    efsiface.EFSAPI
 	Sns                    snsiface.SNSAPI
 	Sqs                    sqsiface.SQSAPI
 	Route53                route53iface.Route53API
 	Lambda                 lambdaiface.LambdaAPI
+	Apigateway             apigatewayiface.APIGatewayAPI
+	Sfn                    sfniface.SFNAPI
 	Cloudwatch             cloudwatchiface.CloudWatchAPI
+	Cloudwatchlogs         cloudwatchlogsiface.CloudWatchLogsAPI
+	Cloudtrail             cloudtrailiface.CloudTrailAPI
 	Cloudfront             cloudfrontiface.CloudFrontAPI
 	Cloudformation         cloudformationiface.CloudFormationAPI
 	Acm                    acmiface.ACMAPI
+	Dynamodb               dynamodbiface.DynamoDBAPI
+	Kms                    kmsiface.KMSAPI
+	Ssm                    ssmiface.SSMAPI
 }
 
 type Config struct {
@@ -75,6 +93,18 @@ func (c *Config) getBoolDefaultTrue(key string) bool {
 	return true
 }
 
+func (c *Config) getBoolDefaultFalse(key string) bool {
+	if c.Extra == nil {
+		return false
+	}
+
+	if b, ok := c.Extra[key].(bool); ok {
+		return b
+	}
+
+	return false
+}
+
 func assignAPIs(c *Config, apis ...interface{}) {
 	c.APIs = new(AWSAPI)
 	val := reflect.ValueOf(c.APIs).Elem()
@@ -0,0 +1,205 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsfetch
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/fetch"
+	"github.com/wallix/awless/graph"
+)
+
+const (
+	datapointsSyncKey     = "aws.monitoring.datapoints.sync"
+	datapointsConcurrency = 8
+)
+
+type datapointsMetric struct {
+	namespace, name, dimension string
+	// prefix of the aggregated properties (ex: CPU for CPUAvg1h, CPUMax1h, ...)
+	prefix string
+}
+
+type datapointsWindow struct {
+	suffix         string
+	span, interval time.Duration
+}
+
+// Metrics synced as aggregated properties of the resources, when enabled with aws.monitoring.datapoints.sync (disabled by default)
+var datapointsMetrics = map[string][]datapointsMetric{
+	cloud.Instance: {
+		{namespace: "AWS/EC2", name: "CPUUtilization", dimension: "InstanceId", prefix: "CPU"},
+		{namespace: "AWS/EC2", name: "NetworkIn", dimension: "InstanceId", prefix: "NetworkIn"},
+		{namespace: "AWS/EC2", name: "NetworkOut", dimension: "InstanceId", prefix: "NetworkOut"},
+	},
+	cloud.Database: {
+		{namespace: "AWS/RDS", name: "CPUUtilization", dimension: "DBInstanceIdentifier", prefix: "CPU"},
+		{namespace: "AWS/RDS", name: "FreeStorageSpace", dimension: "DBInstanceIdentifier", prefix: "FreeStorage"},
+	},
+	cloud.LoadBalancer: {
+		{namespace: "AWS/ApplicationELB", name: "RequestCount", dimension: "LoadBalancer", prefix: "Requests"},
+	},
+}
+
+// Windows of the aggregated properties: the 1h and 24h ones are computed from the same 5 minutes datapoints
+var datapointsWindows = []datapointsWindow{
+	{suffix: "1h", span: time.Hour, interval: 5 * time.Minute},
+	{suffix: "24h", span: 24 * time.Hour, interval: 5 * time.Minute},
+	{suffix: "7d", span: 7 * 24 * time.Hour, interval: time.Hour},
+}
+
+// addDatapointsFetchFuncs wraps the fetch funcs of resources having metrics,
+// to set the average and maximum of their recent datapoints as properties
+func addDatapointsFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
+	for resType, metrics := range datapointsMetrics {
+		fn, ok := funcs[resType]
+		if !ok {
+			continue
+		}
+		funcs[resType] = withDatapoints(conf, resType, metrics, fn)
+	}
+}
+
+func withDatapoints(conf *Config, resType string, metrics []datapointsMetric, fn fetch.Func) fetch.Func {
+	return func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		resources, objects, err := fn(ctx, cache)
+		if err != nil || conf.APIs.Cloudwatch == nil || !conf.getBoolDefaultFalse(datapointsSyncKey) {
+			return resources, objects, err
+		}
+		// datapoints are a best effort: failing to get them (ex: denied access) does not fail the fetch of the resources
+		if err := addDatapoints(ctx, conf, resType, metrics, resources, time.Now().UTC()); err != nil {
+			conf.Log.Warningf("sync: datapoints of %s: %s", resType, err)
+		}
+		return resources, objects, nil
+	}
+}
+
+func addDatapoints(ctx context.Context, conf *Config, resType string, metrics []datapointsMetric, resources []*graph.Resource, now time.Time) error {
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, datapointsConcurrency)
+	errc := make(chan error, len(resources))
+
+	for _, res := range resources {
+		if res.Type() != resType {
+			continue
+		}
+		wg.Add(1)
+		go func(r *graph.Resource) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			for _, m := range metrics {
+				if err := m.setAggregates(ctx, conf, r, now); err != nil {
+					errc <- err
+					return
+				}
+			}
+		}(res)
+	}
+	wg.Wait()
+	close(errc)
+
+	return <-errc
+}
+
+func (m datapointsMetric) setAggregates(ctx context.Context, conf *Config, res *graph.Resource, now time.Time) error {
+	dimValue := m.dimensionValue(res)
+	if dimValue == "" {
+		return nil
+	}
+	fetched := make(map[time.Duration][]*cloudwatch.Datapoint)
+	for _, w := range datapointsWindows {
+		if _, done := fetched[w.interval]; done {
+			continue
+		}
+		span := w.span
+		for _, other := range datapointsWindows {
+			if other.interval == w.interval && other.span > span {
+				span = other.span
+			}
+		}
+		out, err := conf.APIs.Cloudwatch.GetMetricStatisticsWithContext(ctx, &cloudwatch.GetMetricStatisticsInput{
+			Namespace:  awssdk.String(m.namespace),
+			MetricName: awssdk.String(m.name),
+			Dimensions: []*cloudwatch.Dimension{{Name: awssdk.String(m.dimension), Value: awssdk.String(dimValue)}},
+			StartTime:  awssdk.Time(now.Add(-span)),
+			EndTime:    awssdk.Time(now),
+			Period:     awssdk.Int64(int64(w.interval.Seconds())),
+			Statistics: awssdk.StringSlice([]string{cloudwatch.StatisticAverage, cloudwatch.StatisticMaximum, cloudwatch.StatisticSampleCount}),
+		})
+		if err != nil {
+			return err
+		}
+		fetched[w.interval] = out.Datapoints
+	}
+
+	for _, w := range datapointsWindows {
+		avg, max, ok := aggregateDatapoints(fetched[w.interval], now.Add(-w.span))
+		if !ok {
+			continue
+		}
+		res.Properties()[m.prefix+"Avg"+w.suffix] = avg
+		res.Properties()[m.prefix+"Max"+w.suffix] = max
+	}
+	return nil
+}
+
+func (m datapointsMetric) dimensionValue(res *graph.Resource) string {
+	switch m.namespace {
+	case "AWS/ApplicationELB":
+		// ex: arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188 -> app/my-lb/50dc6c495c0c9188
+		splits := strings.SplitN(res.Id(), ":loadbalancer/", 2)
+		if len(splits) != 2 || !strings.HasPrefix(splits[1], "app/") {
+			return ""
+		}
+		return splits[1]
+	default:
+		return res.Id()
+	}
+}
+
+// aggregateDatapoints returns the average (weighted by the samples count) and the maximum
+// of the datapoints since the given time
+func aggregateDatapoints(datapoints []*cloudwatch.Datapoint, since time.Time) (avg, max float64, ok bool) {
+	var sum, samples float64
+	for _, d := range datapoints {
+		if d.Timestamp == nil || d.Timestamp.Before(since) {
+			continue
+		}
+		count := awssdk.Float64Value(d.SampleCount)
+		if count == 0 {
+			continue
+		}
+		sum += awssdk.Float64Value(d.Average) * count
+		samples += count
+		if dmax := awssdk.Float64Value(d.Maximum); !ok || dmax > max {
+			max = dmax
+		}
+		ok = true
+	}
+	if !ok {
+		return
+	}
+	avg = sum / samples
+	return
+}
@@ -0,0 +1,142 @@
+package awsfetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/fetch"
+	"github.com/wallix/awless/graph"
+)
+
+func TestDatapointsFetchFuncs(t *testing.T) {
+	now := time.Now().UTC()
+	mock := &mockCloudwatch{datapoints: map[string][]*cloudwatch.Datapoint{
+		"i-1/CPUUtilization/300": {
+			{Timestamp: awssdk.Time(now.Add(-10 * time.Minute)), Average: awssdk.Float64(10), Maximum: awssdk.Float64(20), SampleCount: awssdk.Float64(5)},
+			{Timestamp: awssdk.Time(now.Add(-2 * time.Hour)), Average: awssdk.Float64(40), Maximum: awssdk.Float64(90), SampleCount: awssdk.Float64(15)},
+		},
+		"i-1/CPUUtilization/3600": {
+			{Timestamp: awssdk.Time(now.Add(-3 * 24 * time.Hour)), Average: awssdk.Float64(50), Maximum: awssdk.Float64(100), SampleCount: awssdk.Float64(60)},
+		},
+	}}
+	instancesFuncs := func() map[string]fetch.Func {
+		return map[string]fetch.Func{
+			cloud.Instance: func(context.Context, fetch.Cache) ([]*graph.Resource, interface{}, error) {
+				return []*graph.Resource{graph.InitResource(cloud.Instance, "i-1"), graph.InitResource(cloud.Instance, "i-2")}, nil, nil
+			},
+		}
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		conf := NewConfig(mock)
+		funcs := instancesFuncs()
+		addDatapointsFetchFuncs(conf, funcs)
+		resources, _, err := funcs[cloud.Instance](context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := resources[0].Properties()[properties.CPUAvg24h]; ok {
+			t.Fatalf("unexpected datapoints properties: %v", resources[0].Properties())
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		conf := NewConfig(mock)
+		conf.Extra[datapointsSyncKey] = false
+		funcs := instancesFuncs()
+		addDatapointsFetchFuncs(conf, funcs)
+		resources, _, err := funcs[cloud.Instance](context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := resources[0].Properties()[properties.CPUAvg24h]; ok {
+			t.Fatalf("unexpected datapoints properties: %v", resources[0].Properties())
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		conf := NewConfig(mock)
+		conf.Extra[datapointsSyncKey] = true
+		funcs := instancesFuncs()
+		addDatapointsFetchFuncs(conf, funcs)
+		resources, _, err := funcs[cloud.Instance](context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := map[string]float64{
+			properties.CPUAvg1h:  10,
+			properties.CPUMax1h:  20,
+			properties.CPUAvg24h: 32.5,
+			properties.CPUMax24h: 90,
+			properties.CPUAvg7d:  50,
+			properties.CPUMax7d:  100,
+		}
+		for prop, want := range expected {
+			if got := resources[0].Properties()[prop]; got != want {
+				t.Fatalf("%s: got %v, want %v", prop, got, want)
+			}
+		}
+		if _, ok := resources[0].Properties()[properties.NetworkInAvg1h]; ok {
+			t.Fatal("unexpected property without datapoints")
+		}
+		if _, ok := resources[1].Properties()[properties.CPUAvg1h]; ok {
+			t.Fatal("unexpected property without datapoints")
+		}
+	})
+
+	t.Run("datapoints error", func(t *testing.T) {
+		conf := NewConfig(&mockCloudwatch{err: errors.New("access denied")})
+		conf.Extra[datapointsSyncKey] = true
+		funcs := instancesFuncs()
+		addDatapointsFetchFuncs(conf, funcs)
+		resources, _, err := funcs[cloud.Instance](context.Background(), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got, want := len(resources), 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	})
+}
+
+func TestDatapointsDimension(t *testing.T) {
+	m := datapointsMetrics[cloud.LoadBalancer][0]
+	tcases := map[string]string{
+		"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188": "app/my-lb/50dc6c495c0c9188",
+		"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/my-lb/50dc6c495c0c9188": "",
+	}
+	for id, want := range tcases {
+		if got := m.dimensionValue(graph.InitResource(cloud.LoadBalancer, id)); got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
+
+type mockCloudwatch struct {
+	cloudwatchiface.CloudWatchAPI
+	datapoints map[string][]*cloudwatch.Datapoint
+	err        error
+}
+
+func (m *mockCloudwatch) GetMetricStatisticsWithContext(ctx awssdk.Context, input *cloudwatch.GetMetricStatisticsInput, opts ...request.Option) (*cloudwatch.GetMetricStatisticsOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	key := awssdk.StringValue(input.Dimensions[0].Value) + "/" + awssdk.StringValue(input.MetricName) + "/" + fmt.Sprint(awssdk.Int64Value(input.Period))
+	var datapoints []*cloudwatch.Datapoint
+	for _, d := range m.datapoints[key] {
+		if !d.Timestamp.Before(*input.StartTime) {
+			datapoints = append(datapoints, d)
+		}
+	}
+	return &cloudwatch.GetMetricStatisticsOutput{Datapoints: datapoints}, nil
+}
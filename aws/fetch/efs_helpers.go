@@ -0,0 +1,32 @@
+package awsfetch
+
+import (
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/wallix/awless/fetch"
+)
+
+func getAllFileSystems(cache fetch.Cache, api efsiface.EFSAPI) ([]*efs.FileSystemDescription, error) {
+	var fileSystems []*efs.FileSystemDescription
+	val, err := cache.Get("getAllFileSystems", func() (interface{}, error) {
+		input := &efs.DescribeFileSystemsInput{}
+		for {
+			out, err := api.DescribeFileSystems(input)
+			if err != nil {
+				return fileSystems, err
+			}
+			fileSystems = append(fileSystems, out.FileSystems...)
+			if out.NextMarker == nil {
+				return fileSystems, nil
+			}
+			input.Marker = out.NextMarker
+		}
+	})
+	if err != nil {
+		return fileSystems, err
+	}
+	if v, ok := val.([]*efs.FileSystemDescription); ok {
+		fileSystems = v
+	}
+	return fileSystems, nil
+}
@@ -29,8 +29,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
@@ -38,6 +40,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/wallix/awless/aws/conv"
 	"github.com/wallix/awless/fetch"
 	"github.com/wallix/awless/graph"
@@ -455,6 +458,58 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 		return resources, objects, nil
 	}
 
+	funcs["vpcpeering"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.VpcPeeringConnection
+
+		if !conf.getBoolDefaultTrue("aws.infra.vpcpeering.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[vpcpeering]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeVpcPeeringConnections(&ec2.DescribeVpcPeeringConnectionsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.VpcPeeringConnections {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
+	funcs["vpcendpoint"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ec2.VpcEndpoint
+
+		if !conf.getBoolDefaultTrue("aws.infra.vpcendpoint.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[vpcendpoint]")
+			return resources, objects, nil
+		}
+
+		out, err := conf.APIs.Ec2.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, output := range out.VpcEndpoints {
+			objects = append(objects, output)
+			res, err := awsconv.NewResource(output)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+
+		return resources, objects, nil
+	}
+
 	funcs["classicloadbalancer"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
 		var objects []*elb.LoadBalancerDescription
@@ -605,6 +660,37 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 		return resources, objects, badResErr
 	}
 
+	funcs["replicationgroup"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*elasticache.ReplicationGroup
+
+		if !conf.getBoolDefaultTrue("aws.infra.replicationgroup.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[replicationgroup]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Elasticache.DescribeReplicationGroupsPages(&elasticache.DescribeReplicationGroupsInput{},
+			func(out *elasticache.DescribeReplicationGroupsOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.ReplicationGroups {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.Marker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
+
 	funcs["launchconfiguration"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
 		var resources []*graph.Resource
 		var objects []*autoscaling.LaunchConfiguration
@@ -759,6 +845,7 @@ func BuildInfraFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+	addDatapointsFetchFuncs(conf, funcs)
 	return funcs
 }
 func BuildAccessFetchFuncs(conf *Config) fetch.Funcs {
@@ -827,12 +914,14 @@ func BuildAccessFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+	addDatapointsFetchFuncs(conf, funcs)
 	return funcs
 }
 func BuildStorageFetchFuncs(conf *Config) fetch.Funcs {
 	funcs := make(map[string]fetch.Func)
 
 	addManualStorageFetchFuncs(conf, funcs)
+	addDatapointsFetchFuncs(conf, funcs)
 	return funcs
 }
 func BuildMessagingFetchFuncs(conf *Config) fetch.Funcs {
@@ -901,6 +990,7 @@ func BuildMessagingFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+	addDatapointsFetchFuncs(conf, funcs)
 	return funcs
 }
 func BuildDnsFetchFuncs(conf *Config) fetch.Funcs {
@@ -938,6 +1028,7 @@ func BuildDnsFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+	addDatapointsFetchFuncs(conf, funcs)
 	return funcs
 }
 func BuildLambdaFetchFuncs(conf *Config) fetch.Funcs {
@@ -975,6 +1066,7 @@ func BuildLambdaFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+	addDatapointsFetchFuncs(conf, funcs)
 	return funcs
 }
 func BuildMonitoringFetchFuncs(conf *Config) fetch.Funcs {
@@ -1043,6 +1135,45 @@ func BuildMonitoringFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+	addDatapointsFetchFuncs(conf, funcs)
+	return funcs
+}
+func BuildLogsFetchFuncs(conf *Config) fetch.Funcs {
+	funcs := make(map[string]fetch.Func)
+
+	addManualLogsFetchFuncs(conf, funcs)
+
+	funcs["loggroup"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*cloudwatchlogs.LogGroup
+
+		if !conf.getBoolDefaultTrue("aws.logs.loggroup.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource logs[loggroup]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Cloudwatchlogs.DescribeLogGroupsPages(&cloudwatchlogs.DescribeLogGroupsInput{},
+			func(out *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.LogGroups {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.NextToken != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
+	addDatapointsFetchFuncs(conf, funcs)
 	return funcs
 }
 func BuildCdnFetchFuncs(conf *Config) fetch.Funcs {
@@ -1080,6 +1211,7 @@ func BuildCdnFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+	addDatapointsFetchFuncs(conf, funcs)
 	return funcs
 }
 func BuildCloudformationFetchFuncs(conf *Config) fetch.Funcs {
@@ -1117,5 +1249,51 @@ func BuildCloudformationFetchFuncs(conf *Config) fetch.Funcs {
 
 		return resources, objects, badResErr
 	}
+	addDatapointsFetchFuncs(conf, funcs)
+	return funcs
+}
+func BuildDynamodbFetchFuncs(conf *Config) fetch.Funcs {
+	funcs := make(map[string]fetch.Func)
+
+	addManualDynamodbFetchFuncs(conf, funcs)
+	addDatapointsFetchFuncs(conf, funcs)
+	return funcs
+}
+func BuildSecurityFetchFuncs(conf *Config) fetch.Funcs {
+	funcs := make(map[string]fetch.Func)
+
+	addManualSecurityFetchFuncs(conf, funcs)
+
+	funcs["parameter"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*ssm.ParameterMetadata
+
+		if !conf.getBoolDefaultTrue("aws.security.parameter.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource security[parameter]")
+			return resources, objects, nil
+		}
+		var badResErr error
+		err := conf.APIs.Ssm.DescribeParametersPages(&ssm.DescribeParametersInput{},
+			func(out *ssm.DescribeParametersOutput, lastPage bool) (shouldContinue bool) {
+				for _, output := range out.Parameters {
+					if badResErr != nil {
+						return false
+					}
+					objects = append(objects, output)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(output); badResErr != nil {
+						return false
+					}
+					resources = append(resources, res)
+				}
+				return out.NextToken != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		return resources, objects, badResErr
+	}
+	addDatapointsFetchFuncs(conf, funcs)
 	return funcs
 }
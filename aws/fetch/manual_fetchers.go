@@ -10,11 +10,17 @@ import (
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/wallix/awless/aws/conv"
 	"github.com/wallix/awless/cloud"
@@ -362,6 +368,59 @@ func addManualInfraFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 			}
 		}
 	}
+
+	funcs["cachecluster"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*elasticache.CacheCluster
+
+		if !conf.getBoolDefaultTrue("aws.infra.cachecluster.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource infra[cachecluster]")
+			return resources, objects, nil
+		}
+
+		// clusters only reference their subnet group by name: subnets and vpc are resolved from it
+		subnetGroups := make(map[string]*elasticache.CacheSubnetGroup)
+		err := conf.APIs.Elasticache.DescribeCacheSubnetGroupsPages(&elasticache.DescribeCacheSubnetGroupsInput{},
+			func(out *elasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) (shouldContinue bool) {
+				for _, group := range out.CacheSubnetGroups {
+					subnetGroups[awssdk.StringValue(group.CacheSubnetGroupName)] = group
+				}
+				return out.Marker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		var badResErr error
+		err = conf.APIs.Elasticache.DescribeCacheClustersPages(&elasticache.DescribeCacheClustersInput{},
+			func(out *elasticache.DescribeCacheClustersOutput, lastPage bool) (shouldContinue bool) {
+				for _, cluster := range out.CacheClusters {
+					objects = append(objects, cluster)
+					var res *graph.Resource
+					if res, badResErr = awsconv.NewResource(cluster); badResErr != nil {
+						return false
+					}
+					if group, ok := subnetGroups[awssdk.StringValue(cluster.CacheSubnetGroupName)]; ok {
+						var subnets []string
+						for _, subnet := range group.Subnets {
+							subnets = append(subnets, awssdk.StringValue(subnet.SubnetIdentifier))
+						}
+						if len(subnets) > 0 {
+							res.Properties()[properties.Subnets] = subnets
+						}
+						if vpc := awssdk.StringValue(group.VpcId); vpc != "" {
+							res.Properties()[properties.Vpc] = vpc
+						}
+					}
+					resources = append(resources, res)
+				}
+				return out.Marker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+		return resources, objects, badResErr
+	}
 }
 
 func addManualAccessFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
@@ -676,6 +735,12 @@ func addManualStorageFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 				return fmt.Errorf("fetching grants for bucket %s: %s", awssdk.StringValue(b.Name), err)
 			}
 			res.Properties()[properties.Grants] = grants
+			encryptionKey, err := fetchBucketEncryptionKey(ctx, conf.APIs.S3, awssdk.StringValue(b.Name))
+			if err != nil { // ex: AccessDenied, the bucket is still listed without its encryption key
+				conf.Log.Warningf("sync: fetching encryption for bucket %s: %s", awssdk.StringValue(b.Name), err)
+			} else if encryptionKey != "" {
+				res.Properties()[properties.EncryptionKey] = encryptionKey
+			}
 			bucketM.Lock()
 			resources = append(resources, res)
 			bucketM.Unlock()
@@ -695,11 +760,27 @@ func addManualStorageFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 			return resources, objects, nil
 		}
 
+		var streamErr error
 		var wg sync.WaitGroup
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			for r := range resourcesC {
+				// once streaming failed, the remaining resources are only drained for the fetchers not to block
+				if streamErr != nil {
+					continue
+				}
+				// objects can be numerous: they are streamed when possible, parent buckets are returned
+				if r.Type() == cloud.S3Object {
+					streamed, err := fetch.Stream(ctx, r)
+					if err != nil {
+						streamErr = err
+						continue
+					}
+					if streamed {
+						continue
+					}
+				}
 				resources = append(resources, r)
 			}
 		}()
@@ -712,8 +793,80 @@ func addManualStorageFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 
 		wg.Wait()
 
+		if err == nil {
+			err = streamErr
+		}
+
 		return resources, objects, err
 	}
+
+	funcs["filesystem"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*efs.FileSystemDescription
+
+		if !conf.getBoolDefaultTrue("aws.storage.filesystem.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource storage[filesystem]")
+			return resources, objects, nil
+		}
+
+		fileSystems, err := getAllFileSystems(cache, conf.APIs.Efs)
+		if err != nil {
+			return resources, objects, err
+		}
+		for _, fs := range fileSystems {
+			objects = append(objects, fs)
+			res, err := awsconv.NewResource(fs)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
+
+	funcs["mounttarget"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*efs.MountTargetDescription
+
+		if !conf.getBoolDefaultTrue("aws.storage.mounttarget.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource storage[mounttarget]")
+			return resources, objects, nil
+		}
+
+		fileSystems, err := getAllFileSystems(cache, conf.APIs.Efs)
+		if err != nil {
+			return resources, objects, err
+		}
+		for _, fs := range fileSystems {
+			input := &efs.DescribeMountTargetsInput{FileSystemId: fs.FileSystemId}
+			for {
+				out, err := conf.APIs.Efs.DescribeMountTargets(input)
+				if err != nil {
+					return resources, objects, err
+				}
+				for _, target := range out.MountTargets {
+					objects = append(objects, target)
+					res, err := awsconv.NewResource(target)
+					if err != nil {
+						return resources, objects, err
+					}
+					groups, err := conf.APIs.Efs.DescribeMountTargetSecurityGroups(&efs.DescribeMountTargetSecurityGroupsInput{MountTargetId: target.MountTargetId})
+					if err != nil {
+						return resources, objects, err
+					}
+					if len(groups.SecurityGroups) > 0 {
+						res.Properties()[properties.SecurityGroups] = awssdk.StringValueSlice(groups.SecurityGroups)
+					}
+					resources = append(resources, res)
+				}
+				if out.NextMarker == nil {
+					break
+				}
+				input.Marker = out.NextMarker
+			}
+		}
+		return resources, objects, nil
+	}
 }
 func addManualMessagingFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 	funcs["queue"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
@@ -776,6 +929,8 @@ func addManualMessagingFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 						}
 					case "QueueArn":
 						res.Properties()[properties.Arn] = awssdk.StringValue(v)
+					case "KmsMasterKeyId":
+						res.Properties()[properties.EncryptionKey] = awssdk.StringValue(v)
 					case "DelaySeconds":
 						delay, err := strconv.Atoi(awssdk.StringValue(v))
 						if err != nil {
@@ -829,6 +984,8 @@ func addManualDnsFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 		}
 
 		zoneName, hasZoneFilter := getUserFiltersFromContext(ctx)["zone"]
+		// records can be numerous: they are streamed when possible, and their objects not kept
+		streaming := fetch.IsStreaming(ctx)
 
 		errC := make(chan error)
 		zoneC := make(chan *route53.HostedZone)
@@ -877,6 +1034,9 @@ func addManualDnsFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 									errC <- err
 								}
 								res.AddRelation(rdf.ChildrenOfRel, parent)
+								if output.HealthCheckId != nil {
+									res.AddRelation(rdf.DependingOnRel, graph.InitResource(cloud.HealthCheck, awssdk.StringValue(output.HealthCheckId)))
+								}
 								resourcesC <- res
 							}
 							return out.NextRecordName != nil
@@ -904,21 +1064,319 @@ func addManualDnsFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 				if !ok {
 					return resources, objects, nil
 				}
-				objects = append(objects, o)
+				if !streaming {
+					objects = append(objects, o)
+				}
 			case r, ok := <-resourcesC:
 				if !ok {
 					return resources, objects, nil
 				}
+				if streaming {
+					if _, err := fetch.Stream(ctx, r); err != nil {
+						return resources, objects, err
+					}
+					continue
+				}
 				resources = append(resources, r)
 			}
 		}
 	}
+
+	funcs["healthcheck"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*route53.HealthCheck
+
+		if !conf.getBoolDefaultTrue("aws.dns.healthcheck.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource dns[healthcheck]")
+			return resources, objects, nil
+		}
+
+		err := conf.APIs.Route53.ListHealthChecksPages(&route53.ListHealthChecksInput{},
+			func(out *route53.ListHealthChecksOutput, lastPage bool) (shouldContinue bool) {
+				objects = append(objects, out.HealthChecks...)
+				return out.NextMarker != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		// health checks are named through their tags only
+		var ids []*string
+		for _, check := range objects {
+			ids = append(ids, check.Id)
+		}
+		names, err := getHealthCheckNames(conf.APIs.Route53, ids)
+		if err != nil {
+			return resources, objects, err
+		}
+		for _, check := range objects {
+			res, err := awsconv.NewResource(check)
+			if err != nil {
+				return resources, objects, err
+			}
+			if name, ok := names[awssdk.StringValue(check.Id)]; ok {
+				res.Properties()[properties.Name] = name
+			}
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
 }
 func addManualLambdaFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
+	funcs["restapi"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*apigateway.RestApi
+
+		if !conf.getBoolDefaultTrue("aws.lambda.restapi.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource lambda[restapi]")
+			return resources, objects, nil
+		}
+
+		restApis, err := getAllRestApis(cache, conf.APIs.Apigateway)
+		if err != nil {
+			return resources, objects, err
+		}
+		for _, restApi := range restApis {
+			objects = append(objects, restApi)
+			res, err := awsconv.NewResource(restApi)
+			if err != nil {
+				return resources, objects, err
+			}
+			functions, err := getRestApiFunctions(conf.APIs.Apigateway, restApi.Id)
+			if err != nil {
+				return resources, objects, err
+			}
+			if len(functions) > 0 {
+				res.Properties()[properties.Functions] = functions
+			}
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
+
+	funcs["stage"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*apigateway.Stage
+
+		if !conf.getBoolDefaultTrue("aws.lambda.stage.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource lambda[stage]")
+			return resources, objects, nil
+		}
+
+		restApis, err := getAllRestApis(cache, conf.APIs.Apigateway)
+		if err != nil {
+			return resources, objects, err
+		}
+		for _, restApi := range restApis {
+			out, err := conf.APIs.Apigateway.GetStages(&apigateway.GetStagesInput{RestApiId: restApi.Id})
+			if err != nil {
+				return resources, objects, err
+			}
+			parent, err := awsconv.InitResource(restApi)
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, stage := range out.Item {
+				objects = append(objects, stage)
+				res, err := awsconv.NewResource(stage)
+				if err != nil {
+					return resources, objects, err
+				}
+				res.Properties()[properties.RestApi] = awssdk.StringValue(restApi.Id)
+				res.AddRelation(rdf.ChildrenOfRel, parent)
+				resources = append(resources, res)
+			}
+		}
+		return resources, objects, nil
+	}
+
+	funcs["statemachine"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*sfn.DescribeStateMachineOutput
+
+		if !conf.getBoolDefaultTrue("aws.lambda.statemachine.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource lambda[statemachine]")
+			return resources, objects, nil
+		}
+
+		var arns []*string
+		err := conf.APIs.Sfn.ListStateMachinesPages(&sfn.ListStateMachinesInput{},
+			func(out *sfn.ListStateMachinesOutput, lastPage bool) (shouldContinue bool) {
+				for _, item := range out.StateMachines {
+					arns = append(arns, item.StateMachineArn)
+				}
+				return out.NextToken != nil
+			})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		// only the full description holds the definition, in which invoked functions are found
+		for _, arn := range arns {
+			machine, err := conf.APIs.Sfn.DescribeStateMachine(&sfn.DescribeStateMachineInput{StateMachineArn: arn})
+			if err != nil {
+				return resources, objects, err
+			}
+			objects = append(objects, machine)
+			res, err := awsconv.NewResource(machine)
+			if err != nil {
+				return resources, objects, err
+			}
+			if functions := lambdaFunctionArns(awssdk.StringValue(machine.Definition)); len(functions) > 0 {
+				res.Properties()[properties.Functions] = functions
+			}
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
 }
 func addManualMonitoringFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 }
+func addManualLogsFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
+}
 func addManualCdnFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 }
 func addManualCloudformationFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
 }
+func addManualDynamodbFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
+	funcs["table"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*dynamodb.TableDescription
+
+		if !conf.getBoolDefaultTrue("aws.dynamodb.table.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource dynamodb[table]")
+			return resources, objects, nil
+		}
+
+		var names []*string
+		err := conf.APIs.Dynamodb.ListTablesPages(&dynamodb.ListTablesInput{}, func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+			names = append(names, page.TableNames...)
+			return page.LastEvaluatedTableName != nil
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, name := range names {
+			out, err := conf.APIs.Dynamodb.DescribeTable(&dynamodb.DescribeTableInput{TableName: name})
+			if e, ok := err.(awserr.Error); ok && e.Code() == dynamodb.ErrCodeResourceNotFoundException {
+				continue
+			}
+			if err != nil {
+				return resources, objects, err
+			}
+			objects = append(objects, out.Table)
+			res, err := awsconv.NewResource(out.Table)
+			if err != nil {
+				return resources, objects, err
+			}
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
+
+	funcs["globaltable"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*dynamodb.GlobalTableDescription
+
+		if !conf.getBoolDefaultTrue("aws.dynamodb.globaltable.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource dynamodb[globaltable]")
+			return resources, objects, nil
+		}
+
+		input := &dynamodb.ListGlobalTablesInput{}
+		for {
+			out, err := conf.APIs.Dynamodb.ListGlobalTables(input)
+			if err != nil {
+				return resources, objects, err
+			}
+			for _, global := range out.GlobalTables {
+				desc, err := conf.APIs.Dynamodb.DescribeGlobalTable(&dynamodb.DescribeGlobalTableInput{GlobalTableName: global.GlobalTableName})
+				if err != nil {
+					return resources, objects, err
+				}
+				objects = append(objects, desc.GlobalTableDescription)
+				res, err := awsconv.NewResource(desc.GlobalTableDescription)
+				if err != nil {
+					return resources, objects, err
+				}
+				resources = append(resources, res)
+			}
+			if out.LastEvaluatedGlobalTableName == nil {
+				break
+			}
+			input.ExclusiveStartGlobalTableName = out.LastEvaluatedGlobalTableName
+		}
+		return resources, objects, nil
+	}
+}
+
+func addManualSecurityFetchFuncs(conf *Config, funcs map[string]fetch.Func) {
+	funcs["key"] = func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+		var resources []*graph.Resource
+		var objects []*kms.KeyMetadata
+
+		if !conf.getBoolDefaultTrue("aws.security.key.sync") && !getBoolFromContext(ctx, "force") {
+			conf.Log.Verbose("sync: *disabled* for resource security[key]")
+			return resources, objects, nil
+		}
+
+		aliases := make(map[string][]string)
+		err := conf.APIs.Kms.ListAliasesPages(&kms.ListAliasesInput{}, func(page *kms.ListAliasesOutput, lastPage bool) bool {
+			for _, alias := range page.Aliases {
+				if keyID := awssdk.StringValue(alias.TargetKeyId); keyID != "" {
+					aliases[keyID] = append(aliases[keyID], awssdk.StringValue(alias.AliasName))
+				}
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		var keys []*kms.KeyListEntry
+		err = conf.APIs.Kms.ListKeysPages(&kms.ListKeysInput{}, func(page *kms.ListKeysOutput, lastPage bool) bool {
+			keys = append(keys, page.Keys...)
+			return !lastPage
+		})
+		if err != nil {
+			return resources, objects, err
+		}
+
+		for _, key := range keys {
+			out, err := conf.APIs.Kms.DescribeKey(&kms.DescribeKeyInput{KeyId: key.KeyId})
+			if e, ok := err.(awserr.Error); ok && e.Code() == kms.ErrCodeNotFoundException {
+				continue
+			}
+			if err != nil {
+				return resources, objects, err
+			}
+			objects = append(objects, out.KeyMetadata)
+			res, err := awsconv.NewResource(out.KeyMetadata)
+			if err != nil {
+				return resources, objects, err
+			}
+			if keyAliases, ok := aliases[res.Id()]; ok {
+				res.Properties()[properties.Aliases] = keyAliases
+			}
+			// Rotation status and policy cannot be read from keys disabled or pending deletion
+			if awssdk.StringValue(out.KeyMetadata.KeyState) == kms.KeyStateEnabled {
+				rotation, err := conf.APIs.Kms.GetKeyRotationStatus(&kms.GetKeyRotationStatusInput{KeyId: key.KeyId})
+				switch e, ok := err.(awserr.Error); {
+				case ok && e.Code() == kms.ErrCodeUnsupportedOperationException: // keys with imported material are not rotated
+				case err != nil:
+					return resources, objects, err
+				default:
+					res.Properties()[properties.RotationEnabled] = awssdk.BoolValue(rotation.KeyRotationEnabled)
+				}
+				policy, err := conf.APIs.Kms.GetKeyPolicy(&kms.GetKeyPolicyInput{KeyId: key.KeyId, PolicyName: awssdk.String("default")})
+				if err != nil {
+					return resources, objects, err
+				}
+				res.Properties()[properties.Document] = awssdk.StringValue(policy.Policy)
+			}
+			resources = append(resources, res)
+		}
+		return resources, objects, nil
+	}
+}
@@ -0,0 +1,36 @@
+package awsfetch
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+)
+
+// route53 lists the tags of at most 10 resources per call
+const maxTaggedHealthChecksPerCall = 10
+
+// getHealthCheckNames returns the 'Name' tags of the given health checks, per health check id
+func getHealthCheckNames(api route53iface.Route53API, ids []*string) (map[string]string, error) {
+	names := make(map[string]string)
+	for start := 0; start < len(ids); start += maxTaggedHealthChecksPerCall {
+		end := start + maxTaggedHealthChecksPerCall
+		if end > len(ids) {
+			end = len(ids)
+		}
+		out, err := api.ListTagsForResources(&route53.ListTagsForResourcesInput{
+			ResourceType: awssdk.String(route53.TagResourceTypeHealthcheck),
+			ResourceIds:  ids[start:end],
+		})
+		if err != nil {
+			return names, err
+		}
+		for _, set := range out.ResourceTagSets {
+			for _, tag := range set.Tags {
+				if awssdk.StringValue(tag.Key) == "Name" {
+					names[awssdk.StringValue(set.ResourceId)] = awssdk.StringValue(tag.Value)
+				}
+			}
+		}
+	}
+	return names, nil
+}
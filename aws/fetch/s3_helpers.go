@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/wallix/awless/aws/conv"
@@ -53,6 +54,11 @@ func forEachBucketParallel(ctx context.Context, cache fetch.Cache, api s3iface.S
 }
 
 func fetchObjectsForBucket(ctx context.Context, api s3iface.S3API, bucket *s3.Bucket, resourcesC chan<- *graph.Resource) error {
+	parent, err := awsconv.InitResource(bucket)
+	if err != nil {
+		return err
+	}
+
 	objectc := make(chan []*s3.Object)
 	errc := make(chan error)
 
@@ -69,23 +75,22 @@ func fetchObjectsForBucket(ctx context.Context, api s3iface.S3API, bucket *s3.Bu
 		}
 	}()
 
-	processObjects := func(objs []*s3.Object) {
+	var parentSent bool
+	processObjects := func(objs []*s3.Object) error {
 		for _, output := range objs {
 			res, err := awsconv.NewResource(output)
 			if err != nil {
-				errc <- err
-				return
+				return err
 			}
 			res.SetProperty("Bucket", awssdk.StringValue(bucket.Name))
+			res.AddRelation(rdf.ChildrenOfRel, parent)
 			resourcesC <- res
-			parent, err := awsconv.InitResource(bucket)
-			if err != nil {
-				errc <- err
-				return
+			if !parentSent {
+				resourcesC <- parent
+				parentSent = true
 			}
-			res.AddRelation(rdf.ChildrenOfRel, parent)
-			resourcesC <- parent
 		}
+		return nil
 	}
 
 	go func() {
@@ -102,7 +107,9 @@ func fetchObjectsForBucket(ctx context.Context, api s3iface.S3API, bucket *s3.Bu
 			if !ok {
 				return nil
 			}
-			processObjects(objects)
+			if err := processObjects(objects); err != nil {
+				return err
+			}
 		}
 	}
 }
@@ -211,3 +218,25 @@ func fetchAndExtractGrantsFn(ctx context.Context, api s3iface.S3API, bucketName
 	}
 	return grants, nil
 }
+
+// fetchBucketEncryptionKey returns the KMS key encrypting by default the objects of a bucket, if any
+func fetchBucketEncryptionKey(ctx context.Context, api s3iface.S3API, bucketName string) (string, error) {
+	out, err := api.GetBucketEncryption(&s3.GetBucketEncryptionInput{Bucket: awssdk.String(bucketName)})
+	if e, ok := err.(awserr.Error); ok && e.Code() == "ServerSideEncryptionConfigurationNotFoundError" {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if out.ServerSideEncryptionConfiguration == nil {
+		return "", nil
+	}
+	for _, rule := range out.ServerSideEncryptionConfiguration.Rules {
+		if rule.ApplyServerSideEncryptionByDefault != nil {
+			if keyID := awssdk.StringValue(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID); keyID != "" {
+				return keyID, nil
+			}
+		}
+	}
+	return "", nil
+}
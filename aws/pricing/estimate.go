@@ -0,0 +1,152 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+)
+
+// PricedTypes are the resource types whose monthly cost can be estimated
+var PricedTypes = []string{
+	cloud.Instance, cloud.Volume, cloud.Snapshot, cloud.ElasticIP, cloud.NatGateway,
+	cloud.LoadBalancer, cloud.ClassicLoadBalancer, cloud.Database,
+}
+
+// Priced reports whether the monthly cost of resources of this type can be estimated
+func Priced(resourceType string) bool {
+	for _, typ := range PricedTypes {
+		if typ == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// MonthlyCost returns the estimated monthly cost (in USD) of a resource,
+// or false if the resource type is not priced or its price is not in the list
+func (l *PriceList) MonthlyCost(res cloud.Resource) (float64, bool) {
+	props := res.Properties()
+	hourly := func(key string) (float64, bool) {
+		price, ok := l.Hourly[key]
+		return price * HoursPerMonth, ok
+	}
+
+	switch res.Type() {
+	case cloud.Instance:
+		if state := fmt.Sprint(props[properties.State]); state == "stopped" || state == "terminated" {
+			return 0, true
+		}
+		return hourly("instance/" + fmt.Sprint(props[properties.Type]))
+	case cloud.Volume:
+		typ := fmt.Sprint(props[properties.Type])
+		price, ok := l.Monthly["volume/"+typ]
+		if !ok {
+			return 0, false
+		}
+		cost := price * toFloat(props[properties.Size])
+		if typ == "io1" {
+			cost += l.Monthly["volume-iops/io1"] * toFloat(props[properties.IOPS])
+		}
+		return cost, true
+	case cloud.Snapshot:
+		price, ok := l.Monthly["snapshot"]
+		return price * toFloat(props[properties.Size]), ok
+	case cloud.ElasticIP:
+		if assoc, _ := props[properties.Association].(string); assoc != "" {
+			return 0, true
+		}
+		return hourly("elasticip/idle")
+	case cloud.NatGateway:
+		return hourly("natgateway")
+	case cloud.ClassicLoadBalancer:
+		return hourly("classicloadbalancer")
+	case cloud.LoadBalancer:
+		typ := fmt.Sprint(props[properties.Type])
+		if typ == "<nil>" || typ == "" {
+			typ = "application"
+		}
+		return hourly("loadbalancer/" + typ)
+	case cloud.Database:
+		deploy := "single-az"
+		if toBool(props[properties.MultiAZ]) {
+			deploy = "multi-az"
+		}
+		engine := fmt.Sprint(props[properties.Engine])
+		if engine == "aurora" {
+			engine = "aurora-mysql"
+		}
+		cost, ok := hourly(databaseKey(fmt.Sprint(props[properties.Class]), engine, deploy, license(fmt.Sprint(props[properties.License]))))
+		if !ok {
+			return 0, false
+		}
+		if strings.HasPrefix(engine, "aurora") {
+			return cost, true
+		}
+		// storage is charged per GB at the price of its type, plus the provisioned IOPS for io1
+		storageType, _ := props[properties.StorageType].(string)
+		if storageType == "" {
+			storageType = "gp2"
+			if toFloat(props[properties.IOPS]) > 0 {
+				storageType = "io1"
+			}
+		}
+		price, ok := l.Monthly["database-storage/"+storageType+"/"+deploy]
+		if !ok {
+			return 0, false
+		}
+		cost += price * toFloat(props[properties.Storage])
+		if storageType == "io1" {
+			if price, ok = l.Monthly["database-iops/"+deploy]; !ok {
+				return 0, false
+			}
+			cost += price * toFloat(props[properties.IOPS])
+		}
+		return cost, true
+	}
+	return 0, false
+}
+
+func toFloat(i interface{}) float64 {
+	switch v := i.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	case string:
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	}
+	return 0
+}
+
+func toBool(i interface{}) bool {
+	switch v := i.(type) {
+	case bool:
+		return v
+	case string:
+		b, _ := strconv.ParseBool(v)
+		return b
+	}
+	return false
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Offers of the AWS price list used to estimate the cost of resources
+var Offers = []string{"AmazonEC2", "AmazonRDS"}
+
+// OfferURL returns the URL of the current regional offer file of an AWS service
+func OfferURL(offer, region string) string {
+	return fmt.Sprintf("https://pricing.us-east-1.amazonaws.com/offers/v1.0/aws/%s/current/%s/index.json", offer, region)
+}
+
+// Download builds the price list of a region from its current AWS offers
+func Download(region string) (*PriceList, error) {
+	l := NewPriceList(region)
+	client := &http.Client{Timeout: 5 * time.Minute}
+	for _, offer := range Offers {
+		if err := l.downloadOffer(client, OfferURL(offer, region)); err != nil {
+			return l, fmt.Errorf("%s offer: %s", offer, err)
+		}
+	}
+	l.Updated = time.Now().UTC()
+	return l, nil
+}
+
+// ReadOfferFiles builds the price list of a region from local AWS offer files
+func ReadOfferFiles(region string, paths ...string) (*PriceList, error) {
+	l := NewPriceList(region)
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return l, err
+		}
+		err = l.ReadOffer(f)
+		f.Close()
+		if err != nil {
+			return l, fmt.Errorf("%s: %s", path, err)
+		}
+	}
+	l.Updated = time.Now().UTC()
+	return l, nil
+}
+
+func (l *PriceList) downloadOffer(client *http.Client, url string) error {
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return l.ReadOffer(resp.Body)
+}
+
+type offerProduct struct {
+	Sku           string            `json:"sku"`
+	ProductFamily string            `json:"productFamily"`
+	Attributes    map[string]string `json:"attributes"`
+}
+
+type offerTerm struct {
+	PriceDimensions map[string]struct {
+		Unit         string            `json:"unit"`
+		BeginRange   string            `json:"beginRange"`
+		PricePerUnit map[string]string `json:"pricePerUnit"`
+	} `json:"priceDimensions"`
+}
+
+// ReadOffer reads an AWS offer file (JSON), adding to the price list the on-demand
+// prices of the products of its region awless knows how to apply.
+// Offer files being large, they are streamed rather than fully unmarshalled.
+func (l *PriceList) ReadOffer(r io.Reader) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	skus := make(map[string]string)
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "products":
+			err = decodeObject(dec, func(string) error {
+				var p offerProduct
+				if err := dec.Decode(&p); err != nil {
+					return err
+				}
+				if k := l.productKey(p); k != "" {
+					skus[p.Sku] = k
+				}
+				return nil
+			})
+		case "terms":
+			err = decodeObject(dec, func(termType string) error {
+				if termType != "OnDemand" {
+					return skipValue(dec)
+				}
+				return decodeObject(dec, func(sku string) error {
+					k, ok := skus[sku]
+					if !ok {
+						return skipValue(dec)
+					}
+					var terms map[string]offerTerm
+					if err := dec.Decode(&terms); err != nil {
+						return err
+					}
+					l.setTermsPrice(k, terms)
+					return nil
+				})
+			})
+		default:
+			err = skipValue(dec)
+		}
+		if err != nil {
+			return fmt.Errorf("reading offer: %s", err)
+		}
+	}
+	return nil
+}
+
+func (l *PriceList) setTermsPrice(key string, terms map[string]offerTerm) {
+	for _, term := range terms {
+		for _, dim := range term.PriceDimensions {
+			if dim.BeginRange != "" && dim.BeginRange != "0" {
+				continue
+			}
+			price, err := strconv.ParseFloat(dim.PricePerUnit["USD"], 64)
+			if err != nil {
+				continue
+			}
+			l.setPrice(key, dim.Unit, price)
+		}
+	}
+}
+
+// productKey returns the key of the price of a product in the price list, or "" if the product is ignored
+func (l *PriceList) productKey(p offerProduct) string {
+	attr := p.Attributes
+	if code, ok := attr["regionCode"]; ok && code != l.Region {
+		return ""
+	}
+	usage := attr["usagetype"]
+	switch p.ProductFamily {
+	case "Compute Instance":
+		if attr["operatingSystem"] != "Linux" || attr["tenancy"] != "Shared" || attr["preInstalledSw"] != "NA" {
+			return ""
+		}
+		if status := attr["capacitystatus"]; status != "" && status != "Used" {
+			return ""
+		}
+		return "instance/" + attr["instanceType"]
+	case "Storage":
+		if api := volumeApiName(attr); api != "" {
+			return "volume/" + api
+		}
+	case "System Operation":
+		if attr["group"] == "EBS IOPS" && attr["volumeType"] == "Provisioned IOPS" {
+			return "volume-iops/io1"
+		}
+	case "Storage Snapshot":
+		if strings.HasSuffix(usage, "EBS:SnapshotUsage") {
+			return "snapshot"
+		}
+	case "IP Address":
+		if strings.HasSuffix(usage, "ElasticIP:IdleAddress") {
+			return "elasticip/idle"
+		}
+	case "NAT Gateway":
+		if strings.HasSuffix(usage, "NatGateway-Hours") {
+			return "natgateway"
+		}
+	case "Load Balancer":
+		if strings.HasSuffix(usage, "LoadBalancerUsage") {
+			return "classicloadbalancer"
+		}
+	case "Load Balancer-Application":
+		if strings.HasSuffix(usage, "LoadBalancerUsage") {
+			return "loadbalancer/application"
+		}
+	case "Load Balancer-Network":
+		if strings.HasSuffix(usage, "LoadBalancerUsage") {
+			return "loadbalancer/network"
+		}
+	case "Database Instance":
+		engine := rdsEngine(attr["databaseEngine"], attr["databaseEdition"])
+		if engine == "" {
+			return ""
+		}
+		return databaseKey(attr["instanceType"], engine, deployment(attr["deploymentOption"]), license(attr["licenseModel"]))
+	case "Database Storage":
+		if strings.Contains(attr["databaseEngine"], "Aurora") {
+			return ""
+		}
+		if api := volumeApiName(attr); api != "" {
+			return "database-storage/" + api + "/" + deployment(attr["deploymentOption"])
+		}
+	case "Provisioned IOPS":
+		if attr["databaseEngine"] != "" && !strings.Contains(attr["databaseEngine"], "Aurora") {
+			return "database-iops/" + deployment(attr["deploymentOption"])
+		}
+	}
+	return ""
+}
+
+func volumeApiName(attr map[string]string) string {
+	if api := attr["volumeApiName"]; api != "" {
+		return api
+	}
+	switch attr["volumeType"] {
+	case "General Purpose":
+		return "gp2"
+	case "Provisioned IOPS":
+		return "io1"
+	case "Throughput Optimized HDD":
+		return "st1"
+	case "Cold HDD":
+		return "sc1"
+	case "Magnetic":
+		return "standard"
+	}
+	return ""
+}
+
+// rdsEngine returns the RDS engine identifier (as in the Engine property of databases) of an offer engine
+func rdsEngine(engine, edition string) string {
+	switch engine {
+	case "MySQL":
+		return "mysql"
+	case "MariaDB":
+		return "mariadb"
+	case "PostgreSQL":
+		return "postgres"
+	case "Aurora MySQL":
+		return "aurora-mysql"
+	case "Aurora PostgreSQL":
+		return "aurora-postgresql"
+	case "Oracle":
+		switch edition {
+		case "Standard Two":
+			return "oracle-se2"
+		case "Standard One":
+			return "oracle-se1"
+		case "Standard":
+			return "oracle-se"
+		case "Enterprise":
+			return "oracle-ee"
+		}
+	case "SQL Server":
+		switch edition {
+		case "Express":
+			return "sqlserver-ex"
+		case "Web":
+			return "sqlserver-web"
+		case "Standard":
+			return "sqlserver-se"
+		case "Enterprise":
+			return "sqlserver-ee"
+		}
+	}
+	return ""
+}
+
+func deployment(option string) string {
+	if strings.HasPrefix(option, "Multi-AZ") {
+		return "multi-az"
+	}
+	return "single-az"
+}
+
+func license(model string) string {
+	if strings.EqualFold(model, "Bring your own license") || model == "bring-your-own-license" {
+		return "byol"
+	}
+	return "included"
+}
+
+func databaseKey(class, engine, deployment, license string) string {
+	return strings.Join([]string{"database", class, engine, deployment, license}, "/")
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("invalid offer: expected '%s', got %v", delim, tok)
+	}
+	return nil
+}
+
+// decodeObject calls fn for each key of the next JSON object, fn being responsible for consuming the key value
+func decodeObject(dec *json.Decoder, fn func(key string) error) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("invalid offer: unexpected key %v", tok)
+		}
+		if err = fn(key); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+func skipValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package pricing estimates the monthly cost of synced resources from the on-demand prices of the public AWS price list.
+
+The AWS offer files (JSON) are compacted per region into a small price list (only the prices awless knows how
+to apply) stored in $HOME/.awless/pricing/<region>.json, so that estimates are computed offline.
+*/
+package pricing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// HoursPerMonth is the average number of hours in a month used by AWS to convert hourly prices
+const HoursPerMonth = 730
+
+const dirname = "pricing"
+
+// PriceList holds the on-demand prices (in USD) of a region
+type PriceList struct {
+	Region  string    `json:"region"`
+	Updated time.Time `json:"updated"`
+	// Hourly prices per key (ex: instance/t2.micro)
+	Hourly map[string]float64 `json:"hourly"`
+	// Monthly prices per GB or IOPS per key (ex: volume/gp2)
+	Monthly map[string]float64 `json:"monthly"`
+}
+
+func NewPriceList(region string) *PriceList {
+	return &PriceList{Region: region, Hourly: make(map[string]float64), Monthly: make(map[string]float64)}
+}
+
+// DefaultDir returns the directory holding the price lists of all regions
+func DefaultDir() string {
+	return filepath.Join(os.Getenv("__AWLESS_HOME"), dirname)
+}
+
+// Load reads the price list of a region from the given directory
+func Load(dir, region string) (*PriceList, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, region+".json"))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no price list for region %s (run `awless pricing update`)", region)
+	}
+	if err != nil {
+		return nil, err
+	}
+	l := NewPriceList(region)
+	if err = json.Unmarshal(b, l); err != nil {
+		return nil, fmt.Errorf("price list of %s: %s", region, err)
+	}
+	return l, nil
+}
+
+// Save writes the price list in the given directory
+func (l *PriceList) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(l, "", " ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, l.Region+".json"), b, 0600)
+}
+
+// Len returns the number of prices in the list
+func (l *PriceList) Len() int {
+	return len(l.Hourly) + len(l.Monthly)
+}
+
+func (l *PriceList) setPrice(key, unit string, price float64) {
+	var prices map[string]float64
+	switch unit {
+	case "Hrs":
+		prices = l.Hourly
+	case "GB-Mo", "IOPS-Mo":
+		prices = l.Monthly
+	default:
+		return
+	}
+	// Keep the cheapest of equivalent offers
+	if existing, ok := prices[key]; !ok || price < existing {
+		prices[key] = price
+	}
+}
@@ -0,0 +1,159 @@
+package pricing
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+)
+
+const offer = `{
+  "formatVersion": "v1.0",
+  "offerCode": "AmazonEC2",
+  "products": {
+    "SKU1": {"sku": "SKU1", "productFamily": "Compute Instance", "attributes": {"regionCode": "eu-west-1", "instanceType": "t2.micro", "operatingSystem": "Linux", "tenancy": "Shared", "preInstalledSw": "NA", "capacitystatus": "Used"}},
+    "SKU2": {"sku": "SKU2", "productFamily": "Compute Instance", "attributes": {"regionCode": "eu-west-1", "instanceType": "t2.micro", "operatingSystem": "Windows", "tenancy": "Shared", "preInstalledSw": "NA", "capacitystatus": "Used"}},
+    "SKU3": {"sku": "SKU3", "productFamily": "Compute Instance", "attributes": {"regionCode": "us-east-1", "instanceType": "t2.micro", "operatingSystem": "Linux", "tenancy": "Shared", "preInstalledSw": "NA", "capacitystatus": "Used"}},
+    "SKU4": {"sku": "SKU4", "productFamily": "Storage", "attributes": {"regionCode": "eu-west-1", "volumeType": "General Purpose", "volumeApiName": "gp2"}},
+    "SKU5": {"sku": "SKU5", "productFamily": "Storage", "attributes": {"regionCode": "eu-west-1", "volumeType": "Provisioned IOPS"}},
+    "SKU6": {"sku": "SKU6", "productFamily": "System Operation", "attributes": {"regionCode": "eu-west-1", "group": "EBS IOPS", "volumeType": "Provisioned IOPS"}},
+    "SKU7": {"sku": "SKU7", "productFamily": "IP Address", "attributes": {"regionCode": "eu-west-1", "usagetype": "EU-ElasticIP:IdleAddress"}},
+    "SKU8": {"sku": "SKU8", "productFamily": "Database Instance", "attributes": {"regionCode": "eu-west-1", "instanceType": "db.t2.small", "databaseEngine": "PostgreSQL", "deploymentOption": "Multi-AZ", "licenseModel": "No license required"}},
+    "SKU9": {"sku": "SKU9", "productFamily": "Database Storage", "attributes": {"regionCode": "eu-west-1", "volumeType": "General Purpose", "databaseEngine": "Any", "deploymentOption": "Multi-AZ"}},
+    "SKU10": {"sku": "SKU10", "productFamily": "Database Storage", "attributes": {"regionCode": "eu-west-1", "volumeType": "Provisioned IOPS", "databaseEngine": "Any", "deploymentOption": "Multi-AZ"}},
+    "SKU11": {"sku": "SKU11", "productFamily": "Provisioned IOPS", "attributes": {"regionCode": "eu-west-1", "databaseEngine": "Any", "deploymentOption": "Multi-AZ"}}
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU1": {"SKU1.JRTCKXETXF": {"priceDimensions": {"SKU1.JRTCKXETXF.6YS6EN2CT7": {"unit": "Hrs", "beginRange": "0", "pricePerUnit": {"USD": "0.0126"}}}}},
+      "SKU2": {"SKU2.JRTCKXETXF": {"priceDimensions": {"SKU2.JRTCKXETXF.6YS6EN2CT7": {"unit": "Hrs", "beginRange": "0", "pricePerUnit": {"USD": "0.0172"}}}}},
+      "SKU3": {"SKU3.JRTCKXETXF": {"priceDimensions": {"SKU3.JRTCKXETXF.6YS6EN2CT7": {"unit": "Hrs", "beginRange": "0", "pricePerUnit": {"USD": "0.0116"}}}}},
+      "SKU4": {"SKU4.JRTCKXETXF": {"priceDimensions": {"SKU4.JRTCKXETXF.6YS6EN2CT7": {"unit": "GB-Mo", "beginRange": "0", "pricePerUnit": {"USD": "0.11"}}}}},
+      "SKU5": {"SKU5.JRTCKXETXF": {"priceDimensions": {"SKU5.JRTCKXETXF.6YS6EN2CT7": {"unit": "GB-Mo", "beginRange": "0", "pricePerUnit": {"USD": "0.138"}}}}},
+      "SKU6": {"SKU6.JRTCKXETXF": {"priceDimensions": {"SKU6.JRTCKXETXF.6YS6EN2CT7": {"unit": "IOPS-Mo", "beginRange": "0", "pricePerUnit": {"USD": "0.072"}}}}},
+      "SKU7": {"SKU7.JRTCKXETXF": {"priceDimensions": {
+        "SKU7.JRTCKXETXF.1": {"unit": "Hrs", "beginRange": "0", "pricePerUnit": {"USD": "0.005"}},
+        "SKU7.JRTCKXETXF.2": {"unit": "Hrs", "beginRange": "1", "pricePerUnit": {"USD": "0.0"}}
+      }}},
+      "SKU8": {"SKU8.JRTCKXETXF": {"priceDimensions": {"SKU8.JRTCKXETXF.6YS6EN2CT7": {"unit": "Hrs", "beginRange": "0", "pricePerUnit": {"USD": "0.072"}}}}},
+      "SKU9": {"SKU9.JRTCKXETXF": {"priceDimensions": {"SKU9.JRTCKXETXF.6YS6EN2CT7": {"unit": "GB-Mo", "beginRange": "0", "pricePerUnit": {"USD": "0.253"}}}}},
+      "SKU10": {"SKU10.JRTCKXETXF": {"priceDimensions": {"SKU10.JRTCKXETXF.6YS6EN2CT7": {"unit": "GB-Mo", "beginRange": "0", "pricePerUnit": {"USD": "0.276"}}}}},
+      "SKU11": {"SKU11.JRTCKXETXF": {"priceDimensions": {"SKU11.JRTCKXETXF.6YS6EN2CT7": {"unit": "IOPS-Mo", "beginRange": "0", "pricePerUnit": {"USD": "0.22"}}}}}
+    },
+    "Reserved": {
+      "SKU1": {"SKU1.4NA7Y494T4": {"priceDimensions": {"SKU1.4NA7Y494T4.6YS6EN2CT7": {"unit": "Hrs", "beginRange": "0", "pricePerUnit": {"USD": "0.0080"}}}}}
+    }
+  }
+}`
+
+func TestReadOffer(t *testing.T) {
+	l := NewPriceList("eu-west-1")
+	if err := l.ReadOffer(strings.NewReader(offer)); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]float64{
+		"instance/t2.micro": 0.0126,
+		"elasticip/idle":    0.005,
+		"database/db.t2.small/postgres/multi-az/included": 0.072,
+	}
+	if got, want := len(l.Hourly), len(expected); got != want {
+		t.Fatalf("got %d hourly prices (%v), want %d", got, l.Hourly, want)
+	}
+	for k, want := range expected {
+		if got := l.Hourly[k]; got != want {
+			t.Fatalf("%s: got %v, want %v", k, got, want)
+		}
+	}
+	expected = map[string]float64{
+		"volume/gp2":                    0.11,
+		"volume/io1":                    0.138,
+		"volume-iops/io1":               0.072,
+		"database-storage/gp2/multi-az": 0.253,
+		"database-storage/io1/multi-az": 0.276,
+		"database-iops/multi-az":        0.22,
+	}
+	if got, want := len(l.Monthly), len(expected); got != want {
+		t.Fatalf("got %d monthly prices (%v), want %d", got, l.Monthly, want)
+	}
+	for k, want := range expected {
+		if got := l.Monthly[k]; got != want {
+			t.Fatalf("%s: got %v, want %v", k, got, want)
+		}
+	}
+
+	if err := NewPriceList("eu-west-1").ReadOffer(strings.NewReader(`{"products": [`)); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestMonthlyCost(t *testing.T) {
+	l := NewPriceList("eu-west-1")
+	if err := l.ReadOffer(strings.NewReader(offer)); err != nil {
+		t.Fatal(err)
+	}
+	res := func(typ string, props map[string]interface{}) *graph.Resource {
+		r := graph.InitResource(typ, "id")
+		for k, v := range props {
+			r.Properties()[k] = v
+		}
+		return r
+	}
+	tcases := []struct {
+		res    *graph.Resource
+		cost   float64
+		priced bool
+	}{
+		{res(cloud.Instance, map[string]interface{}{properties.Type: "t2.micro", properties.State: "running"}), 9.198, true},
+		{res(cloud.Instance, map[string]interface{}{properties.Type: "t2.micro", properties.State: "stopped"}), 0, true},
+		{res(cloud.Instance, map[string]interface{}{properties.Type: "m4.large", properties.State: "running"}), 0, false},
+		{res(cloud.Volume, map[string]interface{}{properties.Type: "gp2", properties.Size: 100}), 11, true},
+		{res(cloud.Volume, map[string]interface{}{properties.Type: "io1", properties.Size: 100, properties.IOPS: 1000}), 85.8, true},
+		{res(cloud.ElasticIP, map[string]interface{}{properties.Association: "eipassoc-1"}), 0, true},
+		{res(cloud.ElasticIP, nil), 3.65, true},
+		{res(cloud.Database, map[string]interface{}{properties.Class: "db.t2.small", properties.Engine: "postgres", properties.MultiAZ: "true", properties.License: "postgresql-license", properties.Storage: "20"}), 57.62, true},
+		{res(cloud.Database, map[string]interface{}{properties.Class: "db.t2.small", properties.Engine: "postgres", properties.MultiAZ: "true", properties.License: "postgresql-license", properties.Storage: "100", properties.StorageType: "io1", properties.IOPS: 1000}), 52.56 + 27.6 + 220, true},
+		{res(cloud.Database, map[string]interface{}{properties.Class: "db.t2.small", properties.Engine: "postgres", properties.MultiAZ: "true", properties.License: "postgresql-license", properties.Storage: "20", properties.StorageType: "standard"}), 0, false},
+		{res(cloud.Subnet, nil), 0, false},
+	}
+	for i, tcase := range tcases {
+		cost, priced := l.MonthlyCost(tcase.res)
+		if priced != tcase.priced {
+			t.Fatalf("%d: got priced %t, want %t", i+1, priced, tcase.priced)
+		}
+		if diff := cost - tcase.cost; diff > 0.001 || diff < -0.001 {
+			t.Fatalf("%d: got %v, want %v", i+1, cost, tcase.cost)
+		}
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err = Load(dir, "eu-west-1"); err == nil {
+		t.Fatal("expected error")
+	}
+	l := NewPriceList("eu-west-1")
+	l.Hourly["instance/t2.micro"] = 0.0126
+	l.Monthly["volume/gp2"] = 0.11
+	if err = l.Save(dir); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load(dir, "eu-west-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := loaded.Len(), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := loaded.Monthly["volume/gp2"], 0.11; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsservices
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/wallix/awless/fetch"
+)
+
+const (
+	fetchConcurrencyConfigKey = "aws.fetch.concurrency"
+	fetchRateLimitConfigKey   = "aws.fetch.ratelimit"
+
+	defaultFetchConcurrency = 8
+	defaultFetchRateLimit   = 20
+
+	fetchMaxRetries  = 5
+	fetchBaseBackoff = 500 * time.Millisecond
+)
+
+// Bounded concurrency of resource types fetches per service, retried with exponential backoff when throttled
+func fetchLimits(extraConf map[string]interface{}) fetch.Limits {
+	return fetch.Limits{
+		MaxConcurrent: getInt(extraConf, fetchConcurrencyConfigKey, defaultFetchConcurrency),
+		MaxRetries:    fetchMaxRetries,
+		BaseBackoff:   fetchBaseBackoff,
+		IsRetryable:   isThrottleError,
+		OnRetry: func(resourceType string, attempt int, err error) {
+			DefaultNetworkMonitor.countFetchRetry()
+		},
+	}
+}
+
+// rateLimitedSession returns a copy of the session whose requests are limited by a token bucket (per second)
+func rateLimitedSession(sess *session.Session, extraConf map[string]interface{}) *session.Session {
+	rate := getInt(extraConf, fetchRateLimitConfigKey, defaultFetchRateLimit)
+	if rate <= 0 {
+		return sess
+	}
+	bucket := fetch.NewTokenBucket(float64(rate), rate)
+	limited := sess.Copy()
+	limited.Handlers.Send.PushFront(func(r *request.Request) {
+		if err := bucket.Wait(r.Context()); err != nil {
+			r.Error = err
+		}
+	})
+	return limited
+}
+
+func isThrottleError(err error) bool {
+	if ferr, ok := err.(*fetch.Error); ok {
+		for _, e := range *ferr {
+			if isThrottleError(e) {
+				return true
+			}
+		}
+		return false
+	}
+	return request.IsErrorThrottle(err)
+}
+
+func getInt(m map[string]interface{}, key string, def int) int {
+	if i, ok := m[key].(int); ok {
+		return i
+	}
+	return def
+}
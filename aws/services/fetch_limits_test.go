@@ -0,0 +1,28 @@
+package awsservices
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/wallix/awless/fetch"
+)
+
+func TestIsThrottleError(t *testing.T) {
+	tcases := []struct {
+		err      error
+		expected bool
+	}{
+		{err: awserr.New("Throttling", "Rate exceeded", nil), expected: true},
+		{err: awserr.New("RequestLimitExceeded", "Request limit exceeded", nil), expected: true},
+		{err: awserr.New("AccessDenied", "", nil), expected: false},
+		{err: errors.New("any"), expected: false},
+		{err: fetch.WrapError(errors.New("any"), awserr.New("Throttling", "Rate exceeded", nil)), expected: true},
+		{err: fetch.WrapError(errors.New("any")), expected: false},
+	}
+	for i, tcase := range tcases {
+		if got, want := isThrottleError(tcase.err), tcase.expected; got != want {
+			t.Fatalf("%d: got %t, want %t", i+1, got, want)
+		}
+	}
+}
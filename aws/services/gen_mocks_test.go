@@ -25,6 +25,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -33,18 +35,28 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -53,10 +65,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/wallix/awless/cloud"
 )
 
@@ -64,21 +80,23 @@ import (
 
 type mockEc2 struct {
 	ec2iface.EC2API
-	instances         []*ec2.Instance
-	subnets           []*ec2.Subnet
-	vpcs              []*ec2.Vpc
-	keypairinfos      []*ec2.KeyPairInfo
-	securitygroups    []*ec2.SecurityGroup
-	volumes           []*ec2.Volume
-	internetgateways  []*ec2.InternetGateway
-	natgateways       []*ec2.NatGateway
-	routetables       []*ec2.RouteTable
-	availabilityzones []*ec2.AvailabilityZone
-	images            []*ec2.Image
-	importimagetasks  []*ec2.ImportImageTask
-	addresss          []*ec2.Address
-	snapshots         []*ec2.Snapshot
-	networkinterfaces []*ec2.NetworkInterface
+	instances             []*ec2.Instance
+	subnets               []*ec2.Subnet
+	vpcs                  []*ec2.Vpc
+	keypairinfos          []*ec2.KeyPairInfo
+	securitygroups        []*ec2.SecurityGroup
+	volumes               []*ec2.Volume
+	internetgateways      []*ec2.InternetGateway
+	natgateways           []*ec2.NatGateway
+	routetables           []*ec2.RouteTable
+	availabilityzones     []*ec2.AvailabilityZone
+	images                []*ec2.Image
+	importimagetasks      []*ec2.ImportImageTask
+	addresss              []*ec2.Address
+	snapshots             []*ec2.Snapshot
+	networkinterfaces     []*ec2.NetworkInterface
+	vpcpeeringconnections []*ec2.VpcPeeringConnection
+	vpcendpoints          []*ec2.VpcEndpoint
 }
 
 func (m *mockEc2) Name() string {
@@ -199,6 +217,14 @@ func (m *mockEc2) DescribeNetworkInterfaces(input *ec2.DescribeNetworkInterfaces
 	return &ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: m.networkinterfaces}, nil
 }
 
+func (m *mockEc2) DescribeVpcPeeringConnections(input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+	return &ec2.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: m.vpcpeeringconnections}, nil
+}
+
+func (m *mockEc2) DescribeVpcEndpoints(input *ec2.DescribeVpcEndpointsInput) (*ec2.DescribeVpcEndpointsOutput, error) {
+	return &ec2.DescribeVpcEndpointsOutput{VpcEndpoints: m.vpcendpoints}, nil
+}
+
 type mockElbv2 struct {
 	elbv2iface.ELBV2API
 	loadbalancers            []*elbv2.LoadBalancer
@@ -398,6 +424,100 @@ func (m *mockRds) DescribeDBSubnetGroupsPages(input *rds.DescribeDBSubnetGroupsI
 	return nil
 }
 
+type mockElasticache struct {
+	elasticacheiface.ElastiCacheAPI
+	cacheclusters     []*elasticache.CacheCluster
+	replicationgroups []*elasticache.ReplicationGroup
+	cachesubnetgroups []*elasticache.CacheSubnetGroup
+}
+
+func (m *mockElasticache) Name() string {
+	return ""
+}
+
+func (m *mockElasticache) Region() string {
+	return ""
+}
+
+func (m *mockElasticache) Profile() string {
+	return ""
+}
+
+func (m *mockElasticache) Provider() string {
+	return ""
+}
+
+func (m *mockElasticache) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockElasticache) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockElasticache) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockElasticache) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockElasticache) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockElasticache) DescribeCacheClustersPages(input *elasticache.DescribeCacheClustersInput, fn func(p *elasticache.DescribeCacheClustersOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*elasticache.CacheCluster
+	for i := 0; i < len(m.cacheclusters); i += 2 {
+		page := []*elasticache.CacheCluster{m.cacheclusters[i]}
+		if i+1 < len(m.cacheclusters) {
+			page = append(page, m.cacheclusters[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&elasticache.DescribeCacheClustersOutput{CacheClusters: page, Marker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
+func (m *mockElasticache) DescribeReplicationGroupsPages(input *elasticache.DescribeReplicationGroupsInput, fn func(p *elasticache.DescribeReplicationGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*elasticache.ReplicationGroup
+	for i := 0; i < len(m.replicationgroups); i += 2 {
+		page := []*elasticache.ReplicationGroup{m.replicationgroups[i]}
+		if i+1 < len(m.replicationgroups) {
+			page = append(page, m.replicationgroups[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&elasticache.DescribeReplicationGroupsOutput{ReplicationGroups: page, Marker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
+func (m *mockElasticache) DescribeCacheSubnetGroupsPages(input *elasticache.DescribeCacheSubnetGroupsInput, fn func(p *elasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*elasticache.CacheSubnetGroup
+	for i := 0; i < len(m.cachesubnetgroups); i += 2 {
+		page := []*elasticache.CacheSubnetGroup{m.cachesubnetgroups[i]}
+		if i+1 < len(m.cachesubnetgroups) {
+			page = append(page, m.cachesubnetgroups[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&elasticache.DescribeCacheSubnetGroupsOutput{CacheSubnetGroups: page, Marker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockAutoscaling struct {
 	autoscalingiface.AutoScalingAPI
 	launchconfigurations []*autoscaling.LaunchConfiguration
@@ -652,9 +772,10 @@ func (m *mockIam) ListVirtualMFADevicesPages(input *iam.ListVirtualMFADevicesInp
 
 type mockS3 struct {
 	s3iface.S3API
-	buckets map[string][]*s3.Bucket
-	objects map[string][]*s3.Object
-	grants  map[string][]*s3.Grant
+	buckets         map[string][]*s3.Bucket
+	objects         map[string][]*s3.Object
+	grants          map[string][]*s3.Grant
+	encryptionrules map[string][]*s3.ServerSideEncryptionRule
 }
 
 func (m *mockS3) Name() string {
@@ -693,6 +814,49 @@ func (m *mockS3) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
 	return nil, nil
 }
 
+type mockEfs struct {
+	efsiface.EFSAPI
+	filesystemdescriptions    []*efs.FileSystemDescription
+	mounttargetdescriptions   []*efs.MountTargetDescription
+	mounttargetsecuritygroups map[string][]*string
+}
+
+func (m *mockEfs) Name() string {
+	return ""
+}
+
+func (m *mockEfs) Region() string {
+	return ""
+}
+
+func (m *mockEfs) Profile() string {
+	return ""
+}
+
+func (m *mockEfs) Provider() string {
+	return ""
+}
+
+func (m *mockEfs) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockEfs) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockEfs) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockEfs) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockEfs) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
 type mockSns struct {
 	snsiface.SNSAPI
 	subscriptions []*sns.Subscription
@@ -819,6 +983,8 @@ type mockRoute53 struct {
 	route53iface.Route53API
 	hostedzones        []*route53.HostedZone
 	resourcerecordsets map[string][]*route53.ResourceRecordSet
+	healthchecks       []*route53.HealthCheck
+	resourcetagsets    []*route53.ResourceTagSet
 }
 
 func (m *mockRoute53) Name() string {
@@ -874,6 +1040,23 @@ func (m *mockRoute53) ListHostedZonesPages(input *route53.ListHostedZonesInput,
 	return nil
 }
 
+func (m *mockRoute53) ListHealthChecksPages(input *route53.ListHealthChecksInput, fn func(p *route53.ListHealthChecksOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*route53.HealthCheck
+	for i := 0; i < len(m.healthchecks); i += 2 {
+		page := []*route53.HealthCheck{m.healthchecks[i]}
+		if i+1 < len(m.healthchecks) {
+			page = append(page, m.healthchecks[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&route53.ListHealthChecksOutput{HealthChecks: page, NextMarker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockLambda struct {
 	lambdaiface.LambdaAPI
 	functionconfigurations []*lambda.FunctionConfiguration
@@ -932,6 +1115,125 @@ func (m *mockLambda) ListFunctionsPages(input *lambda.ListFunctionsInput, fn fun
 	return nil
 }
 
+type mockApigateway struct {
+	apigatewayiface.APIGatewayAPI
+	restapis  []*apigateway.RestApi
+	resources map[string][]*apigateway.Resource
+	stages    map[string][]*apigateway.Stage
+}
+
+func (m *mockApigateway) Name() string {
+	return ""
+}
+
+func (m *mockApigateway) Region() string {
+	return ""
+}
+
+func (m *mockApigateway) Profile() string {
+	return ""
+}
+
+func (m *mockApigateway) Provider() string {
+	return ""
+}
+
+func (m *mockApigateway) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockApigateway) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockApigateway) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockApigateway) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockApigateway) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockApigateway) GetRestApisPages(input *apigateway.GetRestApisInput, fn func(p *apigateway.GetRestApisOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*apigateway.RestApi
+	for i := 0; i < len(m.restapis); i += 2 {
+		page := []*apigateway.RestApi{m.restapis[i]}
+		if i+1 < len(m.restapis) {
+			page = append(page, m.restapis[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&apigateway.GetRestApisOutput{Items: page, Position: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
+type mockSfn struct {
+	sfniface.SFNAPI
+	statemachinelistitems []*sfn.StateMachineListItem
+	statemachines         map[string]*sfn.DescribeStateMachineOutput
+}
+
+func (m *mockSfn) Name() string {
+	return ""
+}
+
+func (m *mockSfn) Region() string {
+	return ""
+}
+
+func (m *mockSfn) Profile() string {
+	return ""
+}
+
+func (m *mockSfn) Provider() string {
+	return ""
+}
+
+func (m *mockSfn) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockSfn) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockSfn) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockSfn) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockSfn) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockSfn) ListStateMachinesPages(input *sfn.ListStateMachinesInput, fn func(p *sfn.ListStateMachinesOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*sfn.StateMachineListItem
+	for i := 0; i < len(m.statemachinelistitems); i += 2 {
+		page := []*sfn.StateMachineListItem{m.statemachinelistitems[i]}
+		if i+1 < len(m.statemachinelistitems) {
+			page = append(page, m.statemachinelistitems[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&sfn.ListStateMachinesOutput{StateMachines: page, NextToken: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockCloudwatch struct {
 	cloudwatchiface.CloudWatchAPI
 	metrics      []*cloudwatch.Metric
@@ -1008,6 +1310,64 @@ func (m *mockCloudwatch) DescribeAlarmsPages(input *cloudwatch.DescribeAlarmsInp
 	return nil
 }
 
+type mockCloudwatchlogs struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	loggroups []*cloudwatchlogs.LogGroup
+}
+
+func (m *mockCloudwatchlogs) Name() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) Region() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) Profile() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) Provider() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockCloudwatchlogs) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockCloudwatchlogs) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockCloudwatchlogs) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockCloudwatchlogs) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockCloudwatchlogs) DescribeLogGroupsPages(input *cloudwatchlogs.DescribeLogGroupsInput, fn func(p *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*cloudwatchlogs.LogGroup
+	for i := 0; i < len(m.loggroups); i += 2 {
+		page := []*cloudwatchlogs.LogGroup{m.loggroups[i]}
+		if i+1 < len(m.loggroups) {
+			page = append(page, m.loggroups[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: page, NextToken: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockCloudfront struct {
 	cloudfrontiface.CloudFrontAPI
 	distributionsummarys []*cloudfront.DistributionSummary
@@ -1107,6 +1467,168 @@ func (m *mockCloudformation) DescribeStacksPages(input *cloudformation.DescribeS
 	return nil
 }
 
+type mockDynamodb struct {
+	dynamodbiface.DynamoDBAPI
+	tabledescriptions       []*dynamodb.TableDescription
+	globaltabledescriptions []*dynamodb.GlobalTableDescription
+}
+
+func (m *mockDynamodb) Name() string {
+	return ""
+}
+
+func (m *mockDynamodb) Region() string {
+	return ""
+}
+
+func (m *mockDynamodb) Profile() string {
+	return ""
+}
+
+func (m *mockDynamodb) Provider() string {
+	return ""
+}
+
+func (m *mockDynamodb) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockDynamodb) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockDynamodb) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockDynamodb) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockDynamodb) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+type mockKms struct {
+	kmsiface.KMSAPI
+	keymetadatas    []*kms.KeyMetadata
+	aliaslistentrys []*kms.AliasListEntry
+	rotations       map[string]bool
+	policies        map[string]string
+}
+
+func (m *mockKms) Name() string {
+	return ""
+}
+
+func (m *mockKms) Region() string {
+	return ""
+}
+
+func (m *mockKms) Profile() string {
+	return ""
+}
+
+func (m *mockKms) Provider() string {
+	return ""
+}
+
+func (m *mockKms) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockKms) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockKms) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockKms) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockKms) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockKms) ListAliasesPages(input *kms.ListAliasesInput, fn func(p *kms.ListAliasesOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*kms.AliasListEntry
+	for i := 0; i < len(m.aliaslistentrys); i += 2 {
+		page := []*kms.AliasListEntry{m.aliaslistentrys[i]}
+		if i+1 < len(m.aliaslistentrys) {
+			page = append(page, m.aliaslistentrys[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&kms.ListAliasesOutput{Aliases: page, NextMarker: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
+type mockSsm struct {
+	ssmiface.SSMAPI
+	parametermetadatas []*ssm.ParameterMetadata
+	values             map[string]*ssm.Parameter
+}
+
+func (m *mockSsm) Name() string {
+	return ""
+}
+
+func (m *mockSsm) Region() string {
+	return ""
+}
+
+func (m *mockSsm) Profile() string {
+	return ""
+}
+
+func (m *mockSsm) Provider() string {
+	return ""
+}
+
+func (m *mockSsm) ProviderAPI() string {
+	return ""
+}
+
+func (m *mockSsm) ResourceTypes() []string {
+	return []string{}
+}
+
+func (m *mockSsm) Fetch(context.Context) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockSsm) IsSyncDisabled() bool {
+	return false
+}
+
+func (m *mockSsm) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
+	return nil, nil
+}
+
+func (m *mockSsm) DescribeParametersPages(input *ssm.DescribeParametersInput, fn func(p *ssm.DescribeParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	var pages [][]*ssm.ParameterMetadata
+	for i := 0; i < len(m.parametermetadatas); i += 2 {
+		page := []*ssm.ParameterMetadata{m.parametermetadatas[i]}
+		if i+1 < len(m.parametermetadatas) {
+			page = append(page, m.parametermetadatas[i+1])
+		}
+		pages = append(pages, page)
+	}
+	for i, page := range pages {
+		fn(&ssm.DescribeParametersOutput{Parameters: page, NextToken: aws.String(strconv.Itoa(i + 1))},
+			i < len(pages),
+		)
+	}
+	return nil
+}
+
 type mockEcr struct {
 	ecriface.ECRAPI
 	repositorys []*ecr.Repository
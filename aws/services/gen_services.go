@@ -30,6 +30,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -38,20 +40,32 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -60,10 +74,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"github.com/wallix/awless/aws/fetch"
@@ -84,8 +102,11 @@ var ServiceNames = []string{
 	"dns",
 	"lambda",
 	"monitoring",
+	"logs",
 	"cdn",
 	"cloudformation",
+	"dynamodb",
+	"security",
 }
 
 var ResourceTypes = []string{
@@ -104,12 +125,16 @@ var ResourceTypes = []string{
 	"elasticip",
 	"snapshot",
 	"networkinterface",
+	"vpcpeering",
+	"vpcendpoint",
 	"classicloadbalancer",
 	"loadbalancer",
 	"targetgroup",
 	"listener",
 	"database",
 	"dbsubnetgroup",
+	"cachecluster",
+	"replicationgroup",
 	"launchconfiguration",
 	"scalinggroup",
 	"scalingpolicy",
@@ -128,16 +153,27 @@ var ResourceTypes = []string{
 	"mfadevice",
 	"bucket",
 	"s3object",
+	"filesystem",
+	"mounttarget",
 	"subscription",
 	"topic",
 	"queue",
 	"zone",
 	"record",
+	"healthcheck",
 	"function",
+	"restapi",
+	"stage",
+	"statemachine",
 	"metric",
 	"alarm",
+	"loggroup",
 	"distribution",
 	"stack",
+	"table",
+	"globaltable",
+	"key",
+	"parameter",
 }
 
 var ServicePerAPI = map[string]string{
@@ -145,6 +181,7 @@ var ServicePerAPI = map[string]string{
 	"elbv2":       "infra",
 	"elb":         "infra",
 	"rds":         "infra",
+	"elasticache": "infra",
 	"autoscaling": "infra",
 	"ecr":         "infra",
 	"ecs":         "infra",
@@ -153,13 +190,21 @@ var ServicePerAPI = map[string]string{
 	"iam":            "access",
 	"sts":            "access",
 	"s3":             "storage",
+	"efs":            "storage",
 	"sns":            "messaging",
 	"sqs":            "messaging",
 	"route53":        "dns",
 	"lambda":         "lambda",
+	"apigateway":     "lambda",
+	"sfn":            "lambda",
 	"cloudwatch":     "monitoring",
+	"cloudwatchlogs":         "logs",
+	"cloudtrail":             "logs",
 	"cloudfront":     "cdn",
 	"cloudformation": "cloudformation",
+	"dynamodb":               "dynamodb",
+	"kms":                    "security",
+	"ssm":                    "security",
 }
 
 var ServicePerResourceType = map[string]string{
@@ -178,12 +223,16 @@ var ServicePerResourceType = map[string]string{
 	"elasticip":           "infra",
 	"snapshot":            "infra",
 	"networkinterface":    "infra",
+	"vpcpeering":          "infra",
+	"vpcendpoint":         "infra",
 	"classicloadbalancer": "infra",
 	"loadbalancer":        "infra",
 	"targetgroup":         "infra",
 	"listener":            "infra",
 	"database":            "infra",
 	"dbsubnetgroup":       "infra",
+	"cachecluster":        "infra",
+	"replicationgroup":    "infra",
 	"launchconfiguration": "infra",
 	"scalinggroup":        "infra",
 	"scalingpolicy":       "infra",
@@ -202,16 +251,27 @@ var ServicePerResourceType = map[string]string{
 	"mfadevice":           "access",
 	"bucket":              "storage",
 	"s3object":            "storage",
+	"filesystem":          "storage",
+	"mounttarget":         "storage",
 	"subscription":        "messaging",
 	"topic":               "messaging",
 	"queue":               "messaging",
 	"zone":                "dns",
 	"record":              "dns",
+	"healthcheck":         "dns",
 	"function":            "lambda",
+	"restapi":             "lambda",
+	"stage":               "lambda",
+	"statemachine":        "lambda",
 	"metric":              "monitoring",
 	"alarm":               "monitoring",
+	"loggroup":            "logs",
 	"distribution":        "cdn",
 	"stack":               "cloudformation",
+	"table":               "dynamodb",
+	"globaltable":         "dynamodb",
+	"key":                 "security",
+	"parameter":           "security",
 }
 
 var APIPerResourceType = map[string]string{
@@ -230,12 +290,16 @@ var APIPerResourceType = map[string]string{
 	"elasticip":           "ec2",
 	"snapshot":            "ec2",
 	"networkinterface":    "ec2",
+	"vpcpeering":          "ec2",
+	"vpcendpoint":         "ec2",
 	"classicloadbalancer": "elb",
 	"loadbalancer":        "elbv2",
 	"targetgroup":         "elbv2",
 	"listener":            "elbv2",
 	"database":            "rds",
 	"dbsubnetgroup":       "rds",
+	"cachecluster":        "elasticache",
+	"replicationgroup":    "elasticache",
 	"launchconfiguration": "autoscaling",
 	"scalinggroup":        "autoscaling",
 	"scalingpolicy":       "autoscaling",
@@ -254,16 +318,27 @@ var APIPerResourceType = map[string]string{
 	"mfadevice":           "iam",
 	"bucket":              "s3",
 	"s3object":            "s3",
+	"filesystem":          "efs",
+	"mounttarget":         "efs",
 	"subscription":        "sns",
 	"topic":               "sns",
 	"queue":               "sqs",
 	"zone":                "route53",
 	"record":              "route53",
+	"healthcheck":         "route53",
 	"function":            "lambda",
+	"restapi":             "apigateway",
+	"stage":               "apigateway",
+	"statemachine":        "sfn",
 	"metric":              "cloudwatch",
 	"alarm":               "cloudwatch",
+	"loggroup":            "cloudwatchlogs",
 	"distribution":        "cloudfront",
 	"stack":               "cloudformation",
+	"table":               "dynamodb",
+	"globaltable":         "dynamodb",
+	"key":                 "kms",
+	"parameter":           "ssm",
 }
 
 type Infra struct {
@@ -275,6 +350,7 @@ type Infra struct {
 	elbv2iface.ELBV2API
 	elbiface.ELBAPI
 	rdsiface.RDSAPI
+	elasticacheiface.ElastiCacheAPI
 	autoscalingiface.AutoScalingAPI
 	ecriface.ECRAPI
 	ecsiface.ECSAPI
@@ -288,6 +364,7 @@ func NewInfra(sess *session.Session, profile string, extraConf map[string]interf
 	elbv2API := elbv2.New(sess)
 	elbAPI := elb.New(sess)
 	rdsAPI := rds.New(sess)
+	elasticacheAPI := elasticache.New(sess)
 	autoscalingAPI := autoscaling.New(sess)
 	ecrAPI := ecr.New(sess)
 	ecsAPI := ecs.New(sess)
@@ -299,11 +376,13 @@ func NewInfra(sess *session.Session, profile string, extraConf map[string]interf
 		elbv2API,
 		elbAPI,
 		rdsAPI,
+		elasticacheAPI,
 		autoscalingAPI,
 		ecrAPI,
 		ecsAPI,
 		applicationautoscalingAPI,
 		acmAPI,
+		cloudwatch.New(sess),
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
@@ -313,12 +392,13 @@ func NewInfra(sess *session.Session, profile string, extraConf map[string]interf
 		ELBV2API:       elbv2API,
 		ELBAPI:         elbAPI,
 		RDSAPI:         rdsAPI,
+		ElastiCacheAPI: elasticacheAPI,
 		AutoScalingAPI: autoscalingAPI,
 		ECRAPI:         ecrAPI,
 		ECSAPI:         ecsAPI,
 		ApplicationAutoScalingAPI: applicationautoscalingAPI,
 		ACMAPI:  acmAPI,
-		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(fetchConfig)),
+		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
 		config:  extraConf,
 		region:  region,
 		profile: profile,
@@ -355,12 +435,16 @@ func (s *Infra) ResourceTypes() []string {
 		"elasticip",
 		"snapshot",
 		"networkinterface",
+		"vpcpeering",
+		"vpcendpoint",
 		"classicloadbalancer",
 		"loadbalancer",
 		"targetgroup",
 		"listener",
 		"database",
 		"dbsubnetgroup",
+		"cachecluster",
+		"replicationgroup",
 		"launchconfiguration",
 		"scalinggroup",
 		"scalingpolicy",
@@ -737,6 +821,50 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.infra.vpcpeering.sync", true) {
+		list, err := s.fetcher.Get("vpcpeering_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.VpcPeeringConnection); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.VpcPeeringConnection' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.VpcPeeringConnection) {
+			for _, fn := range addParentsFns["vpcpeering"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.VpcPeeringConnection) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.vpcendpoint.sync", true) {
+		list, err := s.fetcher.Get("vpcendpoint_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ec2.VpcEndpoint); !ok {
+			return gph, errors.New("cannot cast to '[]*ec2.VpcEndpoint' type from fetch context")
+		}
+		for _, r := range list.([]*ec2.VpcEndpoint) {
+			for _, fn := range addParentsFns["vpcendpoint"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ec2.VpcEndpoint) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 	if getBool(s.config, "aws.infra.classicloadbalancer.sync", true) {
 		list, err := s.fetcher.Get("classicloadbalancer_objects")
 		if err != nil {
@@ -869,6 +997,50 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.infra.cachecluster.sync", true) {
+		list, err := s.fetcher.Get("cachecluster_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elasticache.CacheCluster); !ok {
+			return gph, errors.New("cannot cast to '[]*elasticache.CacheCluster' type from fetch context")
+		}
+		for _, r := range list.([]*elasticache.CacheCluster) {
+			for _, fn := range addParentsFns["cachecluster"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elasticache.CacheCluster) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.infra.replicationgroup.sync", true) {
+		list, err := s.fetcher.Get("replicationgroup_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*elasticache.ReplicationGroup); !ok {
+			return gph, errors.New("cannot cast to '[]*elasticache.ReplicationGroup' type from fetch context")
+		}
+		for _, r := range list.([]*elasticache.ReplicationGroup) {
+			for _, fn := range addParentsFns["replicationgroup"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *elasticache.ReplicationGroup) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 	if getBool(s.config, "aws.infra.launchconfiguration.sync", true) {
 		list, err := s.fetcher.Get("launchconfiguration_objects")
 		if err != nil {
@@ -1088,7 +1260,11 @@ func (s *Infra) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 
 func (s *Infra) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
 	defer s.fetcher.Reset()
-	return s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
 }
 
 func (s *Infra) IsSyncDisabled() bool {
@@ -1119,7 +1295,7 @@ func NewAccess(sess *session.Session, profile string, extraConf map[string]inter
 	return &Access{
 		IAMAPI:  iamAPI,
 		STSAPI:  stsAPI,
-		fetcher: fetch.NewFetcher(awsfetch.BuildAccessFetchFuncs(fetchConfig)),
+		fetcher: fetch.NewFetcher(awsfetch.BuildAccessFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
 		config:  extraConf,
 		region:  region,
 		profile: profile,
@@ -1360,7 +1536,11 @@ func (s *Access) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 
 func (s *Access) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
 	defer s.fetcher.Reset()
-	return s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
 }
 
 func (s *Access) IsSyncDisabled() bool {
@@ -1373,21 +1553,25 @@ type Storage struct {
 	config          map[string]interface{}
 	log             *logger.Logger
 	s3iface.S3API
+	efsiface.EFSAPI
 }
 
 func NewStorage(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
 	region := awssdk.StringValue(sess.Config.Region)
 	s3API := s3.New(sess)
+	efsAPI := efs.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
 		s3API,
+		efsAPI,
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
 
 	return &Storage{
 		S3API:   s3API,
-		fetcher: fetch.NewFetcher(awsfetch.BuildStorageFetchFuncs(fetchConfig)),
+		EFSAPI:  efsAPI,
+		fetcher: fetch.NewFetcher(awsfetch.BuildStorageFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
 		config:  extraConf,
 		region:  region,
 		profile: profile,
@@ -1411,6 +1595,8 @@ func (s *Storage) ResourceTypes() []string {
 	return []string{
 		"bucket",
 		"s3object",
+		"filesystem",
+		"mounttarget",
 	}
 }
 
@@ -1492,6 +1678,50 @@ func (s *Storage) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.storage.filesystem.sync", true) {
+		list, err := s.fetcher.Get("filesystem_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*efs.FileSystemDescription); !ok {
+			return gph, errors.New("cannot cast to '[]*efs.FileSystemDescription' type from fetch context")
+		}
+		for _, r := range list.([]*efs.FileSystemDescription) {
+			for _, fn := range addParentsFns["filesystem"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *efs.FileSystemDescription) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.storage.mounttarget.sync", true) {
+		list, err := s.fetcher.Get("mounttarget_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*efs.MountTargetDescription); !ok {
+			return gph, errors.New("cannot cast to '[]*efs.MountTargetDescription' type from fetch context")
+		}
+		for _, r := range list.([]*efs.MountTargetDescription) {
+			for _, fn := range addParentsFns["mounttarget"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *efs.MountTargetDescription) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 
 	go func() {
 		wg.Wait()
@@ -1513,7 +1743,11 @@ func (s *Storage) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 
 func (s *Storage) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
 	defer s.fetcher.Reset()
-	return s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
 }
 
 func (s *Storage) IsSyncDisabled() bool {
@@ -1544,7 +1778,7 @@ func NewMessaging(sess *session.Session, profile string, extraConf map[string]in
 	return &Messaging{
 		SNSAPI:  snsAPI,
 		SQSAPI:  sqsAPI,
-		fetcher: fetch.NewFetcher(awsfetch.BuildMessagingFetchFuncs(fetchConfig)),
+		fetcher: fetch.NewFetcher(awsfetch.BuildMessagingFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
 		config:  extraConf,
 		region:  region,
 		profile: profile,
@@ -1693,7 +1927,11 @@ func (s *Messaging) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 
 func (s *Messaging) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
 	defer s.fetcher.Reset()
-	return s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
 }
 
 func (s *Messaging) IsSyncDisabled() bool {
@@ -1720,7 +1958,7 @@ func NewDns(sess *session.Session, profile string, extraConf map[string]interfac
 
 	return &Dns{
 		Route53API: route53API,
-		fetcher:    fetch.NewFetcher(awsfetch.BuildDnsFetchFuncs(fetchConfig)),
+		fetcher:    fetch.NewFetcher(awsfetch.BuildDnsFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
 		config:     extraConf,
 		region:     region,
 		profile:    profile,
@@ -1744,6 +1982,7 @@ func (s *Dns) ResourceTypes() []string {
 	return []string{
 		"zone",
 		"record",
+		"healthcheck",
 	}
 }
 
@@ -1825,6 +2064,28 @@ func (s *Dns) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.dns.healthcheck.sync", true) {
+		list, err := s.fetcher.Get("healthcheck_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*route53.HealthCheck); !ok {
+			return gph, errors.New("cannot cast to '[]*route53.HealthCheck' type from fetch context")
+		}
+		for _, r := range list.([]*route53.HealthCheck) {
+			for _, fn := range addParentsFns["healthcheck"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *route53.HealthCheck) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 
 	go func() {
 		wg.Wait()
@@ -1846,7 +2107,11 @@ func (s *Dns) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 
 func (s *Dns) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
 	defer s.fetcher.Reset()
-	return s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
 }
 
 func (s *Dns) IsSyncDisabled() bool {
@@ -1859,25 +2124,33 @@ type Lambda struct {
 	config          map[string]interface{}
 	log             *logger.Logger
 	lambdaiface.LambdaAPI
+	apigatewayiface.APIGatewayAPI
+	sfniface.SFNAPI
 }
 
 func NewLambda(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
 	region := awssdk.StringValue(sess.Config.Region)
 	lambdaAPI := lambda.New(sess)
+	apigatewayAPI := apigateway.New(sess)
+	sfnAPI := sfn.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
 		lambdaAPI,
+		apigatewayAPI,
+		sfnAPI,
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
 
 	return &Lambda{
-		LambdaAPI: lambdaAPI,
-		fetcher:   fetch.NewFetcher(awsfetch.BuildLambdaFetchFuncs(fetchConfig)),
-		config:    extraConf,
-		region:    region,
-		profile:   profile,
-		log:       log,
+		LambdaAPI:     lambdaAPI,
+		APIGatewayAPI: apigatewayAPI,
+		SFNAPI:        sfnAPI,
+		fetcher:       fetch.NewFetcher(awsfetch.BuildLambdaFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
+		config:        extraConf,
+		region:        region,
+		profile:       profile,
+		log:           log,
 	}
 }
 
@@ -1896,6 +2169,9 @@ func (s *Lambda) Profile() string {
 func (s *Lambda) ResourceTypes() []string {
 	return []string{
 		"function",
+		"restapi",
+		"stage",
+		"statemachine",
 	}
 }
 
@@ -1955,6 +2231,72 @@ func (s *Lambda) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 			}
 		}
 	}
+	if getBool(s.config, "aws.lambda.restapi.sync", true) {
+		list, err := s.fetcher.Get("restapi_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*apigateway.RestApi); !ok {
+			return gph, errors.New("cannot cast to '[]*apigateway.RestApi' type from fetch context")
+		}
+		for _, r := range list.([]*apigateway.RestApi) {
+			for _, fn := range addParentsFns["restapi"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *apigateway.RestApi) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.lambda.stage.sync", true) {
+		list, err := s.fetcher.Get("stage_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*apigateway.Stage); !ok {
+			return gph, errors.New("cannot cast to '[]*apigateway.Stage' type from fetch context")
+		}
+		for _, r := range list.([]*apigateway.Stage) {
+			for _, fn := range addParentsFns["stage"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *apigateway.Stage) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.lambda.statemachine.sync", true) {
+		list, err := s.fetcher.Get("statemachine_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*sfn.DescribeStateMachineOutput); !ok {
+			return gph, errors.New("cannot cast to '[]*sfn.DescribeStateMachineOutput' type from fetch context")
+		}
+		for _, r := range list.([]*sfn.DescribeStateMachineOutput) {
+			for _, fn := range addParentsFns["statemachine"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *sfn.DescribeStateMachineOutput) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
 
 	go func() {
 		wg.Wait()
@@ -1976,7 +2318,11 @@ func (s *Lambda) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 
 func (s *Lambda) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
 	defer s.fetcher.Reset()
-	return s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
 }
 
 func (s *Lambda) IsSyncDisabled() bool {
@@ -2003,7 +2349,7 @@ func NewMonitoring(sess *session.Session, profile string, extraConf map[string]i
 
 	return &Monitoring{
 		CloudWatchAPI: cloudwatchAPI,
-		fetcher:       fetch.NewFetcher(awsfetch.BuildMonitoringFetchFuncs(fetchConfig)),
+		fetcher:       fetch.NewFetcher(awsfetch.BuildMonitoringFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
 		config:        extraConf,
 		region:        region,
 		profile:       profile,
@@ -2129,60 +2475,68 @@ func (s *Monitoring) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 
 func (s *Monitoring) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
 	defer s.fetcher.Reset()
-	return s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
 }
 
 func (s *Monitoring) IsSyncDisabled() bool {
 	return !getBool(s.config, "aws.monitoring.sync", true)
 }
 
-type Cdn struct {
+type Logs struct {
 	fetcher         fetch.Fetcher
 	region, profile string
 	config          map[string]interface{}
 	log             *logger.Logger
-	cloudfrontiface.CloudFrontAPI
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	cloudtrailiface.CloudTrailAPI
 }
 
-func NewCdn(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
-	region := "global"
-	cloudfrontAPI := cloudfront.New(sess)
+func NewLogs(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	cloudwatchlogsAPI := cloudwatchlogs.New(sess)
+	cloudtrailAPI := cloudtrail.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
-		cloudfrontAPI,
+		cloudwatchlogsAPI,
+		cloudtrailAPI,
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
 
-	return &Cdn{
-		CloudFrontAPI: cloudfrontAPI,
-		fetcher:       fetch.NewFetcher(awsfetch.BuildCdnFetchFuncs(fetchConfig)),
-		config:        extraConf,
-		region:        region,
-		profile:       profile,
-		log:           log,
+	return &Logs{
+		CloudWatchLogsAPI: cloudwatchlogsAPI,
+		CloudTrailAPI:     cloudtrailAPI,
+		fetcher:           fetch.NewFetcher(awsfetch.BuildLogsFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
+		config:            extraConf,
+		region:            region,
+		profile:           profile,
+		log:               log,
 	}
 }
 
-func (s *Cdn) Name() string {
-	return "cdn"
+func (s *Logs) Name() string {
+	return "logs"
 }
 
-func (s *Cdn) Region() string {
+func (s *Logs) Region() string {
 	return s.region
 }
 
-func (s *Cdn) Profile() string {
+func (s *Logs) Profile() string {
 	return s.profile
 }
 
-func (s *Cdn) ResourceTypes() []string {
+func (s *Logs) ResourceTypes() []string {
 	return []string{
-		"distribution",
+		"loggroup",
 	}
 }
 
-func (s *Cdn) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
+func (s *Logs) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 	if s.IsSyncDisabled() {
 		return graph.NewGraph(), nil
 	}
@@ -2216,18 +2570,18 @@ func (s *Cdn) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 
 	errc := make(chan error)
 	var wg sync.WaitGroup
-	if getBool(s.config, "aws.cdn.distribution.sync", true) {
-		list, err := s.fetcher.Get("distribution_objects")
+	if getBool(s.config, "aws.logs.loggroup.sync", true) {
+		list, err := s.fetcher.Get("loggroup_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*cloudfront.DistributionSummary); !ok {
-			return gph, errors.New("cannot cast to '[]*cloudfront.DistributionSummary' type from fetch context")
+		if _, ok := list.([]*cloudwatchlogs.LogGroup); !ok {
+			return gph, errors.New("cannot cast to '[]*cloudwatchlogs.LogGroup' type from fetch context")
 		}
-		for _, r := range list.([]*cloudfront.DistributionSummary) {
-			for _, fn := range addParentsFns["distribution"] {
+		for _, r := range list.([]*cloudwatchlogs.LogGroup) {
+			for _, fn := range addParentsFns["loggroup"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *cloudfront.DistributionSummary) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *cloudwatchlogs.LogGroup) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -2257,62 +2611,66 @@ func (s *Cdn) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 	return gph, nil
 }
 
-func (s *Cdn) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
+func (s *Logs) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
 	defer s.fetcher.Reset()
-	return s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
 }
 
-func (s *Cdn) IsSyncDisabled() bool {
-	return !getBool(s.config, "aws.cdn.sync", true)
+func (s *Logs) IsSyncDisabled() bool {
+	return !getBool(s.config, "aws.logs.sync", true)
 }
 
-type Cloudformation struct {
+type Cdn struct {
 	fetcher         fetch.Fetcher
 	region, profile string
 	config          map[string]interface{}
 	log             *logger.Logger
-	cloudformationiface.CloudFormationAPI
+	cloudfrontiface.CloudFrontAPI
 }
 
-func NewCloudformation(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
-	region := awssdk.StringValue(sess.Config.Region)
-	cloudformationAPI := cloudformation.New(sess)
+func NewCdn(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
+	region := "global"
+	cloudfrontAPI := cloudfront.New(sess)
 
 	fetchConfig := awsfetch.NewConfig(
-		cloudformationAPI,
+		cloudfrontAPI,
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
 
-	return &Cloudformation{
-		CloudFormationAPI: cloudformationAPI,
-		fetcher:           fetch.NewFetcher(awsfetch.BuildCloudformationFetchFuncs(fetchConfig)),
-		config:            extraConf,
-		region:            region,
-		profile:           profile,
-		log:               log,
+	return &Cdn{
+		CloudFrontAPI: cloudfrontAPI,
+		fetcher:       fetch.NewFetcher(awsfetch.BuildCdnFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
+		config:        extraConf,
+		region:        region,
+		profile:       profile,
+		log:           log,
 	}
 }
 
-func (s *Cloudformation) Name() string {
-	return "cloudformation"
+func (s *Cdn) Name() string {
+	return "cdn"
 }
 
-func (s *Cloudformation) Region() string {
+func (s *Cdn) Region() string {
 	return s.region
 }
 
-func (s *Cloudformation) Profile() string {
+func (s *Cdn) Profile() string {
 	return s.profile
 }
 
-func (s *Cloudformation) ResourceTypes() []string {
+func (s *Cdn) ResourceTypes() []string {
 	return []string{
-		"stack",
+		"distribution",
 	}
 }
 
-func (s *Cloudformation) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
+func (s *Cdn) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 	if s.IsSyncDisabled() {
 		return graph.NewGraph(), nil
 	}
@@ -2346,18 +2704,152 @@ func (s *Cloudformation) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 
 	errc := make(chan error)
 	var wg sync.WaitGroup
-	if getBool(s.config, "aws.cloudformation.stack.sync", true) {
-		list, err := s.fetcher.Get("stack_objects")
+	if getBool(s.config, "aws.cdn.distribution.sync", true) {
+		list, err := s.fetcher.Get("distribution_objects")
 		if err != nil {
 			return gph, err
 		}
-		if _, ok := list.([]*cloudformation.Stack); !ok {
-			return gph, errors.New("cannot cast to '[]*cloudformation.Stack' type from fetch context")
+		if _, ok := list.([]*cloudfront.DistributionSummary); !ok {
+			return gph, errors.New("cannot cast to '[]*cloudfront.DistributionSummary' type from fetch context")
 		}
-		for _, r := range list.([]*cloudformation.Stack) {
-			for _, fn := range addParentsFns["stack"] {
+		for _, r := range list.([]*cloudfront.DistributionSummary) {
+			for _, fn := range addParentsFns["distribution"] {
 				wg.Add(1)
-				go func(f addParentFn, snap tstore.RDFGraph, region string, res *cloudformation.Stack) {
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *cloudfront.DistributionSummary) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			allErrors.Add(err)
+		}
+	}
+
+	if allErrors.Any() {
+		return gph, allErrors
+	}
+
+	return gph, nil
+}
+
+func (s *Cdn) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
+	defer s.fetcher.Reset()
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
+}
+
+func (s *Cdn) IsSyncDisabled() bool {
+	return !getBool(s.config, "aws.cdn.sync", true)
+}
+
+type Cloudformation struct {
+	fetcher         fetch.Fetcher
+	region, profile string
+	config          map[string]interface{}
+	log             *logger.Logger
+	cloudformationiface.CloudFormationAPI
+}
+
+func NewCloudformation(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	cloudformationAPI := cloudformation.New(sess)
+
+	fetchConfig := awsfetch.NewConfig(
+		cloudformationAPI,
+	)
+	fetchConfig.Extra = extraConf
+	fetchConfig.Log = log
+
+	return &Cloudformation{
+		CloudFormationAPI: cloudformationAPI,
+		fetcher:           fetch.NewFetcher(awsfetch.BuildCloudformationFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
+		config:            extraConf,
+		region:            region,
+		profile:           profile,
+		log:               log,
+	}
+}
+
+func (s *Cloudformation) Name() string {
+	return "cloudformation"
+}
+
+func (s *Cloudformation) Region() string {
+	return s.region
+}
+
+func (s *Cloudformation) Profile() string {
+	return s.profile
+}
+
+func (s *Cloudformation) ResourceTypes() []string {
+	return []string{
+		"stack",
+	}
+}
+
+func (s *Cloudformation) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
+	if s.IsSyncDisabled() {
+		return graph.NewGraph(), nil
+	}
+
+	allErrors := new(fetch.Error)
+
+	gph, err := s.fetcher.Fetch(context.WithValue(ctx, "region", s.region))
+	defer s.fetcher.Reset()
+
+	for _, e := range *fetch.WrapError(err) {
+		switch ee := e.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				allErrors.Add(cloud.ErrFetchAccessDenied)
+			default:
+				allErrors.Add(ee)
+			}
+		case nil:
+			continue
+		default:
+			allErrors.Add(ee)
+		}
+	}
+
+	if err := gph.AddResource(graph.InitResource(cloud.Region, s.region)); err != nil {
+		return gph, err
+	}
+
+	snap := gph.AsRDFGraphSnaphot()
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+	if getBool(s.config, "aws.cloudformation.stack.sync", true) {
+		list, err := s.fetcher.Get("stack_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*cloudformation.Stack); !ok {
+			return gph, errors.New("cannot cast to '[]*cloudformation.Stack' type from fetch context")
+		}
+		for _, r := range list.([]*cloudformation.Stack) {
+			for _, fn := range addParentsFns["stack"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *cloudformation.Stack) {
 					defer wg.Done()
 					err := f(gph, snap, region, res)
 					if err != nil {
@@ -2389,9 +2881,331 @@ func (s *Cloudformation) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
 
 func (s *Cloudformation) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
 	defer s.fetcher.Reset()
-	return s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
 }
 
 func (s *Cloudformation) IsSyncDisabled() bool {
 	return !getBool(s.config, "aws.cloudformation.sync", true)
 }
+
+type Dynamodb struct {
+	fetcher         fetch.Fetcher
+	region, profile string
+	config          map[string]interface{}
+	log             *logger.Logger
+	dynamodbiface.DynamoDBAPI
+}
+
+func NewDynamodb(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	dynamodbAPI := dynamodb.New(sess)
+
+	fetchConfig := awsfetch.NewConfig(
+		dynamodbAPI,
+	)
+	fetchConfig.Extra = extraConf
+	fetchConfig.Log = log
+
+	return &Dynamodb{
+		DynamoDBAPI: dynamodbAPI,
+		fetcher:     fetch.NewFetcher(awsfetch.BuildDynamodbFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
+		config:      extraConf,
+		region:      region,
+		profile:     profile,
+		log:         log,
+	}
+}
+
+func (s *Dynamodb) Name() string {
+	return "dynamodb"
+}
+
+func (s *Dynamodb) Region() string {
+	return s.region
+}
+
+func (s *Dynamodb) Profile() string {
+	return s.profile
+}
+
+func (s *Dynamodb) ResourceTypes() []string {
+	return []string{
+		"table",
+		"globaltable",
+	}
+}
+
+func (s *Dynamodb) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
+	if s.IsSyncDisabled() {
+		return graph.NewGraph(), nil
+	}
+
+	allErrors := new(fetch.Error)
+
+	gph, err := s.fetcher.Fetch(context.WithValue(ctx, "region", s.region))
+	defer s.fetcher.Reset()
+
+	for _, e := range *fetch.WrapError(err) {
+		switch ee := e.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				allErrors.Add(cloud.ErrFetchAccessDenied)
+			default:
+				allErrors.Add(ee)
+			}
+		case nil:
+			continue
+		default:
+			allErrors.Add(ee)
+		}
+	}
+
+	if err := gph.AddResource(graph.InitResource(cloud.Region, s.region)); err != nil {
+		return gph, err
+	}
+
+	snap := gph.AsRDFGraphSnaphot()
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+	if getBool(s.config, "aws.dynamodb.table.sync", true) {
+		list, err := s.fetcher.Get("table_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*dynamodb.TableDescription); !ok {
+			return gph, errors.New("cannot cast to '[]*dynamodb.TableDescription' type from fetch context")
+		}
+		for _, r := range list.([]*dynamodb.TableDescription) {
+			for _, fn := range addParentsFns["table"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *dynamodb.TableDescription) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.dynamodb.globaltable.sync", true) {
+		list, err := s.fetcher.Get("globaltable_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*dynamodb.GlobalTableDescription); !ok {
+			return gph, errors.New("cannot cast to '[]*dynamodb.GlobalTableDescription' type from fetch context")
+		}
+		for _, r := range list.([]*dynamodb.GlobalTableDescription) {
+			for _, fn := range addParentsFns["globaltable"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *dynamodb.GlobalTableDescription) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			allErrors.Add(err)
+		}
+	}
+
+	if allErrors.Any() {
+		return gph, allErrors
+	}
+
+	return gph, nil
+}
+
+func (s *Dynamodb) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
+	defer s.fetcher.Reset()
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
+}
+
+func (s *Dynamodb) IsSyncDisabled() bool {
+	return !getBool(s.config, "aws.dynamodb.sync", true)
+}
+
+type Security struct {
+	fetcher         fetch.Fetcher
+	region, profile string
+	config          map[string]interface{}
+	log             *logger.Logger
+	kmsiface.KMSAPI
+	ssmiface.SSMAPI
+}
+
+func NewSecurity(sess *session.Session, profile string, extraConf map[string]interface{}, log *logger.Logger) cloud.Service {
+	region := awssdk.StringValue(sess.Config.Region)
+	kmsAPI := kms.New(sess)
+	ssmAPI := ssm.New(sess)
+
+	fetchConfig := awsfetch.NewConfig(
+		kmsAPI,
+		ssmAPI,
+	)
+	fetchConfig.Extra = extraConf
+	fetchConfig.Log = log
+
+	return &Security{
+		KMSAPI:  kmsAPI,
+		SSMAPI:  ssmAPI,
+		fetcher: fetch.NewFetcher(awsfetch.BuildSecurityFetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
+		config:  extraConf,
+		region:  region,
+		profile: profile,
+		log:     log,
+	}
+}
+
+func (s *Security) Name() string {
+	return "security"
+}
+
+func (s *Security) Region() string {
+	return s.region
+}
+
+func (s *Security) Profile() string {
+	return s.profile
+}
+
+func (s *Security) ResourceTypes() []string {
+	return []string{
+		"key",
+		"parameter",
+	}
+}
+
+func (s *Security) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
+	if s.IsSyncDisabled() {
+		return graph.NewGraph(), nil
+	}
+
+	allErrors := new(fetch.Error)
+
+	gph, err := s.fetcher.Fetch(context.WithValue(ctx, "region", s.region))
+	defer s.fetcher.Reset()
+
+	for _, e := range *fetch.WrapError(err) {
+		switch ee := e.(type) {
+		case awserr.RequestFailure:
+			switch ee.Message() {
+			case accessDenied:
+				allErrors.Add(cloud.ErrFetchAccessDenied)
+			default:
+				allErrors.Add(ee)
+			}
+		case nil:
+			continue
+		default:
+			allErrors.Add(ee)
+		}
+	}
+
+	if err := gph.AddResource(graph.InitResource(cloud.Region, s.region)); err != nil {
+		return gph, err
+	}
+
+	snap := gph.AsRDFGraphSnaphot()
+
+	errc := make(chan error)
+	var wg sync.WaitGroup
+	if getBool(s.config, "aws.security.key.sync", true) {
+		list, err := s.fetcher.Get("key_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*kms.KeyMetadata); !ok {
+			return gph, errors.New("cannot cast to '[]*kms.KeyMetadata' type from fetch context")
+		}
+		for _, r := range list.([]*kms.KeyMetadata) {
+			for _, fn := range addParentsFns["key"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *kms.KeyMetadata) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+	if getBool(s.config, "aws.security.parameter.sync", true) {
+		list, err := s.fetcher.Get("parameter_objects")
+		if err != nil {
+			return gph, err
+		}
+		if _, ok := list.([]*ssm.ParameterMetadata); !ok {
+			return gph, errors.New("cannot cast to '[]*ssm.ParameterMetadata' type from fetch context")
+		}
+		for _, r := range list.([]*ssm.ParameterMetadata) {
+			for _, fn := range addParentsFns["parameter"] {
+				wg.Add(1)
+				go func(f addParentFn, snap tstore.RDFGraph, region string, res *ssm.ParameterMetadata) {
+					defer wg.Done()
+					err := f(gph, snap, region, res)
+					if err != nil {
+						errc <- err
+						return
+					}
+				}(fn, snap, s.region, r)
+			}
+		}
+	}
+
+	go func() {
+		wg.Wait()
+		close(errc)
+	}()
+
+	for err := range errc {
+		if err != nil {
+			allErrors.Add(err)
+		}
+	}
+
+	if allErrors.Any() {
+		return gph, allErrors
+	}
+
+	return gph, nil
+}
+
+func (s *Security) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
+	defer s.fetcher.Reset()
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
+}
+
+func (s *Security) IsSyncDisabled() bool {
+	return !getBool(s.config, "aws.security.sync", true)
+}
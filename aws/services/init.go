@@ -19,6 +19,7 @@ package awsservices
 import (
 	"errors"
 
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/wallix/awless/aws/spec"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/graph"
@@ -27,7 +28,7 @@ import (
 )
 
 var (
-	AccessService, InfraService, StorageService, MessagingService, DnsService, LambdaService, MonitoringService, CdnService, CloudformationService cloud.Service
+	AccessService, InfraService, StorageService, MessagingService, DnsService, LambdaService, MonitoringService, LogsService, CdnService, CloudformationService, DynamodbService, SecurityService cloud.Service
 )
 
 func Init(profile, region string, extraConf map[string]interface{}, log *logger.Logger, profileSetterCallback func(val string) error, enableNetworkMonitor bool) error {
@@ -43,25 +44,10 @@ func Init(profile, region string, extraConf map[string]interface{}, log *logger.
 		return err
 	}
 
-	AccessService = NewAccess(sess, profile, extraConf, log)
-	InfraService = NewInfra(sess, profile, extraConf, log)
-	StorageService = NewStorage(sess, profile, extraConf, log)
-	MessagingService = NewMessaging(sess, profile, extraConf, log)
-	DnsService = NewDns(sess, profile, extraConf, log)
-	LambdaService = NewLambda(sess, profile, extraConf, log)
-	MonitoringService = NewMonitoring(sess, profile, extraConf, log)
-	CdnService = NewCdn(sess, profile, extraConf, log)
-	CloudformationService = NewCloudformation(sess, profile, extraConf, log)
-
-	cloud.ServiceRegistry[InfraService.Name()] = InfraService
-	cloud.ServiceRegistry[AccessService.Name()] = AccessService
-	cloud.ServiceRegistry[StorageService.Name()] = StorageService
-	cloud.ServiceRegistry[MessagingService.Name()] = MessagingService
-	cloud.ServiceRegistry[DnsService.Name()] = DnsService
-	cloud.ServiceRegistry[LambdaService.Name()] = LambdaService
-	cloud.ServiceRegistry[MonitoringService.Name()] = MonitoringService
-	cloud.ServiceRegistry[CdnService.Name()] = CdnService
-	cloud.ServiceRegistry[CloudformationService.Name()] = CloudformationService
+	for _, c := range serviceConstructors {
+		*c.service = c.new(rateLimitedSession(sess, extraConf), profile, extraConf, log)
+		cloud.ServiceRegistry[(*c.service).Name()] = *c.service
+	}
 
 	awsspec.CommandFactory = &awsspec.AWSFactory{
 		Log:  log,
@@ -78,6 +64,42 @@ func Init(profile, region string, extraConf map[string]interface{}, log *logger.
 	return nil
 }
 
+// NewServicesFor returns the cloud services of the given profile and region, without registering them
+func NewServicesFor(profile, region string, extraConf map[string]interface{}, log *logger.Logger, enableNetworkMonitor bool) ([]cloud.Service, error) {
+	sb := newSessionResolver().withRegion(region).withProfile(profile).withNetworkMonitor(enableNetworkMonitor)
+	sb = sb.withLogger(log).withCredentialResolvers()
+
+	sess, err := sb.resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	var services []cloud.Service
+	for _, c := range serviceConstructors {
+		services = append(services, c.new(rateLimitedSession(sess, extraConf), profile, extraConf, log))
+	}
+	return services, nil
+}
+
+// serviceConstructors builds the cloud services, each one being held in its package variable once registered by Init
+var serviceConstructors = []struct {
+	service *cloud.Service
+	new     func(*session.Session, string, map[string]interface{}, *logger.Logger) cloud.Service
+}{
+	{&InfraService, NewInfra},
+	{&AccessService, NewAccess},
+	{&StorageService, NewStorage},
+	{&MessagingService, NewMessaging},
+	{&DnsService, NewDns},
+	{&LambdaService, NewLambda},
+	{&MonitoringService, NewMonitoring},
+	{&LogsService, NewLogs},
+	{&CdnService, NewCdn},
+	{&CloudformationService, NewCloudformation},
+	{&DynamodbService, NewDynamodb},
+	{&SecurityService, NewSecurity},
+}
+
 func getBool(m map[string]interface{}, key string, def bool) bool {
 	if b, ok := m[key].(bool); ok {
 		return b
@@ -17,12 +17,15 @@ limitations under the License.
 package awsservices
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"sync"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/wallix/awless/cloud"
 )
 
@@ -212,3 +215,86 @@ func (s *Access) GetUserPolicies(username string) (*UserPolicies, error) {
 
 	return all, nil
 }
+
+// ParameterStore fills template holes such as {ssm:/golden/ami/latest} with the values
+// of SSM parameters. SecureString values are decrypted and returned as masked holes.
+type ParameterStore struct {
+	api ssmiface.SSMAPI
+}
+
+const parameterStorePrefix = "ssm"
+
+// GetParameters accepts at most 10 names per call
+const maxParametersPerCall = 10
+
+func (s *Security) ParameterStore() *ParameterStore {
+	return &ParameterStore{api: s.SSMAPI}
+}
+
+func (p *ParameterStore) Prefix() string {
+	return parameterStorePrefix
+}
+
+func (p *ParameterStore) Fill(holes []string) (map[string]interface{}, []string, error) {
+	fillers := make(map[string]interface{})
+	var masked, unknown []string
+
+	holesByName := make(map[string]string)
+	var names []*string
+	for _, hole := range holes {
+		name := strings.TrimPrefix(hole, parameterStorePrefix+":")
+		holesByName[name] = hole
+		names = append(names, awssdk.String(name))
+	}
+
+	for _, batch := range sliceOfSlice(names, maxParametersPerCall) {
+		out, err := p.api.GetParameters(&ssm.GetParametersInput{Names: batch, WithDecryption: awssdk.Bool(true)})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, param := range out.Parameters {
+			hole, ok := holesByName[awssdk.StringValue(param.Name)]
+			if !ok {
+				continue
+			}
+			value := awssdk.StringValue(param.Value)
+			switch awssdk.StringValue(param.Type) {
+			case ssm.ParameterTypeStringList:
+				var list []interface{}
+				for _, v := range strings.Split(value, ",") {
+					list = append(list, v)
+				}
+				fillers[hole] = list
+			case ssm.ParameterTypeSecureString:
+				fillers[hole] = value
+				masked = append(masked, hole)
+			default:
+				fillers[hole] = value
+			}
+		}
+		unknown = append(unknown, awssdk.StringValueSlice(out.InvalidParameters)...)
+	}
+	if len(unknown) > 0 {
+		return nil, nil, fmt.Errorf("unknown parameter(s): %s", strings.Join(unknown, ", "))
+	}
+
+	return fillers, masked, nil
+}
+
+func sliceOfSlice(in []*string, maxLength int) (res [][]*string) {
+	if maxLength <= 0 {
+		return
+	}
+	if len(in) == 0 {
+		return
+	}
+	for i := 0; i < len(in); i += maxLength {
+		if i+maxLength < len(in) {
+			res = append(res, in[i:i+maxLength])
+		} else {
+			res = append(res, in[i:])
+		}
+	}
+
+	return
+}
@@ -5,14 +5,21 @@ import (
 	"strconv"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 func (m *mockEc2) DescribeInstancesPages(input *ec2.DescribeInstancesInput, fn func(p *ec2.DescribeInstancesOutput, lastPage bool) (shouldContinue bool)) error {
@@ -38,6 +45,21 @@ func (m *mockRoute53) ListResourceRecordSetsPages(input *route53.ListResourceRec
 	return nil
 }
 
+func (m *mockRoute53) ListTagsForResources(input *route53.ListTagsForResourcesInput) (*route53.ListTagsForResourcesOutput, error) {
+	if len(input.ResourceIds) > 10 {
+		return nil, fmt.Errorf("list tags for resources: at most 10 resources expected, got %d", len(input.ResourceIds))
+	}
+	var sets []*route53.ResourceTagSet
+	for _, set := range m.resourcetagsets {
+		for _, id := range input.ResourceIds {
+			if awssdk.StringValue(set.ResourceId) == awssdk.StringValue(id) {
+				sets = append(sets, set)
+			}
+		}
+	}
+	return &route53.ListTagsForResourcesOutput{ResourceTagSets: sets}, nil
+}
+
 func (m *mockIam) ListUsersPages(input *iam.ListUsersInput, fn func(p *iam.ListUsersOutput, lastPage bool) (shouldContinue bool)) error {
 	fn(&iam.ListUsersOutput{Users: m.users}, true)
 	return nil
@@ -92,6 +114,50 @@ func (m *mockS3) GetBucketLocation(input *s3.GetBucketLocationInput) (*s3.GetBuc
 	return nil, fmt.Errorf("bucket location mock: bucket %s not found", awssdk.StringValue(input.Bucket))
 }
 
+func (m *mockS3) GetBucketEncryption(input *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	rules, ok := m.encryptionrules[awssdk.StringValue(input.Bucket)]
+	if !ok {
+		return nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found", nil)
+	}
+	if rules == nil {
+		return nil, awserr.New("AccessDenied", "Access Denied", nil)
+	}
+	return &s3.GetBucketEncryptionOutput{ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{Rules: rules}}, nil
+}
+
+// File systems are returned one per page to go through markers
+func (m *mockEfs) DescribeFileSystems(input *efs.DescribeFileSystemsInput) (*efs.DescribeFileSystemsOutput, error) {
+	var index int
+	if input.Marker != nil {
+		var err error
+		if index, err = strconv.Atoi(awssdk.StringValue(input.Marker)); err != nil {
+			return nil, err
+		}
+	}
+	out := &efs.DescribeFileSystemsOutput{}
+	if index < len(m.filesystemdescriptions) {
+		out.FileSystems = []*efs.FileSystemDescription{m.filesystemdescriptions[index]}
+	}
+	if index+1 < len(m.filesystemdescriptions) {
+		out.NextMarker = awssdk.String(strconv.Itoa(index + 1))
+	}
+	return out, nil
+}
+
+func (m *mockEfs) DescribeMountTargets(input *efs.DescribeMountTargetsInput) (*efs.DescribeMountTargetsOutput, error) {
+	out := &efs.DescribeMountTargetsOutput{}
+	for _, target := range m.mounttargetdescriptions {
+		if awssdk.StringValue(target.FileSystemId) == awssdk.StringValue(input.FileSystemId) {
+			out.MountTargets = append(out.MountTargets, target)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockEfs) DescribeMountTargetSecurityGroups(input *efs.DescribeMountTargetSecurityGroupsInput) (*efs.DescribeMountTargetSecurityGroupsOutput, error) {
+	return &efs.DescribeMountTargetSecurityGroupsOutput{SecurityGroups: m.mounttargetsecuritygroups[awssdk.StringValue(input.MountTargetId)]}, nil
+}
+
 func (m *mockSqs) GetQueueAttributes(input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
 	return &sqs.GetQueueAttributesOutput{Attributes: m.attributes[awssdk.StringValue(input.QueueUrl)]}, nil
 }
@@ -188,3 +254,102 @@ func (m *mockEcs) ListContainerInstancesPages(input *ecs.ListContainerInstancesI
 func (m *mockEcs) DescribeContainerInstances(input *ecs.DescribeContainerInstancesInput) (*ecs.DescribeContainerInstancesOutput, error) {
 	return &ecs.DescribeContainerInstancesOutput{ContainerInstances: m.containerinstances[awssdk.StringValue(input.Cluster)]}, nil
 }
+
+func (m *mockDynamodb) ListTablesPages(input *dynamodb.ListTablesInput, fn func(p *dynamodb.ListTablesOutput, lastPage bool) (shouldContinue bool)) error {
+	for i, table := range m.tabledescriptions {
+		out := &dynamodb.ListTablesOutput{TableNames: []*string{table.TableName}}
+		if i < len(m.tabledescriptions)-1 {
+			out.LastEvaluatedTableName = table.TableName
+		}
+		if !fn(out, i == len(m.tabledescriptions)-1) {
+			break
+		}
+	}
+	return nil
+}
+
+func (m *mockDynamodb) DescribeTable(input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	for _, table := range m.tabledescriptions {
+		if awssdk.StringValue(table.TableName) == awssdk.StringValue(input.TableName) {
+			return &dynamodb.DescribeTableOutput{Table: table}, nil
+		}
+	}
+	return nil, fmt.Errorf("describe table mock: table %s not found", awssdk.StringValue(input.TableName))
+}
+
+func (m *mockDynamodb) ListGlobalTables(input *dynamodb.ListGlobalTablesInput) (*dynamodb.ListGlobalTablesOutput, error) {
+	out := &dynamodb.ListGlobalTablesOutput{}
+	for _, global := range m.globaltabledescriptions {
+		out.GlobalTables = append(out.GlobalTables, &dynamodb.GlobalTable{GlobalTableName: global.GlobalTableName})
+	}
+	return out, nil
+}
+
+func (m *mockDynamodb) DescribeGlobalTable(input *dynamodb.DescribeGlobalTableInput) (*dynamodb.DescribeGlobalTableOutput, error) {
+	for _, global := range m.globaltabledescriptions {
+		if awssdk.StringValue(global.GlobalTableName) == awssdk.StringValue(input.GlobalTableName) {
+			return &dynamodb.DescribeGlobalTableOutput{GlobalTableDescription: global}, nil
+		}
+	}
+	return nil, fmt.Errorf("describe global table mock: global table %s not found", awssdk.StringValue(input.GlobalTableName))
+}
+
+func (m *mockKms) ListKeysPages(input *kms.ListKeysInput, fn func(p *kms.ListKeysOutput, lastPage bool) (shouldContinue bool)) error {
+	var keys []*kms.KeyListEntry
+	for _, key := range m.keymetadatas {
+		keys = append(keys, &kms.KeyListEntry{KeyId: key.KeyId, KeyArn: key.Arn})
+	}
+	fn(&kms.ListKeysOutput{Keys: keys}, true)
+	return nil
+}
+
+func (m *mockKms) DescribeKey(input *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error) {
+	for _, key := range m.keymetadatas {
+		if awssdk.StringValue(key.KeyId) == awssdk.StringValue(input.KeyId) {
+			return &kms.DescribeKeyOutput{KeyMetadata: key}, nil
+		}
+	}
+	return nil, fmt.Errorf("describe key mock: key %s not found", awssdk.StringValue(input.KeyId))
+}
+
+func (m *mockKms) GetKeyRotationStatus(input *kms.GetKeyRotationStatusInput) (*kms.GetKeyRotationStatusOutput, error) {
+	return &kms.GetKeyRotationStatusOutput{KeyRotationEnabled: awssdk.Bool(m.rotations[awssdk.StringValue(input.KeyId)])}, nil
+}
+
+func (m *mockKms) GetKeyPolicy(input *kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOutput, error) {
+	return &kms.GetKeyPolicyOutput{Policy: awssdk.String(m.policies[awssdk.StringValue(input.KeyId)])}, nil
+}
+
+func (m *mockSsm) GetParameters(input *ssm.GetParametersInput) (*ssm.GetParametersOutput, error) {
+	if len(input.Names) > 10 {
+		return nil, fmt.Errorf("get parameters mock: %d names, expected at most 10", len(input.Names))
+	}
+	out := &ssm.GetParametersOutput{}
+	for _, name := range input.Names {
+		if param, ok := m.values[awssdk.StringValue(name)]; ok {
+			out.Parameters = append(out.Parameters, param)
+		} else {
+			out.InvalidParameters = append(out.InvalidParameters, name)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockApigateway) GetResourcesPages(input *apigateway.GetResourcesInput, fn func(p *apigateway.GetResourcesOutput, lastPage bool) (shouldContinue bool)) error {
+	if len(input.Embed) != 1 || awssdk.StringValue(input.Embed[0]) != "methods" {
+		return fmt.Errorf("get resources mock: expected methods to be embedded, got %v", awssdk.StringValueSlice(input.Embed))
+	}
+	fn(&apigateway.GetResourcesOutput{Items: m.resources[awssdk.StringValue(input.RestApiId)]}, true)
+	return nil
+}
+
+func (m *mockApigateway) GetStages(input *apigateway.GetStagesInput) (*apigateway.GetStagesOutput, error) {
+	return &apigateway.GetStagesOutput{Item: m.stages[awssdk.StringValue(input.RestApiId)]}, nil
+}
+
+func (m *mockSfn) DescribeStateMachine(input *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error) {
+	if machine, ok := m.statemachines[awssdk.StringValue(input.StateMachineArn)]; ok {
+		return machine, nil
+	}
+	return nil, fmt.Errorf("describe state machine mock: state machine %s not found", awssdk.StringValue(input.StateMachineArn))
+}
@@ -18,6 +18,8 @@ var DefaultNetworkMonitor = &NetworkMonitor{requests: make(map[*request.Request]
 type NetworkMonitor struct {
 	requests map[*request.Request]*req
 	l        sync.Mutex
+
+	throttled, fetchRetries int
 }
 
 type req struct {
@@ -28,7 +30,11 @@ type req struct {
 }
 
 func (n *NetworkMonitor) DisplayStats(w io.Writer) {
-	fmt.Fprintf(w, "\n%d requests sent:\n", len(n.requests))
+	var retries int
+	for _, r := range n.requests {
+		retries += len(r.retries)
+	}
+	fmt.Fprintf(w, "\n%d requests sent (%d throttled, %d retried, %d resource fetches retried):\n", len(n.requests), n.throttled, retries, n.fetchRetries)
 
 	var sorted []*req
 
@@ -87,6 +93,18 @@ func (n *NetworkMonitor) addRequest(r *request.Request) {
 	}
 }
 
+func (n *NetworkMonitor) countThrottle() {
+	n.l.Lock()
+	defer n.l.Unlock()
+	n.throttled++
+}
+
+func (n *NetworkMonitor) countFetchRetry() {
+	n.l.Lock()
+	defer n.l.Unlock()
+	n.fetchRetries++
+}
+
 func (n *NetworkMonitor) setRequestEnd(r *request.Request) {
 	n.l.Lock()
 	defer n.l.Unlock()
@@ -27,10 +27,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/wallix/awless/aws/conv"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/fetch"
 	"github.com/wallix/awless/graph"
 	tstore "github.com/wallix/triplestore"
 )
@@ -78,6 +83,7 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.Volume: {
 		funcBuilder{parent: cloud.AvailabilityZone, fieldName: "AvailabilityZone"}.build(),
 		funcBuilder{parent: cloud.Instance, fieldName: "InstanceId", listName: "Attachments", relation: DEPENDING_ON}.build(),
+		addEncryptionKeyRelation(cloud.Volume),
 	},
 	cloud.ElasticIP: {
 		addRegionParent,
@@ -92,6 +98,16 @@ var addParentsFns = map[string][]addParentFn{
 		funcBuilder{parent: cloud.SecurityGroup, fieldName: "GroupId", listName: "Groups", relation: APPLIES_ON}.build(),
 		funcBuilder{parent: cloud.Instance, fieldName: "Attachment.InstanceId", relation: DEPENDING_ON}.build(),
 	},
+	cloud.VpcPeering: {
+		funcBuilder{parent: cloud.Vpc, fieldName: "RequesterVpcInfo.VpcId"}.build(),
+		funcBuilder{parent: cloud.Vpc, fieldName: "AccepterVpcInfo.VpcId", relation: DEPENDING_ON}.build(),
+	},
+	cloud.VpcEndpoint: {
+		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
+		funcBuilder{parent: cloud.Subnet, stringListName: "SubnetIds", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.RouteTable, stringListName: "RouteTableIds", relation: DEPENDING_ON}.build(),
+		funcBuilder{parent: cloud.SecurityGroup, fieldName: "GroupId", listName: "Groups", relation: APPLIES_ON}.build(),
+	},
 	// Loadbalancer
 	cloud.LoadBalancer: {
 		funcBuilder{parent: cloud.Vpc, fieldName: "VpcId"}.build(),
@@ -117,6 +133,18 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.Database: {
 		funcBuilder{parent: cloud.AvailabilityZone, fieldName: "AvailabilityZone"}.build(),
 		funcBuilder{parent: cloud.SecurityGroup, listName: "VpcSecurityGroups", fieldName: "VpcSecurityGroupId", relation: APPLIES_ON}.build(),
+		addEncryptionKeyRelation(cloud.Database),
+	},
+	// Cache
+	cloud.CacheCluster: {
+		addRegionParent,
+		funcBuilder{parent: cloud.SecurityGroup, fieldName: "SecurityGroupId", listName: "SecurityGroups", relation: APPLIES_ON}.build(),
+		funcBuilder{parent: cloud.ReplicationGroup, fieldName: "ReplicationGroupId", relation: APPLIES_ON}.build(),
+		addPropertyListRelation(cloud.CacheCluster, properties.Subnets, cloud.Subnet, DEPENDING_ON),
+	},
+	cloud.ReplicationGroup: {
+		addRegionParent,
+		addReplicationGroupMembersNetworkRelations,
 	},
 	// Autoscaling
 	cloud.LaunchConfiguration: {
@@ -143,22 +171,75 @@ var addParentsFns = map[string][]addParentFn{
 	cloud.Image:            {addRegionParent},
 	cloud.Repository:       {addRegionParent},
 	cloud.ContainerCluster: {addRegionParent},
-	cloud.ContainerTask:    {addRegionParent},
+	cloud.ContainerTask:    {addRegionParent, addContainerTaskLogGroups},
 	cloud.Certificate:      {addRegionParent},
 	cloud.User:             {userAddGroupsRelations, addManagedPoliciesRelations},
 	cloud.Role:             {addManagedPoliciesRelations},
 	cloud.Group:            {addManagedPoliciesRelations},
-	cloud.Bucket:           {addRegionParent},
-	cloud.Function:         {addRegionParent},
+	cloud.Bucket:           {addRegionParent, addEncryptionKeyRelation(cloud.Bucket)},
+	cloud.Queue:            {addEncryptionKeyRelation(cloud.Queue)},
+	cloud.Function:         {addRegionParent, addFunctionLogGroup},
 	cloud.Topic:            {addRegionParent},
 	cloud.Alarm:            {addRegionParent, addAlarmMetric},
 	cloud.Metric:           {addRegionParent},
+	cloud.LogGroup:         {addRegionParent, addEncryptionKeyRelation(cloud.LogGroup)},
 	cloud.Stack:            {addRegionParent},
+	cloud.Table:            {addRegionParent},
+	cloud.GlobalTable:      {addRegionParent},
+	cloud.Key:              {addRegionParent},
+	cloud.Parameter:        {addRegionParent, addEncryptionKeyRelation(cloud.Parameter)},
+	cloud.FileSystem:       {addRegionParent, addEncryptionKeyRelation(cloud.FileSystem)},
+	cloud.MountTarget: {
+		funcBuilder{parent: cloud.FileSystem, fieldName: "FileSystemId"}.build(),
+		funcBuilder{parent: cloud.Subnet, fieldName: "SubnetId", relation: DEPENDING_ON}.build(),
+		addPropertyListRelation(cloud.MountTarget, properties.SecurityGroups, cloud.SecurityGroup, APPLIES_ON),
+	},
+	cloud.RestApi: {
+		addRegionParent,
+		addPropertyListRelation(cloud.RestApi, properties.Functions, cloud.Function, DEPENDING_ON),
+	},
+	cloud.StateMachine: {
+		addRegionParent,
+		addPropertyListRelation(cloud.StateMachine, properties.Functions, cloud.Function, DEPENDING_ON),
+	},
 	cloud.MFADevice: {
 		funcBuilder{parent: cloud.User, fieldName: "User.UserId", relation: DEPENDING_ON}.build(),
 	},
 }
 
+// Relations resolved by name against resources of other types that are not available when fetching a single type
+var relationsResolvedByName = map[string]bool{
+	cloud.User:  true,
+	cloud.Role:  true,
+	cloud.Group: true,
+}
+
+func addParentsRelationsByType(g *graph.Graph, cache fetch.Cache, region, resourceType string) error {
+	if relationsResolvedByName[resourceType] {
+		return nil
+	}
+	list, err := cache.Get(fmt.Sprintf("%s_objects", resourceType))
+	if err != nil {
+		return err
+	}
+	objects := reflect.ValueOf(list)
+	if objects.Kind() != reflect.Slice {
+		return nil
+	}
+
+	snap := g.AsRDFGraphSnaphot()
+	allErrors := new(fetch.Error)
+	for i := 0; i < objects.Len(); i++ {
+		for _, fn := range addParentsFns[resourceType] {
+			allErrors.Add(fn(g, snap, region, objects.Index(i).Interface()))
+		}
+	}
+	if allErrors.Any() {
+		return allErrors
+	}
+	return nil
+}
+
 func (fb funcBuilder) build() addParentFn {
 	switch {
 	case fb.listName != "":
@@ -457,3 +538,151 @@ func addAlarmMetric(g *graph.Graph, snap tstore.RDFGraph, region string, i inter
 	}
 	return nil
 }
+
+// Lambda functions always log into a group named after them. Log groups are synced by the logs service,
+// so the group is not looked up: the relation is only resolved once graphs are merged, and is ignored
+// when visiting relations if the group does not exist.
+func addFunctionLogGroup(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
+	function, ok := i.(*lambda.FunctionConfiguration)
+	if !ok {
+		return fmt.Errorf("add function log group relation: not a function, but a %T", i)
+	}
+	res, err := awsconv.InitResource(function)
+	if err != nil {
+		return err
+	}
+	if name := awssdk.StringValue(function.FunctionName); name != "" {
+		return addRelation(g, graph.InitResource(cloud.LogGroup, "/aws/lambda/"+name), res, DEPENDING_ON)
+	}
+	return nil
+}
+
+// addContainerTaskLogGroups relates a task definition to the log groups of its awslogs containers,
+// which are not looked up either (see addFunctionLogGroup).
+func addContainerTaskLogGroups(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
+	task, ok := i.(*ecs.TaskDefinition)
+	if !ok {
+		return fmt.Errorf("add container task log groups relation: not a task definition, but a %T", i)
+	}
+	res, err := awsconv.InitResource(task)
+	if err != nil {
+		return err
+	}
+	groups := make(map[string]bool)
+	for _, container := range task.ContainerDefinitions {
+		conf := container.LogConfiguration
+		if conf == nil || awssdk.StringValue(conf.LogDriver) != ecs.LogDriverAwslogs {
+			continue
+		}
+		if group := awssdk.StringValue(conf.Options["awslogs-group"]); group != "" && !groups[group] {
+			groups[group] = true
+			if err = addRelation(g, graph.InitResource(cloud.LogGroup, group), res, DEPENDING_ON); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Relate a resource to the resources listed in one of its properties,
+// for references only resolved at fetch time through additional API calls
+func addPropertyListRelation(resourceType, propName, parentType string, relation int) addParentFn {
+	return func(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
+		res, err := awsconv.InitResource(i)
+		if err != nil {
+			return err
+		}
+		if res, err = g.GetResource(resourceType, res.Id()); err != nil {
+			return err
+		}
+		ids, ok := res.Properties()[propName].([]string)
+		if !ok {
+			return nil
+		}
+		for _, id := range ids {
+			if err = addRelation(g, graph.InitResource(parentType, id), res, relation); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Replication groups are only described with their member clusters:
+// they depend on the subnets and security groups of their synced members.
+func addReplicationGroupMembersNetworkRelations(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
+	group, ok := i.(*elasticache.ReplicationGroup)
+	if !ok {
+		return fmt.Errorf("add replication group relations: not a replication group, but a %T", i)
+	}
+	res, err := awsconv.InitResource(group)
+	if err != nil {
+		return err
+	}
+	subnets, securityGroups := make(map[string]bool), make(map[string]bool)
+	for _, member := range group.MemberClusters {
+		cluster, err := g.GetResource(cloud.CacheCluster, awssdk.StringValue(member))
+		if err != nil { // member not synced (ex: aws.infra.cachecluster.sync=false)
+			continue
+		}
+		ids, _ := cluster.Properties()[properties.Subnets].([]string)
+		for _, id := range ids {
+			subnets[id] = true
+		}
+		ids, _ = cluster.Properties()[properties.SecurityGroups].([]string)
+		for _, id := range ids {
+			securityGroups[id] = true
+		}
+	}
+	for id := range subnets {
+		if err = addRelation(g, graph.InitResource(cloud.Subnet, id), res, DEPENDING_ON); err != nil {
+			return err
+		}
+	}
+	for id := range securityGroups {
+		if err = addRelation(g, graph.InitResource(cloud.SecurityGroup, id), res, APPLIES_ON); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Relate a resource to the KMS key encrypting it, given the key referenced in its properties.
+// Keys referenced by alias are ignored as aliases are only known from the security service.
+func addEncryptionKeyRelation(resourceType string) addParentFn {
+	return func(g *graph.Graph, snap tstore.RDFGraph, region string, i interface{}) error {
+		var id string
+		if url, ok := i.(*string); ok { // queue objects are their urls
+			id = awssdk.StringValue(url)
+		} else {
+			res, err := awsconv.InitResource(i)
+			if err != nil {
+				return err
+			}
+			id = res.Id()
+		}
+		res, err := g.GetResource(resourceType, id)
+		if err != nil {
+			return err
+		}
+		ref, ok := res.Properties()[properties.EncryptionKey].(string)
+		if !ok {
+			return nil
+		}
+		if keyID := kmsKeyID(ref); keyID != "" {
+			return g.AddAppliesOnRelation(graph.InitResource(cloud.Key, keyID), res)
+		}
+		return nil
+	}
+}
+
+// kmsKeyID returns the ID of a KMS key referenced either by ID or ARN
+func kmsKeyID(ref string) string {
+	if strings.HasPrefix(ref, "alias/") || strings.Contains(ref, ":alias/") {
+		return ""
+	}
+	if i := strings.Index(ref, ":key/"); i > -1 {
+		return ref[i+len(":key/"):]
+	}
+	return ref
+}
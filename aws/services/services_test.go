@@ -23,25 +23,34 @@ import (
 	"net/url"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/wallix/awless/aws/fetch"
 	"github.com/wallix/awless/cloud"
 	p "github.com/wallix/awless/cloud/properties"
@@ -355,6 +364,38 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 			VpcId:              awssdk.String("vpc_2")},
 	}
 
+	vpcPeerings := []*ec2.VpcPeeringConnection{
+		{
+			VpcPeeringConnectionId: awssdk.String("pcx_1"),
+			RequesterVpcInfo:       &ec2.VpcPeeringConnectionVpcInfo{VpcId: awssdk.String("vpc_1"), CidrBlock: awssdk.String("10.0.0.0/16"), OwnerId: awssdk.String("123456789012")},
+			AccepterVpcInfo:        &ec2.VpcPeeringConnectionVpcInfo{VpcId: awssdk.String("vpc_2"), CidrBlock: awssdk.String("10.1.0.0/16"), OwnerId: awssdk.String("123456789012"), Region: awssdk.String("eu-west-1")},
+			Status:                 &ec2.VpcPeeringConnectionStateReason{Code: awssdk.String("active"), Message: awssdk.String("Active")},
+			Tags:                   []*ec2.Tag{{Key: awssdk.String("Name"), Value: awssdk.String("my-peering")}},
+		},
+	}
+
+	vpcEndpoints := []*ec2.VpcEndpoint{
+		{
+			VpcEndpointId:     awssdk.String("vpce_1"),
+			VpcEndpointType:   awssdk.String("Gateway"),
+			ServiceName:       awssdk.String("com.amazonaws.eu-west-1.s3"),
+			State:             awssdk.String("available"),
+			CreationTimestamp: awssdk.Time(now),
+			PolicyDocument:    awssdk.String("{}"),
+			RouteTableIds:     []*string{awssdk.String("rt_1")},
+			VpcId:             awssdk.String("vpc_1"),
+		},
+		{
+			VpcEndpointId:       awssdk.String("vpce_2"),
+			VpcEndpointType:     awssdk.String("Interface"),
+			ServiceName:         awssdk.String("com.amazonaws.eu-west-1.ec2"),
+			Groups:              []*ec2.SecurityGroupIdentifier{{GroupId: awssdk.String("securitygroup_1")}},
+			NetworkInterfaceIds: []*string{awssdk.String("eni-2")},
+			SubnetIds:           []*string{awssdk.String("sub_3")},
+			VpcId:               awssdk.String("vpc_2"),
+		},
+	}
+
 	availabilityZones := []*ec2.AvailabilityZone{
 		{ZoneName: awssdk.String("us-west-1a"), State: awssdk.String("available"), RegionName: awssdk.String("us-west-1"), Messages: []*ec2.AvailabilityZoneMessage{{Message: awssdk.String("msg 1")}, {Message: awssdk.String("msg 2")}}},
 		{ZoneName: awssdk.String("us-west-1b")},
@@ -556,12 +597,37 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		{CertificateArn: awssdk.String("arn:certif_3456"), DomainName: awssdk.String("domain-name.3")},
 	}
 
-	mock := &mockEc2{vpcs: vpcs, securitygroups: securityGroups, subnets: subnets, instances: instances, keypairinfos: keypairs, internetgateways: igws, routetables: routeTables, images: images, availabilityzones: availabilityZones, natgateways: natgws, networkinterfaces: networkInterfaces}
+	//ElastiCache
+	cacheClusters := []*elasticache.CacheCluster{
+		{
+			CacheClusterId:       awssdk.String("cache_1"),
+			CacheNodeType:        awssdk.String("cache.t2.micro"),
+			CacheClusterStatus:   awssdk.String("available"),
+			Engine:               awssdk.String("redis"),
+			EngineVersion:        awssdk.String("3.2.10"),
+			NumCacheNodes:        awssdk.Int64(1),
+			CacheSubnetGroupName: awssdk.String("cache_subnets"),
+			ReplicationGroupId:   awssdk.String("repl_1"),
+			SecurityGroups:       []*elasticache.SecurityGroupMembership{{SecurityGroupId: awssdk.String("securitygroup_2"), Status: awssdk.String("active")}},
+		},
+		{CacheClusterId: awssdk.String("cache_2"), CacheSubnetGroupName: awssdk.String("cache_subnets"), ReplicationGroupId: awssdk.String("repl_1")},
+		{CacheClusterId: awssdk.String("cache_3"), Engine: awssdk.String("memcached"), ConfigurationEndpoint: &elasticache.Endpoint{Address: awssdk.String("cache-3.cfg.cache.amazonaws.com"), Port: awssdk.Int64(11211)}},
+	}
+	replicationGroups := []*elasticache.ReplicationGroup{
+		{ReplicationGroupId: awssdk.String("repl_1"), Description: awssdk.String("my replication group"), Status: awssdk.String("available"), AutomaticFailover: awssdk.String("enabled"), MemberClusters: awssdk.StringSlice([]string{"cache_1", "cache_2"})},
+	}
+	cacheSubnetGroups := []*elasticache.CacheSubnetGroup{
+		{CacheSubnetGroupName: awssdk.String("cache_subnets"), VpcId: awssdk.String("vpc_1"), Subnets: []*elasticache.Subnet{{SubnetIdentifier: awssdk.String("sub_1")}, {SubnetIdentifier: awssdk.String("sub_2")}}},
+		{CacheSubnetGroupName: awssdk.String("unused_subnets"), VpcId: awssdk.String("vpc_2"), Subnets: []*elasticache.Subnet{{SubnetIdentifier: awssdk.String("sub_3")}}},
+	}
+
+	mock := &mockEc2{vpcs: vpcs, securitygroups: securityGroups, subnets: subnets, instances: instances, keypairinfos: keypairs, internetgateways: igws, routetables: routeTables, images: images, availabilityzones: availabilityZones, natgateways: natgws, networkinterfaces: networkInterfaces, vpcpeeringconnections: vpcPeerings, vpcendpoints: vpcEndpoints}
 	mockLb := &mockElbv2{loadbalancers: lbPages, targetgroups: targetGroups, listeners: listeners, targethealthdescriptions: targetHealths}
 	mockClassicLb := &mockElb{loadbalancerdescriptions: classicLbPages}
 	mockEcr := &mockEcr{repositorys: repositories}
 	mockEcs := &mockEcs{clusterNames: clusterNames, clusters: clusters, taskdefinitionNames: defNames, taskdefinitions: tasksDef, tasksNames: tasksNames, tasks: tasks, containerinstancesNames: containerInstancesNames, containerinstances: containerInstances}
 	mockRds := &mockRds{}
+	mockElasticache := &mockElasticache{cacheclusters: cacheClusters, replicationgroups: replicationGroups, cachesubnetgroups: cacheSubnetGroups}
 	mockAcm := &mockAcm{certificatesummarys: certificates}
 	mockAutoscaling := &mockAutoscaling{launchconfigurations: launchConfigs, groups: scalingGroups}
 	InfraService = &Infra{
@@ -571,16 +637,17 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		ELBAPI:         mockClassicLb,
 		ELBV2API:       mockLb,
 		RDSAPI:         mockRds,
+		ElastiCacheAPI: mockElasticache,
 		ACMAPI:         mockAcm,
 		AutoScalingAPI: mockAutoscaling,
 		region:         "eu-west-1",
-		fetcher:        fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(mock, mockEcr, mockEcs, mockClassicLb, mockLb, mockRds, mockAutoscaling, mockAcm))),
+		fetcher:        fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(mock, mockEcr, mockEcs, mockClassicLb, mockLb, mockRds, mockElasticache, mockAutoscaling, mockAcm))),
 	}
 	g, err := InfraService.Fetch(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
-	resources, err := g.Find(cloud.NewQuery("region", "instance", "vpc", "securitygroup", "subnet", "keypair", "internetgateway", cloud.NatGateway, "routetable", "classicloadbalancer", "loadbalancer", "targetgroup", "listener", "launchconfiguration", "scalinggroup", "image", "availabilityzone", "repository", cloud.ContainerCluster, cloud.ContainerTask, cloud.Container, cloud.ContainerInstance, cloud.NetworkInterface, cloud.Certificate))
+	resources, err := g.Find(cloud.NewQuery("region", "instance", "vpc", "securitygroup", "subnet", "keypair", "internetgateway", cloud.NatGateway, "routetable", "classicloadbalancer", "loadbalancer", "targetgroup", "listener", "launchconfiguration", "scalinggroup", "image", "availabilityzone", "repository", cloud.ContainerCluster, cloud.ContainerTask, cloud.Container, cloud.ContainerInstance, cloud.NetworkInterface, cloud.Certificate, cloud.VpcPeering, cloud.VpcEndpoint, cloud.CacheCluster, cloud.ReplicationGroup))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -628,6 +695,12 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		if p, ok := res.Properties()[p.IPv6Addresses].([]string); ok {
 			sort.Strings(p)
 		}
+		if p, ok := res.Properties()[p.Subnets].([]string); ok {
+			sort.Strings(p)
+		}
+		if p, ok := res.Properties()[p.Members].([]string); ok {
+			sort.Strings(p)
+		}
 	}
 
 	expected := map[string]cloud.Resource{
@@ -702,18 +775,29 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"arn:certif_1234": resourcetest.Certificate("arn:certif_1234").Prop(p.Arn, "arn:certif_1234").Prop(p.Name, "domain-name.1").Build(),
 		"arn:certif_2345": resourcetest.Certificate("arn:certif_2345").Prop(p.Arn, "arn:certif_2345").Prop(p.Name, "domain-name.2").Build(),
 		"arn:certif_3456": resourcetest.Certificate("arn:certif_3456").Prop(p.Arn, "arn:certif_3456").Prop(p.Name, "domain-name.3").Build(),
+		"pcx_1": resourcetest.VpcPeering("pcx_1").Prop(p.Name, "my-peering").Prop(p.Vpc, "vpc_1").Prop(p.CIDR, "10.0.0.0/16").Prop(p.Owner, "123456789012").Prop(p.PeerVpc, "vpc_2").Prop(p.PeerCIDR, "10.1.0.0/16").
+			Prop(p.PeerOwner, "123456789012").Prop(p.PeerRegion, "eu-west-1").Prop(p.State, "active").Prop(p.StateMessage, "Active").Prop(p.Tags, []string{"Name=my-peering"}).Build(),
+		"vpce_1": resourcetest.VpcEndpoint("vpce_1").Prop(p.Type, "Gateway").Prop(p.Service, "com.amazonaws.eu-west-1.s3").Prop(p.State, "available").Prop(p.Created, now).Prop(p.Document, "{}").
+			Prop(p.RouteTables, []string{"rt_1"}).Prop(p.Vpc, "vpc_1").Build(),
+		"vpce_2": resourcetest.VpcEndpoint("vpce_2").Prop(p.Type, "Interface").Prop(p.Service, "com.amazonaws.eu-west-1.ec2").Prop(p.SecurityGroups, []string{"securitygroup_1"}).Prop(p.NetworkInterfaces, []string{"eni-2"}).
+			Prop(p.Subnets, []string{"sub_3"}).Prop(p.Vpc, "vpc_2").Build(),
+		"cache_1": resourcetest.CacheCluster("cache_1").Prop(p.Class, "cache.t2.micro").Prop(p.State, "available").Prop(p.Engine, "redis").Prop(p.EngineVersion, "3.2.10").Prop(p.NodeCount, 1).
+			Prop(p.CacheSubnetGroup, "cache_subnets").Prop(p.ReplicationGroup, "repl_1").Prop(p.SecurityGroups, []string{"securitygroup_2"}).Prop(p.Subnets, []string{"sub_1", "sub_2"}).Prop(p.Vpc, "vpc_1").Build(),
+		"cache_2": resourcetest.CacheCluster("cache_2").Prop(p.CacheSubnetGroup, "cache_subnets").Prop(p.ReplicationGroup, "repl_1").Prop(p.Subnets, []string{"sub_1", "sub_2"}).Prop(p.Vpc, "vpc_1").Build(),
+		"cache_3": resourcetest.CacheCluster("cache_3").Prop(p.Engine, "memcached").Prop(p.PublicDNS, "cache-3.cfg.cache.amazonaws.com").Prop(p.Port, 11211).Build(),
+		"repl_1":  resourcetest.ReplicationGroup("repl_1").Prop(p.Description, "my replication group").Prop(p.State, "available").Prop(p.Failover, "enabled").Prop(p.Members, []string{"cache_1", "cache_2"}).Build(),
 	}
 
 	expectedChildren := map[string][]string{
-		"eu-west-1": {"arn:certif_1234", "arn:certif_2345", "arn:certif_3456", "asg_arn_1", "asg_arn_2", "clust_1", "clust_2", "clust_3", "cs_1:1", "cs_2:1", "cs_2:2", "cs_3:1", "igw_1", "img_1", "img_2", "launchconfig_arn", "my_key", "natgw_1", "repo_1", "repo_2", "repo_3", "us-west-1a", "us-west-1b", "vpc_1", "vpc_2"},
+		"eu-west-1": {"arn:certif_1234", "arn:certif_2345", "arn:certif_3456", "asg_arn_1", "asg_arn_2", "cache_1", "cache_2", "cache_3", "clust_1", "clust_2", "clust_3", "cs_1:1", "cs_2:1", "cs_2:2", "cs_3:1", "igw_1", "img_1", "img_2", "launchconfig_arn", "my_key", "natgw_1", "repl_1", "repo_1", "repo_2", "repo_3", "us-west-1a", "us-west-1b", "vpc_1", "vpc_2"},
 		"lb_1":      {"list_1", "list_1.2"},
 		"lb_2":      {"list_2"},
 		"lb_3":      {"list_3"},
 		"sub_1":     {"eni-1", "inst_1"},
 		"sub_2":     {"inst_2"},
 		"sub_3":     {"eni-2", "inst_3", "inst_4", "inst_6"},
-		"vpc_1":     {"lb_1", "lb_3", "my_classic_loadbalancer_1", "my_classic_loadbalancer_3", "natgw_1", "rt_1", "securitygroup_1", "securitygroup_2", "sub_1", "sub_2", "tg_1"},
-		"vpc_2":     {"lb_2", "my_classic_loadbalancer_2", "sub_3", "tg_2"},
+		"vpc_1":     {"lb_1", "lb_3", "my_classic_loadbalancer_1", "my_classic_loadbalancer_3", "natgw_1", "pcx_1", "rt_1", "securitygroup_1", "securitygroup_2", "sub_1", "sub_2", "tg_1", "vpce_1"},
+		"vpc_2":     {"lb_2", "my_classic_loadbalancer_2", "sub_3", "tg_2", "vpce_2"},
 		"clust_1":   {"cont_inst_1", "cont_inst_2", "container_1", "container_2", "container_3"},
 		"clust_2":   {"cont_inst_3", "container_4", "container_5"},
 	}
@@ -726,8 +810,8 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"my_key":          {"inst_4", "inst_6", "launchconfig_arn"},
 		"natgw_1":         {"sub_1"},
 		"rt_1":            {"sub_1", "sub_2"},
-		"securitygroup_1": {"eni-1", "inst_2", "inst_4", "inst_6", "lb_3", "my_classic_loadbalancer_3"},
-		"securitygroup_2": {"eni-1", "inst_4", "lb_3", "my_classic_loadbalancer_3"},
+		"securitygroup_1": {"eni-1", "inst_2", "inst_4", "inst_6", "lb_3", "my_classic_loadbalancer_3", "vpce_2"},
+		"securitygroup_2": {"cache_1", "eni-1", "inst_4", "lb_3", "my_classic_loadbalancer_3", "repl_1"},
 		"tg_1":            {"inst_1"},
 		"tg_2":            {"inst_2", "inst_3"},
 		"asg_arn_1":       {"inst_1", "inst_3", "sub_1", "sub_2"},
@@ -742,6 +826,12 @@ func TestBuildInfraRdfGraph(t *testing.T) {
 		"cont_inst_2":     {"container_4"},
 		"cont_inst_3":     {"container_5"},
 		"eni-1":           {"inst_1"},
+		"pcx_1":           {"vpc_2"},
+		"vpce_1":          {"rt_1"},
+		"vpce_2":          {"sub_3"},
+		"repl_1":          {"cache_1", "cache_2", "sub_1", "sub_2"},
+		"cache_1":         {"sub_1", "sub_2"},
+		"cache_2":         {"sub_1", "sub_2"},
 	}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
@@ -791,36 +881,102 @@ func TestBuildStorageRdfGraph(t *testing.T) {
 		},
 	}
 
-	mocks3 := &mockS3{buckets: buckets, objects: objects, grants: bucketsACL}
+	encryptionRules := map[string][]*s3.ServerSideEncryptionRule{
+		"bucket_eu_1": nil, // access denied
+		"bucket_eu_2": {
+			{ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{SSEAlgorithm: awssdk.String("aws:kms"), KMSMasterKeyID: awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/key_1")}},
+		},
+	}
+
+	fileSystems := []*efs.FileSystemDescription{
+		{
+			FileSystemId:         awssdk.String("fs_1"),
+			Name:                 awssdk.String("my-fs"),
+			LifeCycleState:       awssdk.String("available"),
+			Encrypted:            awssdk.Bool(true),
+			KmsKeyId:             awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/key_2"),
+			NumberOfMountTargets: awssdk.Int64(2),
+			OwnerId:              awssdk.String("123456789012"),
+			PerformanceMode:      awssdk.String("generalPurpose"),
+			SizeInBytes:          &efs.FileSystemSize{Value: awssdk.Int64(6144)},
+		},
+		{FileSystemId: awssdk.String("fs_2"), LifeCycleState: awssdk.String("creating")},
+	}
+	mountTargets := []*efs.MountTargetDescription{
+		{MountTargetId: awssdk.String("mt_1"), FileSystemId: awssdk.String("fs_1"), SubnetId: awssdk.String("sub_1"), IpAddress: awssdk.String("10.0.1.10"), LifeCycleState: awssdk.String("available")},
+		{MountTargetId: awssdk.String("mt_2"), FileSystemId: awssdk.String("fs_1"), SubnetId: awssdk.String("sub_2")},
+	}
+	mountTargetsSecurityGroups := map[string][]*string{
+		"mt_1": awssdk.StringSlice([]string{"sg_1", "sg_2"}),
+	}
+
+	mocks3 := &mockS3{buckets: buckets, objects: objects, grants: bucketsACL, encryptionrules: encryptionRules}
+	mockEfs := &mockEfs{filesystemdescriptions: fileSystems, mounttargetdescriptions: mountTargets, mounttargetsecuritygroups: mountTargetsSecurityGroups}
 	StorageService = mocks3
 	storage := Storage{
 		S3API:   mocks3,
+		EFSAPI:  mockEfs,
 		region:  "eu-west-1",
-		fetcher: fetch.NewFetcher(awsfetch.BuildStorageFetchFuncs(awsfetch.NewConfig(mocks3))),
+		fetcher: fetch.NewFetcher(awsfetch.BuildStorageFetchFuncs(awsfetch.NewConfig(mocks3, mockEfs))),
 	}
 
 	g, err := storage.Fetch(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
-	resources, err := g.Find(cloud.NewQuery("region", "bucket"))
+	for _, id := range []string{"sub_1", "sub_2"} {
+		g.(*graph.Graph).AddResource(resourcetest.Subnet(id).Build()) // subnets are synced by the infra service
+	}
+	resources, err := g.Find(cloud.NewQuery("region", "bucket", "filesystem", "mounttarget"))
 	if err != nil {
 		t.Fatal(err)
 	}
+	for _, res := range resources {
+		if p, ok := res.Properties()[p.SecurityGroups].([]string); ok {
+			sort.Strings(p)
+		}
+	}
 
 	expected := map[string]cloud.Resource{
 		"eu-west-1":   resourcetest.Region("eu-west-1").Build(),
 		"bucket_eu_1": resourcetest.Bucket("bucket_eu_1").Prop(p.Grants, []*graph.Grant{{Grantee: graph.Grantee{GranteeID: "usr_2"}, Permission: "Write"}}).Build(),
-		"bucket_eu_2": resourcetest.Bucket("bucket_eu_2").Prop(p.Grants, []*graph.Grant{{Grantee: graph.Grantee{GranteeID: "usr_1"}, Permission: "Write"}}).Build(),
+		"bucket_eu_2": resourcetest.Bucket("bucket_eu_2").Prop(p.Grants, []*graph.Grant{{Grantee: graph.Grantee{GranteeID: "usr_1"}, Permission: "Write"}}).Prop(p.EncryptionKey, "arn:aws:kms:eu-west-1:123456789012:key/key_1").Build(),
+		"fs_1": resourcetest.FileSystem("fs_1").Prop(p.Name, "my-fs").Prop(p.State, "available").Prop(p.Encrypted, true).Prop(p.EncryptionKey, "arn:aws:kms:eu-west-1:123456789012:key/key_2").
+			Prop(p.MountTargetCount, 2).Prop(p.Owner, "123456789012").Prop(p.PerformanceMode, "generalPurpose").Prop(p.Size, 6144).Build(),
+		"fs_2": resourcetest.FileSystem("fs_2").Prop(p.State, "creating").Build(),
+		"mt_1": resourcetest.MountTarget("mt_1").Prop(p.FileSystem, "fs_1").Prop(p.Subnet, "sub_1").Prop(p.PrivateIP, "10.0.1.10").Prop(p.State, "available").Prop(p.SecurityGroups, []string{"sg_1", "sg_2"}).Build(),
+		"mt_2": resourcetest.MountTarget("mt_2").Prop(p.FileSystem, "fs_1").Prop(p.Subnet, "sub_2").Build(),
 	}
 	expectedChildren := map[string][]string{
-		"eu-west-1":   {"bucket_eu_1", "bucket_eu_2"},
+		"eu-west-1":   {"bucket_eu_1", "bucket_eu_2", "fs_1", "fs_2"},
 		"bucket_eu_1": {"obj_4"},
 		"bucket_eu_2": {"obj_5", "obj_6"},
+		"fs_1":        {"mt_1", "mt_2"},
+	}
+	expectedAppliedOn := map[string][]string{
+		"mt_1": {"sub_1"},
+		"mt_2": {"sub_2"},
 	}
-	expectedAppliedOn := map[string][]string{}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	key := resourcetest.Key("key_1").Build()
+	g.(*graph.Graph).AddResource(key) // keys are synced by the security service
+	if got, want := mustGetAppliedOnId(g, key), []string{"bucket_eu_2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("key appliedOn: got %v, want %v", got, want)
+	}
+	key = resourcetest.Key("key_2").Build()
+	g.(*graph.Graph).AddResource(key)
+	if got, want := mustGetAppliedOnId(g, key), []string{"fs_1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("key appliedOn: got %v, want %v", got, want)
+	}
+	for _, id := range []string{"sg_1", "sg_2"} {
+		group := resourcetest.SecurityGroup(id).Build()
+		g.(*graph.Graph).AddResource(group) // security groups are synced by the infra service
+		if got, want := mustGetAppliedOnId(g, group), []string{"mt_1"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s appliedOn: got %v, want %v", id, got, want)
+		}
+	}
 }
 
 func TestBuildDnsRdfGraph(t *testing.T) {
@@ -838,9 +994,24 @@ func TestBuildDnsRdfGraph(t *testing.T) {
 		"/hostedzone/23456": {
 			{Type: awssdk.String("A"), TTL: awssdk.Int64(30), Name: awssdk.String("subdomain1.my.second.domain"), ResourceRecords: []*route53.ResourceRecord{{Value: awssdk.String("5.6.7.8")}}},
 			{Type: awssdk.String("CNAME"), TTL: awssdk.Int64(10), Name: awssdk.String("subdomain3.my.second.domain"), ResourceRecords: []*route53.ResourceRecord{{Value: awssdk.String("6.7.8.9")}}},
+			{Type: awssdk.String("A"), TTL: awssdk.Int64(60), Name: awssdk.String("www.my.second.domain"), SetIdentifier: awssdk.String("blue"), Weight: awssdk.Int64(100), HealthCheckId: awssdk.String("hc_1"), ResourceRecords: []*route53.ResourceRecord{{Value: awssdk.String("7.8.9.10")}}},
+			{Type: awssdk.String("A"), TTL: awssdk.Int64(60), Name: awssdk.String("www.my.second.domain"), SetIdentifier: awssdk.String("green"), Weight: awssdk.Int64(0), HealthCheckId: awssdk.String("hc_2"), ResourceRecords: []*route53.ResourceRecord{{Value: awssdk.String("8.9.10.11")}}},
 		},
 	}
-	mockRoute53 := &mockRoute53{hostedzones: zonePages, resourcerecordsets: recordPages}
+	healthChecks := []*route53.HealthCheck{
+		{Id: awssdk.String("hc_1"), CallerReference: awssdk.String("ref_1"), HealthCheckVersion: awssdk.Int64(2), HealthCheckConfig: &route53.HealthCheckConfig{
+			Type: awssdk.String("HTTP"), IPAddress: awssdk.String("7.8.9.10"), Port: awssdk.Int64(80), ResourcePath: awssdk.String("/health"), RequestInterval: awssdk.Int64(30), FailureThreshold: awssdk.Int64(3),
+		}},
+		{Id: awssdk.String("hc_2"), CallerReference: awssdk.String("ref_2"), HealthCheckConfig: &route53.HealthCheckConfig{
+			Type: awssdk.String("TCP"), FullyQualifiedDomainName: awssdk.String("green.my.second.domain"), Port: awssdk.Int64(443),
+		}},
+		{Id: awssdk.String("hc_3"), CallerReference: awssdk.String("ref_3"), HealthCheckConfig: &route53.HealthCheckConfig{Type: awssdk.String("CALCULATED")}},
+	}
+	tags := []*route53.ResourceTagSet{
+		{ResourceId: awssdk.String("hc_1"), ResourceType: awssdk.String("healthcheck"), Tags: []*route53.Tag{{Key: awssdk.String("Env"), Value: awssdk.String("prod")}, {Key: awssdk.String("Name"), Value: awssdk.String("blue-check")}}},
+		{ResourceId: awssdk.String("hc_3"), ResourceType: awssdk.String("healthcheck"), Tags: []*route53.Tag{{Key: awssdk.String("Env"), Value: awssdk.String("prod")}}},
+	}
+	mockRoute53 := &mockRoute53{hostedzones: zonePages, resourcerecordsets: recordPages, healthchecks: healthChecks, resourcetagsets: tags}
 
 	dns := Dns{
 		Route53API: mockRoute53, region: "eu-west-1",
@@ -852,7 +1023,7 @@ func TestBuildDnsRdfGraph(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	resources, err := g.Find(cloud.NewQuery("zone", "record"))
+	resources, err := g.Find(cloud.NewQuery("zone", "record", "healthcheck"))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -872,12 +1043,23 @@ func TestBuildDnsRdfGraph(t *testing.T) {
 		"awls-be1e0b6a":     resourcetest.Record("awls-be1e0b6a").Prop(p.Name, "subdomain3.my.first.domain").Prop(p.Zone, "my.first.domain").Prop(p.Type, "CNAME").Prop(p.TTL, 60).Prop(p.Records, []string{"4.5.6.7"}).Build(),
 		"awls-9c420a99":     resourcetest.Record("awls-9c420a99").Prop(p.Name, "subdomain1.my.second.domain").Prop(p.Zone, "my.second.domain").Prop(p.Type, "A").Prop(p.TTL, 30).Prop(p.Records, []string{"5.6.7.8"}).Build(),
 		"awls-c9b80bbe":     resourcetest.Record("awls-c9b80bbe").Prop(p.Name, "subdomain3.my.second.domain").Prop(p.Zone, "my.second.domain").Prop(p.Type, "CNAME").Prop(p.TTL, 10).Prop(p.Records, []string{"6.7.8.9"}).Build(),
+		"awls-7e6209b3": resourcetest.Record("awls-7e6209b3").Prop(p.Name, "www.my.second.domain").Prop(p.Zone, "my.second.domain").Prop(p.Type, "A").Prop(p.TTL, 60).Prop(p.Records, []string{"7.8.9.10"}).
+			Prop(p.Set, "blue").Prop(p.Weight, 100).Prop(p.HealthCheck, "hc_1").Build(),
+		"awls-88840a1c": resourcetest.Record("awls-88840a1c").Prop(p.Name, "www.my.second.domain").Prop(p.Zone, "my.second.domain").Prop(p.Type, "A").Prop(p.TTL, 60).Prop(p.Records, []string{"8.9.10.11"}).
+			Prop(p.Set, "green").Prop(p.Weight, 0).Prop(p.HealthCheck, "hc_2").Build(),
+		"hc_1": resourcetest.HealthCheck("hc_1").Prop(p.Name, "blue-check").Prop(p.CallerReference, "ref_1").Prop(p.Version, 2).Prop(p.Type, "HTTP").Prop(p.PublicIP, "7.8.9.10").
+			Prop(p.Port, 80).Prop(p.CheckPath, "/health").Prop(p.CheckInterval, 30).Prop(p.UnhealthyThresholdCount, 3).Build(),
+		"hc_2": resourcetest.HealthCheck("hc_2").Prop(p.CallerReference, "ref_2").Prop(p.Type, "TCP").Prop(p.Host, "green.my.second.domain").Prop(p.Port, 443).Build(),
+		"hc_3": resourcetest.HealthCheck("hc_3").Prop(p.CallerReference, "ref_3").Prop(p.Type, "CALCULATED").Build(),
 	}
 	expectedChildren := map[string][]string{
 		"/hostedzone/12345": {"awls-91fa0a45", "awls-920c0a46", "awls-be1e0b6a"},
-		"/hostedzone/23456": {"awls-9c420a99", "awls-c9b80bbe"},
+		"/hostedzone/23456": {"awls-7e6209b3", "awls-88840a1c", "awls-9c420a99", "awls-c9b80bbe"},
+	}
+	expectedAppliedOn := map[string][]string{
+		"hc_1": {"awls-7e6209b3"},
+		"hc_2": {"awls-88840a1c"},
 	}
-	expectedAppliedOn := map[string][]string{}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
 }
@@ -902,9 +1084,11 @@ func TestBuildNotificationGraph(t *testing.T) {
 			"LastModifiedTimestamp":       awssdk.String("1494332859"),
 			"QueueArn":                    awssdk.String("queue_2_arn"),
 			"DelaySeconds":                awssdk.String("15"),
+			"KmsMasterKeyId":              awssdk.String("alias/aws/sqs"),
 		},
 		"queue_3": {
 			"ApproximateNumberOfMessages": awssdk.String("12"),
+			"KmsMasterKeyId":              awssdk.String("key_1"),
 		},
 	}
 
@@ -949,14 +1133,20 @@ func TestBuildNotificationGraph(t *testing.T) {
 
 	expected = map[string]cloud.Resource{
 		"queue_1": resourcetest.Queue("queue_1").Build(),
-		"queue_2": resourcetest.Queue("queue_2").Prop(p.ApproximateMessageCount, 4).Prop(p.Created, time.Unix(1494419259, 0).UTC()).Prop(p.Modified, time.Unix(1494332859, 0).UTC()).Prop(p.Arn, "queue_2_arn").Prop(p.Delay, 15).Build(),
-		"queue_3": resourcetest.Queue("queue_3").Prop(p.ApproximateMessageCount, 12).Build(),
+		"queue_2": resourcetest.Queue("queue_2").Prop(p.ApproximateMessageCount, 4).Prop(p.Created, time.Unix(1494419259, 0).UTC()).Prop(p.Modified, time.Unix(1494332859, 0).UTC()).Prop(p.Arn, "queue_2_arn").Prop(p.Delay, 15).Prop(p.EncryptionKey, "alias/aws/sqs").Build(),
+		"queue_3": resourcetest.Queue("queue_3").Prop(p.ApproximateMessageCount, 12).Prop(p.EncryptionKey, "key_1").Build(),
 	}
 	expectedChildren = map[string][]string{}
 	expectedAppliedOn = map[string][]string{}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
 
+	key := resourcetest.Key("key_1").Build()
+	g.(*graph.Graph).AddResource(key) // keys are synced by the security service
+	if got, want := mustGetAppliedOnId(g, key), []string{"queue_3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("key appliedOn: got %v, want %v", got, want)
+	}
+
 }
 
 func TestBuildLambdaGraph(t *testing.T) {
@@ -980,10 +1170,12 @@ func TestBuildLambdaGraph(t *testing.T) {
 	}
 
 	mock := &mockLambda{functionconfigurations: functions}
+	mockApigateway := &mockApigateway{}
+	mockSfn := &mockSfn{}
 
 	service := Lambda{
-		LambdaAPI: mock, region: "eu-west-1",
-		fetcher: fetch.NewFetcher(awsfetch.BuildLambdaFetchFuncs(awsfetch.NewConfig(mock))),
+		LambdaAPI: mock, APIGatewayAPI: mockApigateway, SFNAPI: mockSfn, region: "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildLambdaFetchFuncs(awsfetch.NewConfig(mock, mockApigateway, mockSfn))),
 	}
 
 	g, err := service.Fetch(context.Background())
@@ -1010,6 +1202,139 @@ func TestBuildLambdaGraph(t *testing.T) {
 	expectedAppliedOn := map[string][]string{}
 
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	if got := mustGetAppliedOnId(g, expected["func_2_arn"]); len(got) != 0 {
+		t.Fatalf("function appliedOn: got %v, want none while its log group is not synced", got)
+	}
+	group := resourcetest.LogGroup("/aws/lambda/func_2_name").Build()
+	g.(*graph.Graph).AddResource(group) // log groups are synced by the logs service
+	if got, want := mustGetAppliedOnId(g, expected["func_2_arn"]), []string{group.Id()}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("function appliedOn: got %v, want %v", got, want)
+	}
+}
+
+func TestBuildServerlessGraph(t *testing.T) {
+	fn1 := "arn:aws:lambda:eu-west-1:123456789012:function:fn_1"
+	fn2 := "arn:aws:lambda:eu-west-1:123456789012:function:fn_2"
+	functions := []*lambda.FunctionConfiguration{
+		{FunctionArn: awssdk.String(fn1)},
+		{FunctionArn: awssdk.String(fn2)},
+	}
+	restApis := []*apigateway.RestApi{
+		{
+			Id:          awssdk.String("api_1"),
+			Name:        awssdk.String("my_api"),
+			Description: awssdk.String("my api desc"),
+			CreatedDate: awssdk.Time(time.Unix(1136214245, 0)),
+			Version:     awssdk.String("v1"),
+		},
+		{Id: awssdk.String("api_2")},
+	}
+	apiResources := map[string][]*apigateway.Resource{
+		"api_1": {
+			{Id: awssdk.String("root"), Path: awssdk.String("/")},
+			{Id: awssdk.String("proxy"), Path: awssdk.String("/{proxy+}"), ResourceMethods: map[string]*apigateway.Method{
+				"ANY": {MethodIntegration: &apigateway.Integration{Uri: awssdk.String("arn:aws:apigateway:eu-west-1:lambda:path/2015-03-31/functions/" + fn1 + "/invocations")}},
+			}},
+			{Id: awssdk.String("users"), Path: awssdk.String("/users"), ResourceMethods: map[string]*apigateway.Method{
+				"GET":  {MethodIntegration: &apigateway.Integration{Uri: awssdk.String("arn:aws:apigateway:eu-west-1:lambda:path/2015-03-31/functions/" + fn2 + ":prod/invocations")}},
+				"POST": {MethodIntegration: &apigateway.Integration{Uri: awssdk.String("arn:aws:apigateway:eu-west-1:lambda:path/2015-03-31/functions/" + fn1 + "/invocations")}},
+			}},
+		},
+		"api_2": {
+			{Id: awssdk.String("root"), Path: awssdk.String("/"), ResourceMethods: map[string]*apigateway.Method{
+				"GET": {MethodIntegration: &apigateway.Integration{Uri: awssdk.String("https://example.com")}},
+			}},
+		},
+	}
+	stages := map[string][]*apigateway.Stage{
+		"api_1": {
+			{StageName: awssdk.String("prod"), DeploymentId: awssdk.String("dep_1"), Description: awssdk.String("production"), CreatedDate: awssdk.Time(time.Unix(1136214245, 0)), LastUpdatedDate: awssdk.Time(time.Unix(1136214245, 0))},
+			{StageName: awssdk.String("dev"), DeploymentId: awssdk.String("dep_2")},
+		},
+	}
+	machines := map[string]*sfn.DescribeStateMachineOutput{
+		"machine_1_arn": {
+			StateMachineArn: awssdk.String("machine_1_arn"),
+			Name:            awssdk.String("my_machine"),
+			RoleArn:         awssdk.String("role_arn"),
+			Status:          awssdk.String("ACTIVE"),
+			CreationDate:    awssdk.Time(time.Unix(1136214245, 0)),
+			Definition: awssdk.String(`{"StartAt": "First", "States": {
+				"First": {"Type": "Task", "Resource": "` + fn2 + `", "Next": "Both"},
+				"Both": {"Type": "Parallel", "End": true, "Branches": [
+					{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "` + fn1 + `:live", "End": true}}},
+					{"StartAt": "B", "States": {"B": {"Type": "Task", "Resource": "` + fn2 + `", "End": true}}}
+				]}}}`),
+		},
+		"machine_2_arn": {StateMachineArn: awssdk.String("machine_2_arn"), Definition: awssdk.String(`{"StartAt": "Wait", "States": {"Wait": {"Type": "Wait", "Seconds": 10, "End": true}}}`)},
+	}
+
+	mockLambda := &mockLambda{functionconfigurations: functions}
+	mockApigateway := &mockApigateway{restapis: restApis, resources: apiResources, stages: stages}
+	mockSfn := &mockSfn{statemachinelistitems: []*sfn.StateMachineListItem{{StateMachineArn: awssdk.String("machine_1_arn")}, {StateMachineArn: awssdk.String("machine_2_arn")}}, statemachines: machines}
+
+	service := Lambda{
+		LambdaAPI: mockLambda, APIGatewayAPI: mockApigateway, SFNAPI: mockSfn, region: "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildLambdaFetchFuncs(awsfetch.NewConfig(mockLambda, mockApigateway, mockSfn))),
+	}
+
+	g, err := service.Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := g.Find(cloud.NewQuery("restapi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range resources {
+		if p, ok := res.Properties()[p.Functions].([]string); ok {
+			sort.Strings(p)
+		}
+	}
+	expected := map[string]cloud.Resource{
+		"api_1": resourcetest.RestApi("api_1").Prop(p.Name, "my_api").Prop(p.Description, "my api desc").Prop(p.Created, time.Unix(1136214245, 0).UTC()).
+			Prop(p.Version, "v1").Prop(p.Functions, []string{fn1, fn2}).Build(),
+		"api_2": resourcetest.RestApi("api_2").Build(),
+	}
+	expectedChildren := map[string][]string{
+		"api_1": {"awls-0d9a030a", "awls-114c037f"},
+	}
+	expectedAppliedOn := map[string][]string{
+		"api_1": {fn1, fn2},
+	}
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	resources, err = g.Find(cloud.NewQuery("stage"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = map[string]cloud.Resource{
+		"awls-114c037f": resourcetest.Stage("awls-114c037f").Prop(p.Name, "prod").Prop(p.Description, "production").Prop(p.Created, time.Unix(1136214245, 0).UTC()).
+			Prop(p.Modified, time.Unix(1136214245, 0).UTC()).Prop(p.RestApi, "api_1").Build(),
+		"awls-0d9a030a": resourcetest.Stage("awls-0d9a030a").Prop(p.Name, "dev").Prop(p.RestApi, "api_1").Build(),
+	}
+	compareResources(t, g, resources, expected, map[string][]string{}, map[string][]string{})
+
+	resources, err = g.Find(cloud.NewQuery("statemachine"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range resources {
+		if p, ok := res.Properties()[p.Functions].([]string); ok {
+			sort.Strings(p)
+		}
+	}
+	expected = map[string]cloud.Resource{
+		"machine_1_arn": resourcetest.StateMachine("machine_1_arn").Prop(p.Arn, "machine_1_arn").Prop(p.Name, "my_machine").Prop(p.Role, "role_arn").
+			Prop(p.State, "ACTIVE").Prop(p.Created, time.Unix(1136214245, 0).UTC()).Prop(p.Functions, []string{fn1, fn2}).Build(),
+		"machine_2_arn": resourcetest.StateMachine("machine_2_arn").Prop(p.Arn, "machine_2_arn").Build(),
+	}
+	expectedAppliedOn = map[string][]string{
+		"machine_1_arn": {fn1, fn2},
+	}
+	compareResources(t, g, resources, expected, map[string][]string{}, expectedAppliedOn)
 }
 
 func TestBuildMonitoringGraph(t *testing.T) {
@@ -1098,6 +1423,63 @@ func TestBuildMonitoringGraph(t *testing.T) {
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
 }
 
+func TestBuildLogsGraph(t *testing.T) {
+	groups := []*cloudwatchlogs.LogGroup{
+		{
+			LogGroupName:    awssdk.String("/aws/lambda/func_1"),
+			Arn:             awssdk.String("arn:aws:logs:eu-west-1:123456789012:log-group:/aws/lambda/func_1:*"),
+			CreationTime:    awssdk.Int64(1494419259000),
+			RetentionInDays: awssdk.Int64(14),
+			StoredBytes:     awssdk.Int64(2048),
+		},
+		{
+			LogGroupName: awssdk.String("app"),
+			KmsKeyId:     awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/key_1"),
+		},
+		{LogGroupName: awssdk.String("other")},
+	}
+
+	mock := &mockCloudwatchlogs{loggroups: groups}
+
+	service := Logs{
+		CloudWatchLogsAPI: mock, region: "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildLogsFetchFuncs(awsfetch.NewConfig(mock))),
+	}
+
+	g, err := service.Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := g.Find(cloud.NewQuery(cloud.LogGroup))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]cloud.Resource{
+		"/aws/lambda/func_1": resourcetest.LogGroup("/aws/lambda/func_1").
+			Prop(p.Name, "/aws/lambda/func_1").
+			Prop(p.Arn, "arn:aws:logs:eu-west-1:123456789012:log-group:/aws/lambda/func_1:*").
+			Prop(p.Created, time.Unix(1494419259, 0).UTC()).
+			Prop(p.RetentionDays, 14).
+			Prop(p.Size, 2048).Build(),
+		"app":   resourcetest.LogGroup("app").Prop(p.Name, "app").Prop(p.EncryptionKey, "arn:aws:kms:eu-west-1:123456789012:key/key_1").Build(),
+		"other": resourcetest.LogGroup("other").Prop(p.Name, "other").Build(),
+	}
+	expectedChildren := map[string][]string{
+		"eu-west-1": {"/aws/lambda/func_1", "app", "other"},
+	}
+	expectedAppliedOn := map[string][]string{}
+
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	key := resourcetest.Key("key_1").Build()
+	g.(*graph.Graph).AddResource(key) // keys are synced by the security service
+	if got, want := mustGetAppliedOnId(g, key), []string{"app"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("key appliedOn: got %v, want %v", got, want)
+	}
+}
+
 func TestBuildCdnGraph(t *testing.T) {
 	now := time.Now().UTC()
 	distributions := []*cloudfront.DistributionSummary{
@@ -1307,6 +1689,285 @@ func TestBuildCloudFormationGraph(t *testing.T) {
 	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
 }
 
+func TestBuildDynamodbGraph(t *testing.T) {
+	now := time.Now().UTC()
+	tables := []*dynamodb.TableDescription{
+		{
+			TableName:        awssdk.String("table_1"),
+			TableArn:         awssdk.String("arn:aws:dynamodb:eu-west-1:123456789012:table/table_1"),
+			TableStatus:      awssdk.String("ACTIVE"),
+			CreationDateTime: awssdk.Time(now),
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: awssdk.String("device"), KeyType: awssdk.String("HASH")},
+				{AttributeName: awssdk.String("timestamp"), KeyType: awssdk.String("RANGE")},
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{ReadCapacityUnits: awssdk.Int64(5), WriteCapacityUnits: awssdk.Int64(10)},
+			ItemCount:             awssdk.Int64(42),
+			TableSizeBytes:        awssdk.Int64(2048),
+			LatestStreamArn:       awssdk.String("stream_arn"),
+		},
+		{
+			TableName:             awssdk.String("table_2"),
+			KeySchema:             []*dynamodb.KeySchemaElement{{AttributeName: awssdk.String("id"), KeyType: awssdk.String("HASH")}},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{ReadCapacityUnits: awssdk.Int64(0), WriteCapacityUnits: awssdk.Int64(0)},
+		},
+		{
+			TableName: awssdk.String("table_3"),
+		},
+	}
+	globals := []*dynamodb.GlobalTableDescription{
+		{
+			GlobalTableName:   awssdk.String("table_1"),
+			GlobalTableArn:    awssdk.String("arn:aws:dynamodb::123456789012:global-table/table_1"),
+			GlobalTableStatus: awssdk.String("ACTIVE"),
+			CreationDateTime:  awssdk.Time(now),
+			ReplicationGroup:  []*dynamodb.ReplicaDescription{{RegionName: awssdk.String("eu-west-1")}, {RegionName: awssdk.String("us-east-1")}},
+		},
+	}
+
+	mock := &mockDynamodb{tabledescriptions: tables, globaltabledescriptions: globals}
+
+	service := Dynamodb{
+		DynamoDBAPI: mock, region: "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildDynamodbFetchFuncs(awsfetch.NewConfig(mock))),
+	}
+
+	g, err := service.Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := g.Find(cloud.NewQuery(cloud.Table))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]cloud.Resource{
+		"table_1": resourcetest.Table("table_1").
+			Prop(p.Name, "table_1").
+			Prop(p.Arn, "arn:aws:dynamodb:eu-west-1:123456789012:table/table_1").
+			Prop(p.State, "ACTIVE").
+			Prop(p.Created, now).
+			Prop(p.HashKey, "device").
+			Prop(p.RangeKey, "timestamp").
+			Prop(p.BillingMode, "PROVISIONED").
+			Prop(p.ReadCapacity, 5).
+			Prop(p.WriteCapacity, 10).
+			Prop(p.ItemCount, 42).
+			Prop(p.Size, 2048).
+			Prop(p.StreamArn, "stream_arn").
+			Build(),
+		"table_2": resourcetest.Table("table_2").
+			Prop(p.Name, "table_2").
+			Prop(p.HashKey, "id").
+			Prop(p.BillingMode, "PAY_PER_REQUEST").
+			Prop(p.ReadCapacity, 0).
+			Prop(p.WriteCapacity, 0).
+			Build(),
+		"table_3": resourcetest.Table("table_3").Prop(p.Name, "table_3").Build(),
+	}
+	expectedChildren := map[string][]string{
+		"eu-west-1": {"table_1", "table_2", "table_3"},
+	}
+	expectedAppliedOn := map[string][]string{}
+
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+
+	resources, err = g.Find(cloud.NewQuery(cloud.GlobalTable))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range resources {
+		if p, ok := res.Properties()[p.Regions].([]string); ok {
+			sort.Strings(p)
+		}
+	}
+	expected = map[string]cloud.Resource{
+		"arn:aws:dynamodb::123456789012:global-table/table_1": resourcetest.GlobalTable("arn:aws:dynamodb::123456789012:global-table/table_1").
+			Prop(p.Name, "table_1").
+			Prop(p.Arn, "arn:aws:dynamodb::123456789012:global-table/table_1").
+			Prop(p.State, "ACTIVE").
+			Prop(p.Created, now).
+			Prop(p.Regions, []string{"eu-west-1", "us-east-1"}).
+			Build(),
+	}
+	expectedChildren = map[string][]string{
+		"eu-west-1": {"arn:aws:dynamodb::123456789012:global-table/table_1", "table_1", "table_2", "table_3"},
+	}
+
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+}
+
+func TestBuildSecurityGraph(t *testing.T) {
+	now := time.Now().UTC()
+	keys := []*kms.KeyMetadata{
+		{
+			KeyId:        awssdk.String("key_1"),
+			Arn:          awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/key_1"),
+			Description:  awssdk.String("backups"),
+			CreationDate: awssdk.Time(now),
+			Enabled:      awssdk.Bool(true),
+			KeyManager:   awssdk.String("CUSTOMER"),
+			KeyState:     awssdk.String("Enabled"),
+			KeyUsage:     awssdk.String("ENCRYPT_DECRYPT"),
+		},
+		{
+			KeyId:      awssdk.String("key_2"),
+			Enabled:    awssdk.Bool(false),
+			KeyManager: awssdk.String("CUSTOMER"),
+			KeyState:   awssdk.String("PendingDeletion"),
+		},
+	}
+	aliases := []*kms.AliasListEntry{
+		{AliasName: awssdk.String("alias/backups"), TargetKeyId: awssdk.String("key_1")},
+		{AliasName: awssdk.String("alias/archives"), TargetKeyId: awssdk.String("key_1")},
+		{AliasName: awssdk.String("alias/unused")},
+	}
+
+	parameters := []*ssm.ParameterMetadata{
+		{
+			Name:             awssdk.String("/golden/ami"),
+			Type:             awssdk.String("String"),
+			Description:      awssdk.String("latest golden image"),
+			LastModifiedDate: awssdk.Time(now),
+			Version:          awssdk.Int64(3),
+		},
+		{
+			Name:             awssdk.String("/db/password"),
+			Type:             awssdk.String("SecureString"),
+			KeyId:            awssdk.String("arn:aws:kms:eu-west-1:123456789012:key/key_1"),
+			LastModifiedDate: awssdk.Time(now),
+			Version:          awssdk.Int64(1),
+		},
+		{
+			Name:             awssdk.String("/db/user"),
+			Type:             awssdk.String("SecureString"),
+			KeyId:            awssdk.String("alias/aws/ssm"),
+			LastModifiedDate: awssdk.Time(now),
+			Version:          awssdk.Int64(1),
+		},
+	}
+
+	mock := &mockKms{
+		keymetadatas:    keys,
+		aliaslistentrys: aliases,
+		rotations:       map[string]bool{"key_1": true},
+		policies:        map[string]string{"key_1": `{"Version":"2012-10-17"}`},
+	}
+	ssmMock := &mockSsm{parametermetadatas: parameters}
+
+	service := Security{
+		KMSAPI: mock, SSMAPI: ssmMock, region: "eu-west-1",
+		fetcher: fetch.NewFetcher(awsfetch.BuildSecurityFetchFuncs(awsfetch.NewConfig(mock, ssmMock))),
+	}
+
+	g, err := service.Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := g.Find(cloud.NewQuery(cloud.Key, cloud.Parameter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range resources {
+		if p, ok := res.Properties()[p.Aliases].([]string); ok {
+			sort.Strings(p)
+		}
+	}
+
+	expected := map[string]cloud.Resource{
+		"key_1": resourcetest.Key("key_1").
+			Prop(p.Arn, "arn:aws:kms:eu-west-1:123456789012:key/key_1").
+			Prop(p.Description, "backups").
+			Prop(p.Created, now).
+			Prop(p.Enabled, true).
+			Prop(p.KeyManager, "CUSTOMER").
+			Prop(p.State, "Enabled").
+			Prop(p.KeyUsage, "ENCRYPT_DECRYPT").
+			Prop(p.Aliases, []string{"alias/archives", "alias/backups"}).
+			Prop(p.RotationEnabled, true).
+			Prop(p.Document, `{"Version":"2012-10-17"}`).
+			Build(),
+		"key_2": resourcetest.Key("key_2").
+			Prop(p.Enabled, false).
+			Prop(p.KeyManager, "CUSTOMER").
+			Prop(p.State, "PendingDeletion").
+			Build(),
+		"/golden/ami": resourcetest.Parameter("/golden/ami").
+			Prop(p.Name, "/golden/ami").
+			Prop(p.Type, "String").
+			Prop(p.Description, "latest golden image").
+			Prop(p.Modified, now).
+			Prop(p.Version, "3").
+			Build(),
+		"/db/password": resourcetest.Parameter("/db/password").
+			Prop(p.Name, "/db/password").
+			Prop(p.Type, "SecureString").
+			Prop(p.EncryptionKey, "arn:aws:kms:eu-west-1:123456789012:key/key_1").
+			Prop(p.Modified, now).
+			Prop(p.Version, "1").
+			Build(),
+		"/db/user": resourcetest.Parameter("/db/user").
+			Prop(p.Name, "/db/user").
+			Prop(p.Type, "SecureString").
+			Prop(p.EncryptionKey, "alias/aws/ssm").
+			Prop(p.Modified, now).
+			Prop(p.Version, "1").
+			Build(),
+	}
+	expectedChildren := map[string][]string{
+		"eu-west-1": {"/db/password", "/db/user", "/golden/ami", "key_1", "key_2"},
+	}
+	expectedAppliedOn := map[string][]string{
+		"key_1": {"/db/password"},
+	}
+
+	compareResources(t, g, resources, expected, expectedChildren, expectedAppliedOn)
+}
+
+func TestParameterStoreFillHoles(t *testing.T) {
+	values := map[string]*ssm.Parameter{
+		"/golden/ami":  {Name: awssdk.String("/golden/ami"), Type: awssdk.String("String"), Value: awssdk.String("ami-1234")},
+		"/net/subnets": {Name: awssdk.String("/net/subnets"), Type: awssdk.String("StringList"), Value: awssdk.String("sub-1,sub-2")},
+		"/db/password": {Name: awssdk.String("/db/password"), Type: awssdk.String("SecureString"), Value: awssdk.String("s3cr3t")},
+	}
+	holes := []string{"ssm:/golden/ami", "ssm:/net/subnets", "ssm:/db/password"}
+	for i := 0; i < 12; i++ {
+		name := fmt.Sprintf("/app/param%d", i)
+		values[name] = &ssm.Parameter{Name: awssdk.String(name), Type: awssdk.String("String"), Value: awssdk.String(fmt.Sprint(i))}
+		holes = append(holes, "ssm:"+name)
+	}
+	store := (&Security{SSMAPI: &mockSsm{values: values}}).ParameterStore()
+
+	if got, want := store.Prefix(), "ssm"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	fillers, masked, err := store.Fill(holes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(fillers), 15; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := fillers["ssm:/golden/ami"], "ami-1234"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := fillers["ssm:/net/subnets"], []interface{}{"sub-1", "sub-2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := fillers["ssm:/app/param11"], "11"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := masked, []string{"ssm:/db/password"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if _, _, err = store.Fill([]string{"ssm:/golden/ami", "ssm:/unknown"}); err == nil || !strings.Contains(err.Error(), "/unknown") {
+		t.Fatalf("expected unknown parameter error, got %v", err)
+	}
+}
+
 func TestBuildEmptyRdfGraphWhenNoData(t *testing.T) {
 	expectG := graph.NewGraph()
 	expectG.AddResource(resourcetest.Region("eu-west-1").Build())
@@ -1339,13 +2000,14 @@ func TestBuildEmptyRdfGraphWhenNoData(t *testing.T) {
 		ELBAPI:         &mockElb{},
 		ELBV2API:       &mockElbv2{},
 		RDSAPI:         &mockRds{},
+		ElastiCacheAPI: &mockElasticache{},
 		AutoScalingAPI: &mockAutoscaling{},
 		ECRAPI:         &mockEcr{},
 		ECSAPI:         &mockEcs{},
 		ACMAPI:         &mockAcm{},
 		region:         "eu-west-1",
 		fetcher: fetch.NewFetcher(awsfetch.BuildInfraFetchFuncs(awsfetch.NewConfig(
-			&mockEc2{}, &mockElb{}, &mockElbv2{}, &mockRds{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockAcm{},
+			&mockEc2{}, &mockElb{}, &mockElbv2{}, &mockRds{}, &mockElasticache{}, &mockEcr{}, &mockEcs{}, &mockAutoscaling{}, &mockAcm{},
 		))),
 	}
 
@@ -1435,21 +2097,3 @@ func TestSliceOfSlice(t *testing.T) {
 		}
 	}
 }
-
-func sliceOfSlice(in []*string, maxLength int) (res [][]*string) {
-	if maxLength <= 0 {
-		return
-	}
-	if len(in) == 0 {
-		return
-	}
-	for i := 0; i < len(in); i += maxLength {
-		if i+maxLength < len(in) {
-			res = append(res, in[i:i+maxLength])
-		} else {
-			res = append(res, in[i:])
-		}
-	}
-
-	return
-}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync/atomic"
 	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
@@ -58,6 +59,29 @@ func ResolveRegionFromEnv() (region string) {
 	return
 }
 
+const (
+	// UserAgent is appended to the user agent of every AWS request
+	// so that calls made by awless can be told apart (ex: in CloudTrail)
+	UserAgent = "awless"
+	// TemplateUserAgentPrefix precedes the ID of the template being run
+	// in the user agent of the AWS requests it makes
+	TemplateUserAgentPrefix = "awless-template/"
+)
+
+var runningTemplateID atomic.Value
+
+// SetRunningTemplateID tags the following AWS requests with the ID of the template being run
+func SetRunningTemplateID(id string) {
+	runningTemplateID.Store(id)
+}
+
+func addUserAgentHandler(r *request.Request) {
+	request.AddToUserAgent(r, UserAgent)
+	if id, _ := runningTemplateID.Load().(string); id != "" {
+		request.AddToUserAgent(r, TemplateUserAgentPrefix+id)
+	}
+}
+
 type sessionResolver struct {
 	region, profile                      string
 	profileSetterCallback                func(val string) error
@@ -127,7 +151,12 @@ func (s *sessionResolver) resolve() (*session.Session, error) {
 		session.Config = session.Config.WithLogLevel(awssdk.LogDebugWithHTTPBody)
 	}
 
+	session.Handlers.Build.PushBack(addUserAgentHandler)
+
 	session.Handlers.Retry.PushFront(func(req *request.Request) {
+		if req.IsErrorThrottle() {
+			DefaultNetworkMonitor.countThrottle()
+		}
 		if req.IsErrorThrottle() && s.logger != nil {
 			s.logger.Verbosef("retrying %s: %s: %s", req.Operation.Name, req.Error.(awserr.Error).Code(), req.Error.(awserr.Error).Message())
 		}
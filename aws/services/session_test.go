@@ -0,0 +1,38 @@
+package awsservices
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestAddUserAgentHandler(t *testing.T) {
+	defer SetRunningTemplateID("")
+
+	newRequest := func() *request.Request {
+		req := &request.Request{HTTPRequest: &http.Request{Header: make(http.Header)}}
+		req.HTTPRequest.Header.Set("User-Agent", "aws-sdk-go/1.12.8")
+		return req
+	}
+
+	req := newRequest()
+	addUserAgentHandler(req)
+	if got, want := req.HTTPRequest.Header.Get("User-Agent"), "aws-sdk-go/1.12.8 awless"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	SetRunningTemplateID("01BZ8XKPB4Y5VY5ZXRVG2H1T9P")
+	req = newRequest()
+	addUserAgentHandler(req)
+	if got, want := req.HTTPRequest.Header.Get("User-Agent"), "aws-sdk-go/1.12.8 awless awless-template/01BZ8XKPB4Y5VY5ZXRVG2H1T9P"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	SetRunningTemplateID("")
+	req = newRequest()
+	addUserAgentHandler(req)
+	if got, want := req.HTTPRequest.Header.Get("User-Agent"), "aws-sdk-go/1.12.8 awless"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
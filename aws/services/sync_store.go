@@ -0,0 +1,92 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsservices
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync/repo"
+)
+
+type s3SyncStore struct {
+	api            s3iface.S3API
+	bucket, prefix string
+}
+
+// NewS3SyncStore returns a shared sync store in the given bucket. When endpoint is set,
+// path style requests are sent to it (ex: a MinIO server http://localhost:9000)
+func NewS3SyncStore(profile, region, bucket, prefix, endpoint string, log *logger.Logger) (repo.ObjectStore, error) {
+	sess, err := newSessionResolver().withRegion(region).withProfile(profile).withLogger(log).withCredentialResolvers().resolve()
+	if err != nil {
+		return nil, err
+	}
+	conf := awssdk.NewConfig()
+	if endpoint != "" {
+		conf = conf.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+	return &s3SyncStore{api: s3.New(sess, conf), bucket: bucket, prefix: strings.Trim(prefix, "/")}, nil
+}
+
+func (s *s3SyncStore) String() string {
+	return fmt.Sprintf("s3://%s", path.Join(s.bucket, s.prefix))
+}
+
+func (s *s3SyncStore) key(k string) string {
+	return path.Join(s.prefix, k)
+}
+
+func (s *s3SyncStore) List(prefix string) ([]string, error) {
+	var keys []string
+	basePrefix := s.key("")
+	if basePrefix != "" {
+		basePrefix += "/"
+	}
+	err := s.api.ListObjectsV2Pages(&s3.ListObjectsV2Input{Bucket: awssdk.String(s.bucket), Prefix: awssdk.String(basePrefix + prefix)},
+		func(out *s3.ListObjectsV2Output, lastPage bool) (shouldContinue bool) {
+			for _, obj := range out.Contents {
+				keys = append(keys, strings.TrimPrefix(awssdk.StringValue(obj.Key), basePrefix))
+			}
+			return out.NextContinuationToken != nil
+		})
+	return keys, err
+}
+
+func (s *s3SyncStore) Get(key string) ([]byte, error) {
+	out, err := s.api.GetObject(&s3.GetObjectInput{Bucket: awssdk.String(s.bucket), Key: awssdk.String(s.key(key))})
+	if awserr, ok := err.(awserr.Error); ok && awserr.Code() == s3.ErrCodeNoSuchKey {
+		return nil, repo.ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+	return ioutil.ReadAll(out.Body)
+}
+
+func (s *s3SyncStore) Put(key string, content []byte) error {
+	_, err := s.api.PutObject(&s3.PutObjectInput{Bucket: awssdk.String(s.bucket), Key: awssdk.String(s.key(key)), Body: bytes.NewReader(content)})
+	return err
+}
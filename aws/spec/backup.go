@@ -0,0 +1,54 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateBackup struct {
+	_      string `action:"create" entity:"backup" awsAPI:"dynamodb" awsCall:"CreateBackup" awsInput:"dynamodb.CreateBackupInput" awsOutput:"dynamodb.CreateBackupOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    dynamodbiface.DynamoDBAPI
+	Table  *string `awsName:"TableName" awsType:"awsstr" templateName:"table"`
+	Name   *string `awsName:"BackupName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *CreateBackup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("table")))
+}
+
+func (cmd *CreateBackup) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*dynamodb.CreateBackupOutput).BackupDetails.BackupArn)
+}
+
+type DeleteBackup struct {
+	_      string `action:"delete" entity:"backup" awsAPI:"dynamodb" awsCall:"DeleteBackup" awsInput:"dynamodb.DeleteBackupInput" awsOutput:"dynamodb.DeleteBackupOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    dynamodbiface.DynamoDBAPI
+	Arn    *string `awsName:"BackupArn" awsType:"awsstr" templateName:"arn"`
+}
+
+func (cmd *DeleteBackup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("arn")))
+}
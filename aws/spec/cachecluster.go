@@ -0,0 +1,211 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateCachecluster struct {
+	_                 string `action:"create" entity:"cachecluster" awsAPI:"elasticache"`
+	logger            *logger.Logger
+	graph             cloud.GraphAPI
+	api               elasticacheiface.ElastiCacheAPI
+	Id                *string   `awsName:"CacheClusterId" awsType:"awsstr" templateName:"id"`
+	Type              *string   `awsName:"CacheNodeType" awsType:"awsstr" templateName:"type"`
+	Engine            *string   `awsName:"Engine" awsType:"awsstr" templateName:"engine"`
+	Nodes             *int64    `awsName:"NumCacheNodes" awsType:"awsint64" templateName:"nodes"`
+	Version           *string   `awsName:"EngineVersion" awsType:"awsstr" templateName:"version"`
+	Port              *int64    `awsName:"Port" awsType:"awsint64" templateName:"port"`
+	Subnetgroup       *string   `awsName:"CacheSubnetGroupName" awsType:"awsstr" templateName:"subnetgroup"`
+	Subnets           []*string `templateName:"subnets"`
+	Securitygroups    []*string `awsName:"SecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroups"`
+	Availabilityzone  *string   `awsName:"PreferredAvailabilityZone" awsType:"awsstr" templateName:"availabilityzone"`
+	Maintenancewindow *string   `awsName:"PreferredMaintenanceWindow" awsType:"awsstr" templateName:"maintenancewindow"`
+	Autoupgrade       *bool     `awsName:"AutoMinorVersionUpgrade" awsType:"awsbool" templateName:"autoupgrade"`
+}
+
+func (cmd *CreateCachecluster) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("engine"), params.Key("id"), params.Key("type"),
+		params.Opt("autoupgrade", "availabilityzone", "maintenancewindow", "nodes", "port", "securitygroups", params.Suggested("subnets"), "subnetgroup", "version"),
+	),
+		params.Validators{
+			"engine":  params.IsInEnumIgnoreCase("memcached", "redis"),
+			"subnets": excludesCacheSubnetgroup,
+		})
+}
+
+func (cmd *CreateCachecluster) ManualRun(renv env.Running) (interface{}, error) {
+	if cmd.Nodes == nil {
+		cmd.Nodes = Int64(1)
+	}
+	cmd.Engine = String(strings.ToLower(StringValue(cmd.Engine)))
+	if len(cmd.Subnets) > 0 {
+		name, err := createCacheSubnetGroup(cmd.api, cmd.logger, StringValue(cmd.Id), cmd.Subnets)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Subnetgroup = name
+	}
+
+	input := &elasticache.CreateCacheClusterInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elasticache.CreateCacheClusterInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateCacheCluster(input)
+	cmd.logger.ExtraVerbosef("elasticache.CreateCacheCluster call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateCachecluster) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*elasticache.CreateCacheClusterOutput).CacheCluster.CacheClusterId)
+}
+
+type DeleteCachecluster struct {
+	_        string `action:"delete" entity:"cachecluster" awsAPI:"elasticache" awsCall:"DeleteCacheCluster" awsInput:"elasticache.DeleteCacheClusterInput" awsOutput:"elasticache.DeleteCacheClusterOutput"`
+	logger   *logger.Logger
+	graph    cloud.GraphAPI
+	api      elasticacheiface.ElastiCacheAPI
+	Id       *string `awsName:"CacheClusterId" awsType:"awsstr" templateName:"id"`
+	Snapshot *string `awsName:"FinalSnapshotIdentifier" awsType:"awsstr" templateName:"snapshot"`
+}
+
+func (cmd *DeleteCachecluster) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("snapshot")))
+}
+
+type CheckCachecluster struct {
+	_       string `action:"check" entity:"cachecluster" awsAPI:"elasticache"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     elasticacheiface.ElastiCacheAPI
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *CheckCachecluster) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase("available", "creating", "deleting", "incompatible-network",
+				"modifying", "rebooting cluster nodes", "restore-failed", "snapshotting", notFoundState),
+		},
+	)
+}
+
+func (cmd *CheckCachecluster) ManualRun(renv env.Running) (interface{}, error) {
+	input := &elasticache.DescribeCacheClustersInput{
+		CacheClusterId: cmd.Id,
+	}
+
+	c := &checker{
+		description: fmt.Sprintf("cachecluster %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeCacheClusters(input)
+			if err != nil {
+				if awserr, ok := err.(awserr.Error); ok && awserr.Code() == elasticache.ErrCodeCacheClusterNotFoundFault {
+					return notFoundState, nil
+				}
+				return "", err
+			}
+			for _, cluster := range output.CacheClusters {
+				if StringValue(cluster.CacheClusterId) == StringValue(cmd.Id) {
+					return StringValue(cluster.CacheClusterStatus), nil
+				}
+			}
+			return notFoundState, nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
+
+// createCacheSubnetGroup derives a subnet group named after the cache resource from the given subnets,
+// reusing the one of a previous creation under the same name when it has the same subnets.
+func createCacheSubnetGroup(api elasticacheiface.ElastiCacheAPI, l *logger.Logger, name string, subnets []*string) (*string, error) {
+	input := &elasticache.CreateCacheSubnetGroupInput{
+		CacheSubnetGroupName:        awssdk.String(name),
+		CacheSubnetGroupDescription: awssdk.String(fmt.Sprintf("Subnet group of %s created by awless", name)),
+		SubnetIds:                   subnets,
+	}
+	start := time.Now()
+	_, err := api.CreateCacheSubnetGroup(input)
+	l.ExtraVerbosef("elasticache.CreateCacheSubnetGroup call took %s", time.Since(start))
+	if awserr, ok := err.(awserr.Error); ok && awserr.Code() == elasticache.ErrCodeCacheSubnetGroupAlreadyExistsFault {
+		if err = checkCacheSubnetGroupSubnets(api, l, name, subnets); err != nil {
+			return nil, err
+		}
+		l.Verbosef("reusing existing cache subnet group '%s'", name)
+		return input.CacheSubnetGroupName, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create cache subnet group '%s': %s", name, err)
+	}
+	l.Verbosef("cache subnet group '%s' created from subnets", name)
+	return input.CacheSubnetGroupName, nil
+}
+
+func checkCacheSubnetGroupSubnets(api elasticacheiface.ElastiCacheAPI, l *logger.Logger, name string, subnets []*string) error {
+	start := time.Now()
+	out, err := api.DescribeCacheSubnetGroups(&elasticache.DescribeCacheSubnetGroupsInput{CacheSubnetGroupName: awssdk.String(name)})
+	l.ExtraVerbosef("elasticache.DescribeCacheSubnetGroups call took %s", time.Since(start))
+	if err != nil {
+		return fmt.Errorf("describe existing cache subnet group '%s': %s", name, err)
+	}
+	if len(out.CacheSubnetGroups) != 1 {
+		return fmt.Errorf("describe existing cache subnet group '%s': found %d groups", name, len(out.CacheSubnetGroups))
+	}
+	existing := make(map[string]bool)
+	var existingIds []string
+	for _, subnet := range out.CacheSubnetGroups[0].Subnets {
+		existing[StringValue(subnet.SubnetIdentifier)] = true
+		existingIds = append(existingIds, StringValue(subnet.SubnetIdentifier))
+	}
+	same := len(existing) == len(subnets)
+	for _, subnet := range subnets {
+		same = same && existing[StringValue(subnet)]
+	}
+	if !same {
+		return fmt.Errorf("cache subnet group '%s' already exists with other subnets [%s]: delete it or use 'subnetgroup' instead of 'subnets'", name, strings.Join(existingIds, ","))
+	}
+	return nil
+}
+
+func excludesCacheSubnetgroup(i interface{}, others map[string]interface{}) error {
+	if _, ok := others["subnetgroup"]; ok {
+		return errors.New("cannot set both 'subnets' and 'subnetgroup': the subnet group is derived from the subnets")
+	}
+	return nil
+}
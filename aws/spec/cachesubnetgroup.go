@@ -0,0 +1,37 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+// Cache subnet groups are created from the subnets of cache clusters and replication groups
+// (see createCacheSubnetGroup): they are deleted when reverting those creations.
+type DeleteCachesubnetgroup struct {
+	_      string `action:"delete" entity:"cachesubnetgroup" awsAPI:"elasticache" awsCall:"DeleteCacheSubnetGroup" awsInput:"elasticache.DeleteCacheSubnetGroupInput" awsOutput:"elasticache.DeleteCacheSubnetGroupOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    elasticacheiface.ElastiCacheAPI
+	Name   *string `awsName:"CacheSubnetGroupName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteCachesubnetgroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateFilesystem struct {
+	_               string `action:"create" entity:"filesystem" awsAPI:"efs" awsCall:"CreateFileSystem" awsInput:"efs.CreateFileSystemInput" awsOutput:"efs.FileSystemDescription"`
+	logger          *logger.Logger
+	graph           cloud.GraphAPI
+	api             efsiface.EFSAPI
+	Name            *string `templateName:"name"`
+	Token           *string `awsName:"CreationToken" awsType:"awsstr" templateName:"token"`
+	Performancemode *string `awsName:"PerformanceMode" awsType:"awsstr" templateName:"performancemode"`
+	Encrypted       *bool   `awsName:"Encrypted" awsType:"awsbool" templateName:"encrypted"`
+	Key             *string `awsName:"KmsKeyId" awsType:"awsstr" templateName:"key"`
+}
+
+func (cmd *CreateFilesystem) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Opt(params.Suggested("name"), "encrypted", "key", "performancemode", "token")),
+		params.Validators{
+			"performancemode": params.IsInEnumIgnoreCase(efs.PerformanceModeGeneralPurpose, efs.PerformanceModeMaxIo),
+		})
+}
+
+func (cmd *CreateFilesystem) BeforeRun(renv env.Running) error {
+	if cmd.Token == nil {
+		token := fmt.Sprintf("awless-%d", time.Now().UnixNano())
+		if cmd.Name != nil {
+			token = fmt.Sprintf("%s-%s", StringValue(cmd.Name), token)
+		}
+		cmd.Token = String(token)
+	}
+	for _, mode := range []string{efs.PerformanceModeGeneralPurpose, efs.PerformanceModeMaxIo} {
+		if strings.EqualFold(StringValue(cmd.Performancemode), mode) {
+			cmd.Performancemode = String(mode)
+		}
+	}
+	if cmd.Key != nil && cmd.Encrypted == nil {
+		cmd.Encrypted = Bool(true)
+	}
+	return nil
+}
+
+func (cmd *CreateFilesystem) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*efs.FileSystemDescription).FileSystemId)
+}
+
+func (cmd *CreateFilesystem) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name == nil {
+		return nil
+	}
+	id := cmd.ExtractResult(output)
+	input := &efs.CreateTagsInput{
+		FileSystemId: awssdk.String(id),
+		Tags:         []*efs.Tag{{Key: awssdk.String("Name"), Value: cmd.Name}},
+	}
+	start := time.Now()
+	if _, err := cmd.api.CreateTags(input); err != nil {
+		return fmt.Errorf("adding Name tag to filesystem %s: %s", id, err)
+	}
+	cmd.logger.ExtraVerbosef("efs.CreateTags call took %s", time.Since(start))
+	return nil
+}
+
+type DeleteFilesystem struct {
+	_      string `action:"delete" entity:"filesystem" awsAPI:"efs" awsCall:"DeleteFileSystem" awsInput:"efs.DeleteFileSystemInput" awsOutput:"efs.DeleteFileSystemOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    efsiface.EFSAPI
+	Id     *string `awsName:"FileSystemId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteFilesystem) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type CheckFilesystem struct {
+	_       string `action:"check" entity:"filesystem" awsAPI:"efs"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     efsiface.EFSAPI
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *CheckFilesystem) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase(efs.LifeCycleStateAvailable, efs.LifeCycleStateCreating,
+				efs.LifeCycleStateDeleted, efs.LifeCycleStateDeleting, notFoundState),
+		},
+	)
+}
+
+func (cmd *CheckFilesystem) ManualRun(renv env.Running) (interface{}, error) {
+	input := &efs.DescribeFileSystemsInput{
+		FileSystemId: cmd.Id,
+	}
+
+	c := &checker{
+		description: fmt.Sprintf("filesystem %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeFileSystems(input)
+			if err != nil {
+				if awserr, ok := err.(awserr.Error); ok && awserr.Code() == efs.ErrCodeFileSystemNotFound {
+					return notFoundState, nil
+				}
+				return "", err
+			}
+			for _, fs := range output.FileSystems {
+				if StringValue(fs.FileSystemId) == StringValue(cmd.Id) {
+					return StringValue(fs.LifeCycleState), nil
+				}
+			}
+			return notFoundState, nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
@@ -18,6 +18,7 @@ limitations under the License.
 package awsspec
 
 var APIPerTemplateDefName = map[string]string{
+	"acceptvpcpeering":          "ec2",
 	"attachalarm":               "cloudwatch",
 	"attachclassicloadbalancer": "elb",
 	"attachcontainertask":       "ecs",
@@ -35,13 +36,17 @@ var APIPerTemplateDefName = map[string]string{
 	"attachuser":                "iam",
 	"attachvolume":              "ec2",
 	"authenticateregistry":      "ecr",
+	"checkcachecluster":         "elasticache",
 	"checkcertificate":          "acm",
 	"checkdatabase":             "rds",
 	"checkdistribution":         "cloudfront",
+	"checkfilesystem":           "efs",
 	"checkinstance":             "ec2",
 	"checkloadbalancer":         "elbv2",
+	"checkmounttarget":          "efs",
 	"checknatgateway":           "ec2",
 	"checknetworkinterface":     "ec2",
+	"checkreplicationgroup":     "elasticache",
 	"checkscalinggroup":         "autoscaling",
 	"checksecuritygroup":        "ec2",
 	"checkvolume":               "ec2",
@@ -51,7 +56,9 @@ var APIPerTemplateDefName = map[string]string{
 	"createalarm":               "cloudwatch",
 	"createappscalingpolicy":    "applicationautoscaling",
 	"createappscalingtarget":    "applicationautoscaling",
+	"createbackup":              "dynamodb",
 	"createbucket":              "s3",
+	"createcachecluster":        "elasticache",
 	"createcertificate":         "acm",
 	"createclassicloadbalancer": "elb",
 	"createcontainercluster":    "ecs",
@@ -59,24 +66,31 @@ var APIPerTemplateDefName = map[string]string{
 	"createdbsubnetgroup":       "rds",
 	"createdistribution":        "cloudfront",
 	"createelasticip":           "ec2",
+	"createfilesystem":          "efs",
 	"createfunction":            "lambda",
 	"creategroup":               "iam",
+	"createhealthcheck":         "route53",
 	"createimage":               "ec2",
 	"createinstance":            "ec2",
 	"createinstanceprofile":     "iam",
 	"createinternetgateway":     "ec2",
+	"createkey":                 "kms",
 	"createkeypair":             "ec2",
 	"createlaunchconfiguration": "autoscaling",
 	"createlistener":            "elbv2",
 	"createloadbalancer":        "elbv2",
 	"createloginprofile":        "iam",
 	"createmfadevice":           "iam",
+	"createmounttarget":         "efs",
 	"createnatgateway":          "ec2",
 	"createnetworkinterface":    "ec2",
+	"createparameter":           "ssm",
 	"createpolicy":              "iam",
 	"createqueue":               "sqs",
 	"createrecord":              "route53",
+	"createreplicationgroup":    "elasticache",
 	"createrepository":          "ecr",
+	"createrestapi":             "apigateway",
 	"createrole":                "iam",
 	"createroute":               "ec2",
 	"createroutetable":          "ec2",
@@ -86,20 +100,28 @@ var APIPerTemplateDefName = map[string]string{
 	"createsecuritygroup":       "ec2",
 	"createsnapshot":            "ec2",
 	"createstack":               "cloudformation",
+	"createstage":               "apigateway",
+	"createstatemachine":        "sfn",
 	"createsubnet":              "ec2",
 	"createsubscription":        "sns",
+	"createtable":               "dynamodb",
 	"createtag":                 "ec2",
 	"createtargetgroup":         "elbv2",
 	"createtopic":               "sns",
 	"createuser":                "iam",
 	"createvolume":              "ec2",
 	"createvpc":                 "ec2",
+	"createvpcendpoint":         "ec2",
+	"createvpcpeering":          "ec2",
 	"createzone":                "route53",
 	"deleteaccesskey":           "iam",
 	"deletealarm":               "cloudwatch",
 	"deleteappscalingpolicy":    "applicationautoscaling",
 	"deleteappscalingtarget":    "applicationautoscaling",
+	"deletebackup":              "dynamodb",
 	"deletebucket":              "s3",
+	"deletecachecluster":        "elasticache",
+	"deletecachesubnetgroup":    "elasticache",
 	"deletecertificate":         "acm",
 	"deleteclassicloadbalancer": "elb",
 	"deletecontainercluster":    "ecs",
@@ -108,24 +130,31 @@ var APIPerTemplateDefName = map[string]string{
 	"deletedbsubnetgroup":       "rds",
 	"deletedistribution":        "cloudfront",
 	"deleteelasticip":           "ec2",
+	"deletefilesystem":          "efs",
 	"deletefunction":            "lambda",
 	"deletegroup":               "iam",
+	"deletehealthcheck":         "route53",
 	"deleteimage":               "ec2",
 	"deleteinstance":            "ec2",
 	"deleteinstanceprofile":     "iam",
 	"deleteinternetgateway":     "ec2",
+	"deletekey":                 "kms",
 	"deletekeypair":             "ec2",
 	"deletelaunchconfiguration": "autoscaling",
 	"deletelistener":            "elbv2",
 	"deleteloadbalancer":        "elbv2",
 	"deleteloginprofile":        "iam",
 	"deletemfadevice":           "iam",
+	"deletemounttarget":         "efs",
 	"deletenatgateway":          "ec2",
 	"deletenetworkinterface":    "ec2",
+	"deleteparameter":           "ssm",
 	"deletepolicy":              "iam",
 	"deletequeue":               "sqs",
 	"deleterecord":              "route53",
+	"deletereplicationgroup":    "elasticache",
 	"deleterepository":          "ecr",
+	"deleterestapi":             "apigateway",
 	"deleterole":                "iam",
 	"deleteroute":               "ec2",
 	"deleteroutetable":          "ec2",
@@ -135,14 +164,19 @@ var APIPerTemplateDefName = map[string]string{
 	"deletesecuritygroup":       "ec2",
 	"deletesnapshot":            "ec2",
 	"deletestack":               "cloudformation",
+	"deletestage":               "apigateway",
+	"deletestatemachine":        "sfn",
 	"deletesubnet":              "ec2",
 	"deletesubscription":        "sns",
+	"deletetable":               "dynamodb",
 	"deletetag":                 "ec2",
 	"deletetargetgroup":         "elbv2",
 	"deletetopic":               "sns",
 	"deleteuser":                "iam",
 	"deletevolume":              "ec2",
 	"deletevpc":                 "ec2",
+	"deletevpcendpoint":         "ec2",
+	"deletevpcpeering":          "ec2",
 	"deletezone":                "route53",
 	"detachalarm":               "cloudwatch",
 	"detachclassicloadbalancer": "elb",
@@ -176,7 +210,9 @@ var APIPerTemplateDefName = map[string]string{
 	"updatedistribution":        "cloudfront",
 	"updateimage":               "ec2",
 	"updateinstance":            "ec2",
+	"updatekey":                 "kms",
 	"updateloginprofile":        "iam",
+	"updateparameter":           "ssm",
 	"updatepolicy":              "iam",
 	"updaterecord":              "route53",
 	"updates3object":            "s3",
@@ -184,10 +220,17 @@ var APIPerTemplateDefName = map[string]string{
 	"updatesecuritygroup":       "ec2",
 	"updatestack":               "cloudformation",
 	"updatesubnet":              "ec2",
+	"updatetable":               "dynamodb",
 	"updatetargetgroup":         "elbv2",
 }
 
 var AWSTemplatesDefinitions = map[string]Definition{
+	"acceptvpcpeering": {
+		Action: "accept",
+		Entity: "vpcpeering",
+		Api:    "ec2",
+		Params: new(AcceptVpcpeering).ParamsSpec().Rule(),
+	},
 	"attachalarm": {
 		Action: "attach",
 		Entity: "alarm",
@@ -290,6 +333,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ecr",
 		Params: new(AuthenticateRegistry).ParamsSpec().Rule(),
 	},
+	"checkcachecluster": {
+		Action: "check",
+		Entity: "cachecluster",
+		Api:    "elasticache",
+		Params: new(CheckCachecluster).ParamsSpec().Rule(),
+	},
 	"checkcertificate": {
 		Action: "check",
 		Entity: "certificate",
@@ -308,6 +357,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "cloudfront",
 		Params: new(CheckDistribution).ParamsSpec().Rule(),
 	},
+	"checkfilesystem": {
+		Action: "check",
+		Entity: "filesystem",
+		Api:    "efs",
+		Params: new(CheckFilesystem).ParamsSpec().Rule(),
+	},
 	"checkinstance": {
 		Action: "check",
 		Entity: "instance",
@@ -320,6 +375,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "elbv2",
 		Params: new(CheckLoadbalancer).ParamsSpec().Rule(),
 	},
+	"checkmounttarget": {
+		Action: "check",
+		Entity: "mounttarget",
+		Api:    "efs",
+		Params: new(CheckMounttarget).ParamsSpec().Rule(),
+	},
 	"checknatgateway": {
 		Action: "check",
 		Entity: "natgateway",
@@ -332,6 +393,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(CheckNetworkinterface).ParamsSpec().Rule(),
 	},
+	"checkreplicationgroup": {
+		Action: "check",
+		Entity: "replicationgroup",
+		Api:    "elasticache",
+		Params: new(CheckReplicationgroup).ParamsSpec().Rule(),
+	},
 	"checkscalinggroup": {
 		Action: "check",
 		Entity: "scalinggroup",
@@ -386,12 +453,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "applicationautoscaling",
 		Params: new(CreateAppscalingtarget).ParamsSpec().Rule(),
 	},
+	"createbackup": {
+		Action: "create",
+		Entity: "backup",
+		Api:    "dynamodb",
+		Params: new(CreateBackup).ParamsSpec().Rule(),
+	},
 	"createbucket": {
 		Action: "create",
 		Entity: "bucket",
 		Api:    "s3",
 		Params: new(CreateBucket).ParamsSpec().Rule(),
 	},
+	"createcachecluster": {
+		Action: "create",
+		Entity: "cachecluster",
+		Api:    "elasticache",
+		Params: new(CreateCachecluster).ParamsSpec().Rule(),
+	},
 	"createcertificate": {
 		Action: "create",
 		Entity: "certificate",
@@ -434,6 +513,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(CreateElasticip).ParamsSpec().Rule(),
 	},
+	"createfilesystem": {
+		Action: "create",
+		Entity: "filesystem",
+		Api:    "efs",
+		Params: new(CreateFilesystem).ParamsSpec().Rule(),
+	},
 	"createfunction": {
 		Action: "create",
 		Entity: "function",
@@ -446,6 +531,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "iam",
 		Params: new(CreateGroup).ParamsSpec().Rule(),
 	},
+	"createhealthcheck": {
+		Action: "create",
+		Entity: "healthcheck",
+		Api:    "route53",
+		Params: new(CreateHealthcheck).ParamsSpec().Rule(),
+	},
 	"createimage": {
 		Action: "create",
 		Entity: "image",
@@ -470,6 +561,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(CreateInternetgateway).ParamsSpec().Rule(),
 	},
+	"createkey": {
+		Action: "create",
+		Entity: "key",
+		Api:    "kms",
+		Params: new(CreateKey).ParamsSpec().Rule(),
+	},
 	"createkeypair": {
 		Action: "create",
 		Entity: "keypair",
@@ -506,6 +603,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "iam",
 		Params: new(CreateMfadevice).ParamsSpec().Rule(),
 	},
+	"createmounttarget": {
+		Action: "create",
+		Entity: "mounttarget",
+		Api:    "efs",
+		Params: new(CreateMounttarget).ParamsSpec().Rule(),
+	},
 	"createnatgateway": {
 		Action: "create",
 		Entity: "natgateway",
@@ -518,6 +621,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(CreateNetworkinterface).ParamsSpec().Rule(),
 	},
+	"createparameter": {
+		Action: "create",
+		Entity: "parameter",
+		Api:    "ssm",
+		Params: new(CreateParameter).ParamsSpec().Rule(),
+	},
 	"createpolicy": {
 		Action: "create",
 		Entity: "policy",
@@ -536,12 +645,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "route53",
 		Params: new(CreateRecord).ParamsSpec().Rule(),
 	},
+	"createreplicationgroup": {
+		Action: "create",
+		Entity: "replicationgroup",
+		Api:    "elasticache",
+		Params: new(CreateReplicationgroup).ParamsSpec().Rule(),
+	},
 	"createrepository": {
 		Action: "create",
 		Entity: "repository",
 		Api:    "ecr",
 		Params: new(CreateRepository).ParamsSpec().Rule(),
 	},
+	"createrestapi": {
+		Action: "create",
+		Entity: "restapi",
+		Api:    "apigateway",
+		Params: new(CreateRestapi).ParamsSpec().Rule(),
+	},
 	"createrole": {
 		Action: "create",
 		Entity: "role",
@@ -596,6 +717,18 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "cloudformation",
 		Params: new(CreateStack).ParamsSpec().Rule(),
 	},
+	"createstage": {
+		Action: "create",
+		Entity: "stage",
+		Api:    "apigateway",
+		Params: new(CreateStage).ParamsSpec().Rule(),
+	},
+	"createstatemachine": {
+		Action: "create",
+		Entity: "statemachine",
+		Api:    "sfn",
+		Params: new(CreateStatemachine).ParamsSpec().Rule(),
+	},
 	"createsubnet": {
 		Action: "create",
 		Entity: "subnet",
@@ -608,6 +741,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "sns",
 		Params: new(CreateSubscription).ParamsSpec().Rule(),
 	},
+	"createtable": {
+		Action: "create",
+		Entity: "table",
+		Api:    "dynamodb",
+		Params: new(CreateTable).ParamsSpec().Rule(),
+	},
 	"createtag": {
 		Action: "create",
 		Entity: "tag",
@@ -644,6 +783,18 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(CreateVpc).ParamsSpec().Rule(),
 	},
+	"createvpcendpoint": {
+		Action: "create",
+		Entity: "vpcendpoint",
+		Api:    "ec2",
+		Params: new(CreateVpcendpoint).ParamsSpec().Rule(),
+	},
+	"createvpcpeering": {
+		Action: "create",
+		Entity: "vpcpeering",
+		Api:    "ec2",
+		Params: new(CreateVpcpeering).ParamsSpec().Rule(),
+	},
 	"createzone": {
 		Action: "create",
 		Entity: "zone",
@@ -674,12 +825,30 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "applicationautoscaling",
 		Params: new(DeleteAppscalingtarget).ParamsSpec().Rule(),
 	},
+	"deletebackup": {
+		Action: "delete",
+		Entity: "backup",
+		Api:    "dynamodb",
+		Params: new(DeleteBackup).ParamsSpec().Rule(),
+	},
 	"deletebucket": {
 		Action: "delete",
 		Entity: "bucket",
 		Api:    "s3",
 		Params: new(DeleteBucket).ParamsSpec().Rule(),
 	},
+	"deletecachecluster": {
+		Action: "delete",
+		Entity: "cachecluster",
+		Api:    "elasticache",
+		Params: new(DeleteCachecluster).ParamsSpec().Rule(),
+	},
+	"deletecachesubnetgroup": {
+		Action: "delete",
+		Entity: "cachesubnetgroup",
+		Api:    "elasticache",
+		Params: new(DeleteCachesubnetgroup).ParamsSpec().Rule(),
+	},
 	"deletecertificate": {
 		Action: "delete",
 		Entity: "certificate",
@@ -728,6 +897,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(DeleteElasticip).ParamsSpec().Rule(),
 	},
+	"deletefilesystem": {
+		Action: "delete",
+		Entity: "filesystem",
+		Api:    "efs",
+		Params: new(DeleteFilesystem).ParamsSpec().Rule(),
+	},
 	"deletefunction": {
 		Action: "delete",
 		Entity: "function",
@@ -740,6 +915,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "iam",
 		Params: new(DeleteGroup).ParamsSpec().Rule(),
 	},
+	"deletehealthcheck": {
+		Action: "delete",
+		Entity: "healthcheck",
+		Api:    "route53",
+		Params: new(DeleteHealthcheck).ParamsSpec().Rule(),
+	},
 	"deleteimage": {
 		Action: "delete",
 		Entity: "image",
@@ -764,6 +945,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(DeleteInternetgateway).ParamsSpec().Rule(),
 	},
+	"deletekey": {
+		Action: "delete",
+		Entity: "key",
+		Api:    "kms",
+		Params: new(DeleteKey).ParamsSpec().Rule(),
+	},
 	"deletekeypair": {
 		Action: "delete",
 		Entity: "keypair",
@@ -800,6 +987,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "iam",
 		Params: new(DeleteMfadevice).ParamsSpec().Rule(),
 	},
+	"deletemounttarget": {
+		Action: "delete",
+		Entity: "mounttarget",
+		Api:    "efs",
+		Params: new(DeleteMounttarget).ParamsSpec().Rule(),
+	},
 	"deletenatgateway": {
 		Action: "delete",
 		Entity: "natgateway",
@@ -812,6 +1005,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(DeleteNetworkinterface).ParamsSpec().Rule(),
 	},
+	"deleteparameter": {
+		Action: "delete",
+		Entity: "parameter",
+		Api:    "ssm",
+		Params: new(DeleteParameter).ParamsSpec().Rule(),
+	},
 	"deletepolicy": {
 		Action: "delete",
 		Entity: "policy",
@@ -830,12 +1029,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "route53",
 		Params: new(DeleteRecord).ParamsSpec().Rule(),
 	},
+	"deletereplicationgroup": {
+		Action: "delete",
+		Entity: "replicationgroup",
+		Api:    "elasticache",
+		Params: new(DeleteReplicationgroup).ParamsSpec().Rule(),
+	},
 	"deleterepository": {
 		Action: "delete",
 		Entity: "repository",
 		Api:    "ecr",
 		Params: new(DeleteRepository).ParamsSpec().Rule(),
 	},
+	"deleterestapi": {
+		Action: "delete",
+		Entity: "restapi",
+		Api:    "apigateway",
+		Params: new(DeleteRestapi).ParamsSpec().Rule(),
+	},
 	"deleterole": {
 		Action: "delete",
 		Entity: "role",
@@ -890,6 +1101,18 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "cloudformation",
 		Params: new(DeleteStack).ParamsSpec().Rule(),
 	},
+	"deletestage": {
+		Action: "delete",
+		Entity: "stage",
+		Api:    "apigateway",
+		Params: new(DeleteStage).ParamsSpec().Rule(),
+	},
+	"deletestatemachine": {
+		Action: "delete",
+		Entity: "statemachine",
+		Api:    "sfn",
+		Params: new(DeleteStatemachine).ParamsSpec().Rule(),
+	},
 	"deletesubnet": {
 		Action: "delete",
 		Entity: "subnet",
@@ -902,6 +1125,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "sns",
 		Params: new(DeleteSubscription).ParamsSpec().Rule(),
 	},
+	"deletetable": {
+		Action: "delete",
+		Entity: "table",
+		Api:    "dynamodb",
+		Params: new(DeleteTable).ParamsSpec().Rule(),
+	},
 	"deletetag": {
 		Action: "delete",
 		Entity: "tag",
@@ -938,6 +1167,18 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(DeleteVpc).ParamsSpec().Rule(),
 	},
+	"deletevpcendpoint": {
+		Action: "delete",
+		Entity: "vpcendpoint",
+		Api:    "ec2",
+		Params: new(DeleteVpcendpoint).ParamsSpec().Rule(),
+	},
+	"deletevpcpeering": {
+		Action: "delete",
+		Entity: "vpcpeering",
+		Api:    "ec2",
+		Params: new(DeleteVpcpeering).ParamsSpec().Rule(),
+	},
 	"deletezone": {
 		Action: "delete",
 		Entity: "zone",
@@ -1136,12 +1377,24 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(UpdateInstance).ParamsSpec().Rule(),
 	},
+	"updatekey": {
+		Action: "update",
+		Entity: "key",
+		Api:    "kms",
+		Params: new(UpdateKey).ParamsSpec().Rule(),
+	},
 	"updateloginprofile": {
 		Action: "update",
 		Entity: "loginprofile",
 		Api:    "iam",
 		Params: new(UpdateLoginprofile).ParamsSpec().Rule(),
 	},
+	"updateparameter": {
+		Action: "update",
+		Entity: "parameter",
+		Api:    "ssm",
+		Params: new(UpdateParameter).ParamsSpec().Rule(),
+	},
 	"updatepolicy": {
 		Action: "update",
 		Entity: "policy",
@@ -1184,6 +1437,12 @@ var AWSTemplatesDefinitions = map[string]Definition{
 		Api:    "ec2",
 		Params: new(UpdateSubnet).ParamsSpec().Rule(),
 	},
+	"updatetable": {
+		Action: "update",
+		Entity: "table",
+		Api:    "dynamodb",
+		Params: new(UpdateTable).ParamsSpec().Rule(),
+	},
 	"updatetargetgroup": {
 		Action: "update",
 		Entity: "targetgroup",
@@ -1193,16 +1452,17 @@ var AWSTemplatesDefinitions = map[string]Definition{
 }
 
 var DriverSupportedActions = map[string][]string{
+	"accept":       {"vpcpeering"},
 	"attach":       {"alarm", "classicloadbalancer", "containertask", "elasticip", "instance", "instanceprofile", "internetgateway", "listener", "mfadevice", "networkinterface", "policy", "role", "routetable", "securitygroup", "user", "volume"},
 	"authenticate": {"registry"},
-	"check":        {"certificate", "database", "distribution", "instance", "loadbalancer", "natgateway", "networkinterface", "scalinggroup", "securitygroup", "volume"},
+	"check":        {"cachecluster", "certificate", "database", "distribution", "filesystem", "instance", "loadbalancer", "mounttarget", "natgateway", "networkinterface", "replicationgroup", "scalinggroup", "securitygroup", "volume"},
 	"copy":         {"image", "snapshot"},
-	"create":       {"accesskey", "alarm", "appscalingpolicy", "appscalingtarget", "bucket", "certificate", "classicloadbalancer", "containercluster", "database", "dbsubnetgroup", "distribution", "elasticip", "function", "group", "image", "instance", "instanceprofile", "internetgateway", "keypair", "launchconfiguration", "listener", "loadbalancer", "loginprofile", "mfadevice", "natgateway", "networkinterface", "policy", "queue", "record", "repository", "role", "route", "routetable", "s3object", "scalinggroup", "scalingpolicy", "securitygroup", "snapshot", "stack", "subnet", "subscription", "tag", "targetgroup", "topic", "user", "volume", "vpc", "zone"},
-	"delete":       {"accesskey", "alarm", "appscalingpolicy", "appscalingtarget", "bucket", "certificate", "classicloadbalancer", "containercluster", "containertask", "database", "dbsubnetgroup", "distribution", "elasticip", "function", "group", "image", "instance", "instanceprofile", "internetgateway", "keypair", "launchconfiguration", "listener", "loadbalancer", "loginprofile", "mfadevice", "natgateway", "networkinterface", "policy", "queue", "record", "repository", "role", "route", "routetable", "s3object", "scalinggroup", "scalingpolicy", "securitygroup", "snapshot", "stack", "subnet", "subscription", "tag", "targetgroup", "topic", "user", "volume", "vpc", "zone"},
+	"create":       {"accesskey", "alarm", "appscalingpolicy", "appscalingtarget", "backup", "bucket", "cachecluster", "certificate", "classicloadbalancer", "containercluster", "database", "dbsubnetgroup", "distribution", "elasticip", "filesystem", "function", "group", "healthcheck", "image", "instance", "instanceprofile", "internetgateway", "key", "keypair", "launchconfiguration", "listener", "loadbalancer", "loginprofile", "mfadevice", "mounttarget", "natgateway", "networkinterface", "parameter", "policy", "queue", "record", "replicationgroup", "repository", "restapi", "role", "route", "routetable", "s3object", "scalinggroup", "scalingpolicy", "securitygroup", "snapshot", "stack", "stage", "statemachine", "subnet", "subscription", "table", "tag", "targetgroup", "topic", "user", "volume", "vpc", "vpcendpoint", "vpcpeering", "zone"},
+	"delete":       {"accesskey", "alarm", "appscalingpolicy", "appscalingtarget", "backup", "bucket", "cachecluster", "cachesubnetgroup", "certificate", "classicloadbalancer", "containercluster", "containertask", "database", "dbsubnetgroup", "distribution", "elasticip", "filesystem", "function", "group", "healthcheck", "image", "instance", "instanceprofile", "internetgateway", "key", "keypair", "launchconfiguration", "listener", "loadbalancer", "loginprofile", "mfadevice", "mounttarget", "natgateway", "networkinterface", "parameter", "policy", "queue", "record", "replicationgroup", "repository", "restapi", "role", "route", "routetable", "s3object", "scalinggroup", "scalingpolicy", "securitygroup", "snapshot", "stack", "stage", "statemachine", "subnet", "subscription", "table", "tag", "targetgroup", "topic", "user", "volume", "vpc", "vpcendpoint", "vpcpeering", "zone"},
 	"detach":       {"alarm", "classicloadbalancer", "containertask", "elasticip", "instance", "instanceprofile", "internetgateway", "mfadevice", "networkinterface", "policy", "role", "routetable", "securitygroup", "user", "volume"},
 	"import":       {"image"},
 	"restart":      {"database", "instance"},
 	"start":        {"alarm", "containertask", "database", "instance"},
 	"stop":         {"alarm", "containertask", "database", "instance"},
-	"update":       {"bucket", "classicloadbalancer", "containertask", "distribution", "image", "instance", "loginprofile", "policy", "record", "s3object", "scalinggroup", "securitygroup", "stack", "subnet", "targetgroup"},
+	"update":       {"bucket", "classicloadbalancer", "containertask", "distribution", "image", "instance", "key", "loginprofile", "parameter", "policy", "record", "s3object", "scalinggroup", "securitygroup", "stack", "subnet", "table", "targetgroup"},
 }
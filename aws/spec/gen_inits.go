@@ -43,6 +43,8 @@ type AWSFactory struct {
 
 func (f *AWSFactory) Build(key string) func() interface{} {
 	switch key {
+	case "acceptvpcpeering":
+		return func() interface{} { return NewAcceptVpcpeering(f.Sess, f.Graph, f.Log) }
 	case "attachalarm":
 		return func() interface{} { return NewAttachAlarm(f.Sess, f.Graph, f.Log) }
 	case "attachclassicloadbalancer":
@@ -77,20 +79,28 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewAttachVolume(f.Sess, f.Graph, f.Log) }
 	case "authenticateregistry":
 		return func() interface{} { return NewAuthenticateRegistry(f.Sess, f.Graph, f.Log) }
+	case "checkcachecluster":
+		return func() interface{} { return NewCheckCachecluster(f.Sess, f.Graph, f.Log) }
 	case "checkcertificate":
 		return func() interface{} { return NewCheckCertificate(f.Sess, f.Graph, f.Log) }
 	case "checkdatabase":
 		return func() interface{} { return NewCheckDatabase(f.Sess, f.Graph, f.Log) }
 	case "checkdistribution":
 		return func() interface{} { return NewCheckDistribution(f.Sess, f.Graph, f.Log) }
+	case "checkfilesystem":
+		return func() interface{} { return NewCheckFilesystem(f.Sess, f.Graph, f.Log) }
 	case "checkinstance":
 		return func() interface{} { return NewCheckInstance(f.Sess, f.Graph, f.Log) }
 	case "checkloadbalancer":
 		return func() interface{} { return NewCheckLoadbalancer(f.Sess, f.Graph, f.Log) }
+	case "checkmounttarget":
+		return func() interface{} { return NewCheckMounttarget(f.Sess, f.Graph, f.Log) }
 	case "checknatgateway":
 		return func() interface{} { return NewCheckNatgateway(f.Sess, f.Graph, f.Log) }
 	case "checknetworkinterface":
 		return func() interface{} { return NewCheckNetworkinterface(f.Sess, f.Graph, f.Log) }
+	case "checkreplicationgroup":
+		return func() interface{} { return NewCheckReplicationgroup(f.Sess, f.Graph, f.Log) }
 	case "checkscalinggroup":
 		return func() interface{} { return NewCheckScalinggroup(f.Sess, f.Graph, f.Log) }
 	case "checksecuritygroup":
@@ -109,8 +119,12 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateAppscalingpolicy(f.Sess, f.Graph, f.Log) }
 	case "createappscalingtarget":
 		return func() interface{} { return NewCreateAppscalingtarget(f.Sess, f.Graph, f.Log) }
+	case "createbackup":
+		return func() interface{} { return NewCreateBackup(f.Sess, f.Graph, f.Log) }
 	case "createbucket":
 		return func() interface{} { return NewCreateBucket(f.Sess, f.Graph, f.Log) }
+	case "createcachecluster":
+		return func() interface{} { return NewCreateCachecluster(f.Sess, f.Graph, f.Log) }
 	case "createcertificate":
 		return func() interface{} { return NewCreateCertificate(f.Sess, f.Graph, f.Log) }
 	case "createclassicloadbalancer":
@@ -125,10 +139,14 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateDistribution(f.Sess, f.Graph, f.Log) }
 	case "createelasticip":
 		return func() interface{} { return NewCreateElasticip(f.Sess, f.Graph, f.Log) }
+	case "createfilesystem":
+		return func() interface{} { return NewCreateFilesystem(f.Sess, f.Graph, f.Log) }
 	case "createfunction":
 		return func() interface{} { return NewCreateFunction(f.Sess, f.Graph, f.Log) }
 	case "creategroup":
 		return func() interface{} { return NewCreateGroup(f.Sess, f.Graph, f.Log) }
+	case "createhealthcheck":
+		return func() interface{} { return NewCreateHealthcheck(f.Sess, f.Graph, f.Log) }
 	case "createimage":
 		return func() interface{} { return NewCreateImage(f.Sess, f.Graph, f.Log) }
 	case "createinstance":
@@ -137,6 +155,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateInstanceprofile(f.Sess, f.Graph, f.Log) }
 	case "createinternetgateway":
 		return func() interface{} { return NewCreateInternetgateway(f.Sess, f.Graph, f.Log) }
+	case "createkey":
+		return func() interface{} { return NewCreateKey(f.Sess, f.Graph, f.Log) }
 	case "createkeypair":
 		return func() interface{} { return NewCreateKeypair(f.Sess, f.Graph, f.Log) }
 	case "createlaunchconfiguration":
@@ -149,18 +169,26 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateLoginprofile(f.Sess, f.Graph, f.Log) }
 	case "createmfadevice":
 		return func() interface{} { return NewCreateMfadevice(f.Sess, f.Graph, f.Log) }
+	case "createmounttarget":
+		return func() interface{} { return NewCreateMounttarget(f.Sess, f.Graph, f.Log) }
 	case "createnatgateway":
 		return func() interface{} { return NewCreateNatgateway(f.Sess, f.Graph, f.Log) }
 	case "createnetworkinterface":
 		return func() interface{} { return NewCreateNetworkinterface(f.Sess, f.Graph, f.Log) }
+	case "createparameter":
+		return func() interface{} { return NewCreateParameter(f.Sess, f.Graph, f.Log) }
 	case "createpolicy":
 		return func() interface{} { return NewCreatePolicy(f.Sess, f.Graph, f.Log) }
 	case "createqueue":
 		return func() interface{} { return NewCreateQueue(f.Sess, f.Graph, f.Log) }
 	case "createrecord":
 		return func() interface{} { return NewCreateRecord(f.Sess, f.Graph, f.Log) }
+	case "createreplicationgroup":
+		return func() interface{} { return NewCreateReplicationgroup(f.Sess, f.Graph, f.Log) }
 	case "createrepository":
 		return func() interface{} { return NewCreateRepository(f.Sess, f.Graph, f.Log) }
+	case "createrestapi":
+		return func() interface{} { return NewCreateRestapi(f.Sess, f.Graph, f.Log) }
 	case "createrole":
 		return func() interface{} { return NewCreateRole(f.Sess, f.Graph, f.Log) }
 	case "createroute":
@@ -179,10 +207,16 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateSnapshot(f.Sess, f.Graph, f.Log) }
 	case "createstack":
 		return func() interface{} { return NewCreateStack(f.Sess, f.Graph, f.Log) }
+	case "createstage":
+		return func() interface{} { return NewCreateStage(f.Sess, f.Graph, f.Log) }
+	case "createstatemachine":
+		return func() interface{} { return NewCreateStatemachine(f.Sess, f.Graph, f.Log) }
 	case "createsubnet":
 		return func() interface{} { return NewCreateSubnet(f.Sess, f.Graph, f.Log) }
 	case "createsubscription":
 		return func() interface{} { return NewCreateSubscription(f.Sess, f.Graph, f.Log) }
+	case "createtable":
+		return func() interface{} { return NewCreateTable(f.Sess, f.Graph, f.Log) }
 	case "createtag":
 		return func() interface{} { return NewCreateTag(f.Sess, f.Graph, f.Log) }
 	case "createtargetgroup":
@@ -195,6 +229,10 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewCreateVolume(f.Sess, f.Graph, f.Log) }
 	case "createvpc":
 		return func() interface{} { return NewCreateVpc(f.Sess, f.Graph, f.Log) }
+	case "createvpcendpoint":
+		return func() interface{} { return NewCreateVpcendpoint(f.Sess, f.Graph, f.Log) }
+	case "createvpcpeering":
+		return func() interface{} { return NewCreateVpcpeering(f.Sess, f.Graph, f.Log) }
 	case "createzone":
 		return func() interface{} { return NewCreateZone(f.Sess, f.Graph, f.Log) }
 	case "deleteaccesskey":
@@ -205,8 +243,14 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDeleteAppscalingpolicy(f.Sess, f.Graph, f.Log) }
 	case "deleteappscalingtarget":
 		return func() interface{} { return NewDeleteAppscalingtarget(f.Sess, f.Graph, f.Log) }
+	case "deletebackup":
+		return func() interface{} { return NewDeleteBackup(f.Sess, f.Graph, f.Log) }
 	case "deletebucket":
 		return func() interface{} { return NewDeleteBucket(f.Sess, f.Graph, f.Log) }
+	case "deletecachecluster":
+		return func() interface{} { return NewDeleteCachecluster(f.Sess, f.Graph, f.Log) }
+	case "deletecachesubnetgroup":
+		return func() interface{} { return NewDeleteCachesubnetgroup(f.Sess, f.Graph, f.Log) }
 	case "deletecertificate":
 		return func() interface{} { return NewDeleteCertificate(f.Sess, f.Graph, f.Log) }
 	case "deleteclassicloadbalancer":
@@ -223,10 +267,14 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDeleteDistribution(f.Sess, f.Graph, f.Log) }
 	case "deleteelasticip":
 		return func() interface{} { return NewDeleteElasticip(f.Sess, f.Graph, f.Log) }
+	case "deletefilesystem":
+		return func() interface{} { return NewDeleteFilesystem(f.Sess, f.Graph, f.Log) }
 	case "deletefunction":
 		return func() interface{} { return NewDeleteFunction(f.Sess, f.Graph, f.Log) }
 	case "deletegroup":
 		return func() interface{} { return NewDeleteGroup(f.Sess, f.Graph, f.Log) }
+	case "deletehealthcheck":
+		return func() interface{} { return NewDeleteHealthcheck(f.Sess, f.Graph, f.Log) }
 	case "deleteimage":
 		return func() interface{} { return NewDeleteImage(f.Sess, f.Graph, f.Log) }
 	case "deleteinstance":
@@ -235,6 +283,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDeleteInstanceprofile(f.Sess, f.Graph, f.Log) }
 	case "deleteinternetgateway":
 		return func() interface{} { return NewDeleteInternetgateway(f.Sess, f.Graph, f.Log) }
+	case "deletekey":
+		return func() interface{} { return NewDeleteKey(f.Sess, f.Graph, f.Log) }
 	case "deletekeypair":
 		return func() interface{} { return NewDeleteKeypair(f.Sess, f.Graph, f.Log) }
 	case "deletelaunchconfiguration":
@@ -247,18 +297,26 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDeleteLoginprofile(f.Sess, f.Graph, f.Log) }
 	case "deletemfadevice":
 		return func() interface{} { return NewDeleteMfadevice(f.Sess, f.Graph, f.Log) }
+	case "deletemounttarget":
+		return func() interface{} { return NewDeleteMounttarget(f.Sess, f.Graph, f.Log) }
 	case "deletenatgateway":
 		return func() interface{} { return NewDeleteNatgateway(f.Sess, f.Graph, f.Log) }
 	case "deletenetworkinterface":
 		return func() interface{} { return NewDeleteNetworkinterface(f.Sess, f.Graph, f.Log) }
+	case "deleteparameter":
+		return func() interface{} { return NewDeleteParameter(f.Sess, f.Graph, f.Log) }
 	case "deletepolicy":
 		return func() interface{} { return NewDeletePolicy(f.Sess, f.Graph, f.Log) }
 	case "deletequeue":
 		return func() interface{} { return NewDeleteQueue(f.Sess, f.Graph, f.Log) }
 	case "deleterecord":
 		return func() interface{} { return NewDeleteRecord(f.Sess, f.Graph, f.Log) }
+	case "deletereplicationgroup":
+		return func() interface{} { return NewDeleteReplicationgroup(f.Sess, f.Graph, f.Log) }
 	case "deleterepository":
 		return func() interface{} { return NewDeleteRepository(f.Sess, f.Graph, f.Log) }
+	case "deleterestapi":
+		return func() interface{} { return NewDeleteRestapi(f.Sess, f.Graph, f.Log) }
 	case "deleterole":
 		return func() interface{} { return NewDeleteRole(f.Sess, f.Graph, f.Log) }
 	case "deleteroute":
@@ -277,10 +335,16 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDeleteSnapshot(f.Sess, f.Graph, f.Log) }
 	case "deletestack":
 		return func() interface{} { return NewDeleteStack(f.Sess, f.Graph, f.Log) }
+	case "deletestage":
+		return func() interface{} { return NewDeleteStage(f.Sess, f.Graph, f.Log) }
+	case "deletestatemachine":
+		return func() interface{} { return NewDeleteStatemachine(f.Sess, f.Graph, f.Log) }
 	case "deletesubnet":
 		return func() interface{} { return NewDeleteSubnet(f.Sess, f.Graph, f.Log) }
 	case "deletesubscription":
 		return func() interface{} { return NewDeleteSubscription(f.Sess, f.Graph, f.Log) }
+	case "deletetable":
+		return func() interface{} { return NewDeleteTable(f.Sess, f.Graph, f.Log) }
 	case "deletetag":
 		return func() interface{} { return NewDeleteTag(f.Sess, f.Graph, f.Log) }
 	case "deletetargetgroup":
@@ -293,6 +357,10 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewDeleteVolume(f.Sess, f.Graph, f.Log) }
 	case "deletevpc":
 		return func() interface{} { return NewDeleteVpc(f.Sess, f.Graph, f.Log) }
+	case "deletevpcendpoint":
+		return func() interface{} { return NewDeleteVpcendpoint(f.Sess, f.Graph, f.Log) }
+	case "deletevpcpeering":
+		return func() interface{} { return NewDeleteVpcpeering(f.Sess, f.Graph, f.Log) }
 	case "deletezone":
 		return func() interface{} { return NewDeleteZone(f.Sess, f.Graph, f.Log) }
 	case "detachalarm":
@@ -359,8 +427,12 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewUpdateImage(f.Sess, f.Graph, f.Log) }
 	case "updateinstance":
 		return func() interface{} { return NewUpdateInstance(f.Sess, f.Graph, f.Log) }
+	case "updatekey":
+		return func() interface{} { return NewUpdateKey(f.Sess, f.Graph, f.Log) }
 	case "updateloginprofile":
 		return func() interface{} { return NewUpdateLoginprofile(f.Sess, f.Graph, f.Log) }
+	case "updateparameter":
+		return func() interface{} { return NewUpdateParameter(f.Sess, f.Graph, f.Log) }
 	case "updatepolicy":
 		return func() interface{} { return NewUpdatePolicy(f.Sess, f.Graph, f.Log) }
 	case "updaterecord":
@@ -375,6 +447,8 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 		return func() interface{} { return NewUpdateStack(f.Sess, f.Graph, f.Log) }
 	case "updatesubnet":
 		return func() interface{} { return NewUpdateSubnet(f.Sess, f.Graph, f.Log) }
+	case "updatetable":
+		return func() interface{} { return NewUpdateTable(f.Sess, f.Graph, f.Log) }
 	case "updatetargetgroup":
 		return func() interface{} { return NewUpdateTargetgroup(f.Sess, f.Graph, f.Log) }
 	}
@@ -382,6 +456,7 @@ func (f *AWSFactory) Build(key string) func() interface{} {
 }
 
 var (
+	_ command = &AcceptVpcpeering{}
 	_ command = &AttachAlarm{}
 	_ command = &AttachClassicLoadbalancer{}
 	_ command = &AttachContainertask{}
@@ -399,13 +474,17 @@ var (
 	_ command = &AttachUser{}
 	_ command = &AttachVolume{}
 	_ command = &AuthenticateRegistry{}
+	_ command = &CheckCachecluster{}
 	_ command = &CheckCertificate{}
 	_ command = &CheckDatabase{}
 	_ command = &CheckDistribution{}
+	_ command = &CheckFilesystem{}
 	_ command = &CheckInstance{}
 	_ command = &CheckLoadbalancer{}
+	_ command = &CheckMounttarget{}
 	_ command = &CheckNatgateway{}
 	_ command = &CheckNetworkinterface{}
+	_ command = &CheckReplicationgroup{}
 	_ command = &CheckScalinggroup{}
 	_ command = &CheckSecuritygroup{}
 	_ command = &CheckVolume{}
@@ -415,7 +494,9 @@ var (
 	_ command = &CreateAlarm{}
 	_ command = &CreateAppscalingpolicy{}
 	_ command = &CreateAppscalingtarget{}
+	_ command = &CreateBackup{}
 	_ command = &CreateBucket{}
+	_ command = &CreateCachecluster{}
 	_ command = &CreateCertificate{}
 	_ command = &CreateClassicLoadbalancer{}
 	_ command = &CreateContainercluster{}
@@ -423,24 +504,31 @@ var (
 	_ command = &CreateDbsubnetgroup{}
 	_ command = &CreateDistribution{}
 	_ command = &CreateElasticip{}
+	_ command = &CreateFilesystem{}
 	_ command = &CreateFunction{}
 	_ command = &CreateGroup{}
+	_ command = &CreateHealthcheck{}
 	_ command = &CreateImage{}
 	_ command = &CreateInstance{}
 	_ command = &CreateInstanceprofile{}
 	_ command = &CreateInternetgateway{}
+	_ command = &CreateKey{}
 	_ command = &CreateKeypair{}
 	_ command = &CreateLaunchconfiguration{}
 	_ command = &CreateListener{}
 	_ command = &CreateLoadbalancer{}
 	_ command = &CreateLoginprofile{}
 	_ command = &CreateMfadevice{}
+	_ command = &CreateMounttarget{}
 	_ command = &CreateNatgateway{}
 	_ command = &CreateNetworkinterface{}
+	_ command = &CreateParameter{}
 	_ command = &CreatePolicy{}
 	_ command = &CreateQueue{}
 	_ command = &CreateRecord{}
+	_ command = &CreateReplicationgroup{}
 	_ command = &CreateRepository{}
+	_ command = &CreateRestapi{}
 	_ command = &CreateRole{}
 	_ command = &CreateRoute{}
 	_ command = &CreateRoutetable{}
@@ -450,20 +538,28 @@ var (
 	_ command = &CreateSecuritygroup{}
 	_ command = &CreateSnapshot{}
 	_ command = &CreateStack{}
+	_ command = &CreateStage{}
+	_ command = &CreateStatemachine{}
 	_ command = &CreateSubnet{}
 	_ command = &CreateSubscription{}
+	_ command = &CreateTable{}
 	_ command = &CreateTag{}
 	_ command = &CreateTargetgroup{}
 	_ command = &CreateTopic{}
 	_ command = &CreateUser{}
 	_ command = &CreateVolume{}
 	_ command = &CreateVpc{}
+	_ command = &CreateVpcendpoint{}
+	_ command = &CreateVpcpeering{}
 	_ command = &CreateZone{}
 	_ command = &DeleteAccesskey{}
 	_ command = &DeleteAlarm{}
 	_ command = &DeleteAppscalingpolicy{}
 	_ command = &DeleteAppscalingtarget{}
+	_ command = &DeleteBackup{}
 	_ command = &DeleteBucket{}
+	_ command = &DeleteCachecluster{}
+	_ command = &DeleteCachesubnetgroup{}
 	_ command = &DeleteCertificate{}
 	_ command = &DeleteClassicLoadbalancer{}
 	_ command = &DeleteContainercluster{}
@@ -472,24 +568,31 @@ var (
 	_ command = &DeleteDbsubnetgroup{}
 	_ command = &DeleteDistribution{}
 	_ command = &DeleteElasticip{}
+	_ command = &DeleteFilesystem{}
 	_ command = &DeleteFunction{}
 	_ command = &DeleteGroup{}
+	_ command = &DeleteHealthcheck{}
 	_ command = &DeleteImage{}
 	_ command = &DeleteInstance{}
 	_ command = &DeleteInstanceprofile{}
 	_ command = &DeleteInternetgateway{}
+	_ command = &DeleteKey{}
 	_ command = &DeleteKeypair{}
 	_ command = &DeleteLaunchconfiguration{}
 	_ command = &DeleteListener{}
 	_ command = &DeleteLoadbalancer{}
 	_ command = &DeleteLoginprofile{}
 	_ command = &DeleteMfadevice{}
+	_ command = &DeleteMounttarget{}
 	_ command = &DeleteNatgateway{}
 	_ command = &DeleteNetworkinterface{}
+	_ command = &DeleteParameter{}
 	_ command = &DeletePolicy{}
 	_ command = &DeleteQueue{}
 	_ command = &DeleteRecord{}
+	_ command = &DeleteReplicationgroup{}
 	_ command = &DeleteRepository{}
+	_ command = &DeleteRestapi{}
 	_ command = &DeleteRole{}
 	_ command = &DeleteRoute{}
 	_ command = &DeleteRoutetable{}
@@ -499,14 +602,19 @@ var (
 	_ command = &DeleteSecuritygroup{}
 	_ command = &DeleteSnapshot{}
 	_ command = &DeleteStack{}
+	_ command = &DeleteStage{}
+	_ command = &DeleteStatemachine{}
 	_ command = &DeleteSubnet{}
 	_ command = &DeleteSubscription{}
+	_ command = &DeleteTable{}
 	_ command = &DeleteTag{}
 	_ command = &DeleteTargetgroup{}
 	_ command = &DeleteTopic{}
 	_ command = &DeleteUser{}
 	_ command = &DeleteVolume{}
 	_ command = &DeleteVpc{}
+	_ command = &DeleteVpcendpoint{}
+	_ command = &DeleteVpcpeering{}
 	_ command = &DeleteZone{}
 	_ command = &DetachAlarm{}
 	_ command = &DetachClassicLoadbalancer{}
@@ -540,7 +648,9 @@ var (
 	_ command = &UpdateDistribution{}
 	_ command = &UpdateImage{}
 	_ command = &UpdateInstance{}
+	_ command = &UpdateKey{}
 	_ command = &UpdateLoginprofile{}
+	_ command = &UpdateParameter{}
 	_ command = &UpdatePolicy{}
 	_ command = &UpdateRecord{}
 	_ command = &UpdateS3object{}
@@ -548,5 +658,6 @@ var (
 	_ command = &UpdateSecuritygroup{}
 	_ command = &UpdateStack{}
 	_ command = &UpdateSubnet{}
+	_ command = &UpdateTable{}
 	_ command = &UpdateTargetgroup{}
 )
@@ -26,6 +26,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -36,18 +38,26 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -56,15 +66,119 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/template/env"
 )
 
+func NewAcceptVpcpeering(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AcceptVpcpeering {
+	cmd := new(AcceptVpcpeering)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *AcceptVpcpeering) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *AcceptVpcpeering) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *AcceptVpcpeering) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.AcceptVpcPeeringConnectionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AcceptVpcPeeringConnectionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.AcceptVpcPeeringConnection(input)
+	renv.Log().ExtraVerbosef("ec2.AcceptVpcPeeringConnection call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("accept vpcpeering: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("accept vpcpeering '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("accept vpcpeering done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *AcceptVpcpeering) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.AcceptVpcPeeringConnectionInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.AcceptVpcPeeringConnectionInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.AcceptVpcPeeringConnection(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.AcceptVpcPeeringConnection call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: accept vpcpeering ok")
+			return fakeDryRunId("vpcpeering"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *AcceptVpcpeering) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewAttachAlarm(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *AttachAlarm {
 	cmd := new(AttachAlarm)
 	if len(l) > 0 {
@@ -1473,6 +1587,79 @@ func (cmd *AuthenticateRegistry) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewCheckCachecluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckCachecluster {
+	cmd := new(CheckCachecluster)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elasticache.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CheckCachecluster) SetApi(api elasticacheiface.ElastiCacheAPI) {
+	cmd.api = api
+}
+
+func (cmd *CheckCachecluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CheckCachecluster) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("check cachecluster: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("check cachecluster '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("check cachecluster done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CheckCachecluster) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cachecluster"), nil
+}
+
+func (cmd *CheckCachecluster) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewCheckCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckCertificate {
 	cmd := new(CheckCertificate)
 	if len(l) > 0 {
@@ -1692,32 +1879,32 @@ func (cmd *CheckDistribution) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckInstance {
-	cmd := new(CheckInstance)
+func NewCheckFilesystem(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckFilesystem {
+	cmd := new(CheckFilesystem)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = efs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckFilesystem) SetApi(api efsiface.EFSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckFilesystem) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckFilesystem) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1738,14 +1925,14 @@ func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check instance: AWS command returned nil output")
+			renv.Log().Warning("check filesystem: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check instance '%s' done", extracted)
+		renv.Log().Verbosef("check filesystem '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check instance done")
+		renv.Log().Verbose("check filesystem done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1757,40 +1944,40 @@ func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *CheckInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instance"), nil
+func (cmd *CheckFilesystem) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("filesystem"), nil
 }
 
-func (cmd *CheckInstance) inject(params map[string]interface{}) error {
+func (cmd *CheckFilesystem) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckLoadbalancer {
-	cmd := new(CheckLoadbalancer)
+func NewCheckInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckInstance {
+	cmd := new(CheckInstance)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *CheckInstance) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CheckLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1811,14 +1998,14 @@ func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check loadbalancer: AWS command returned nil output")
+			renv.Log().Warning("check instance: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check loadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("check instance '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check loadbalancer done")
+		renv.Log().Verbose("check instance done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1830,40 +2017,40 @@ func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CheckLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loadbalancer"), nil
+func (cmd *CheckInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instance"), nil
 }
 
-func (cmd *CheckLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *CheckInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNatgateway {
-	cmd := new(CheckNatgateway)
+func NewCheckLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckLoadbalancer {
+	cmd := new(CheckLoadbalancer)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckNatgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *CheckNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1884,14 +2071,14 @@ func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check natgateway: AWS command returned nil output")
+			renv.Log().Warning("check loadbalancer: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check natgateway '%s' done", extracted)
+		renv.Log().Verbosef("check loadbalancer '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check natgateway done")
+		renv.Log().Verbose("check loadbalancer done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1903,40 +2090,40 @@ func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *CheckNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("natgateway"), nil
+func (cmd *CheckLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loadbalancer"), nil
 }
 
-func (cmd *CheckNatgateway) inject(params map[string]interface{}) error {
+func (cmd *CheckLoadbalancer) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNetworkinterface {
-	cmd := new(CheckNetworkinterface)
+func NewCheckMounttarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckMounttarget {
+	cmd := new(CheckMounttarget)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = efs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckNetworkinterface) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckMounttarget) SetApi(api efsiface.EFSAPI) {
 	cmd.api = api
 }
 
-func (cmd *CheckNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckMounttarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckMounttarget) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -1957,14 +2144,14 @@ func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check networkinterface: AWS command returned nil output")
+			renv.Log().Warning("check mounttarget: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check networkinterface '%s' done", extracted)
+		renv.Log().Verbosef("check mounttarget '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check networkinterface done")
+		renv.Log().Verbose("check mounttarget done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -1976,40 +2163,40 @@ func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *CheckNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("networkinterface"), nil
+func (cmd *CheckMounttarget) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("mounttarget"), nil
 }
 
-func (cmd *CheckNetworkinterface) inject(params map[string]interface{}) error {
+func (cmd *CheckMounttarget) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckScalinggroup {
-	cmd := new(CheckScalinggroup)
+func NewCheckNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNatgateway {
+	cmd := new(CheckNatgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CheckScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *CheckNatgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CheckScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2030,14 +2217,14 @@ func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check scalinggroup: AWS command returned nil output")
+			renv.Log().Warning("check natgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check scalinggroup '%s' done", extracted)
+		renv.Log().Verbosef("check natgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check scalinggroup done")
+		renv.Log().Verbose("check natgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2049,16 +2236,16 @@ func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CheckScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("scalinggroup"), nil
+func (cmd *CheckNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("natgateway"), nil
 }
 
-func (cmd *CheckScalinggroup) inject(params map[string]interface{}) error {
+func (cmd *CheckNatgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCheckSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckSecuritygroup {
-	cmd := new(CheckSecuritygroup)
+func NewCheckNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckNetworkinterface {
+	cmd := new(CheckNetworkinterface)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -2071,18 +2258,18 @@ func NewCheckSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger
 	return cmd
 }
 
-func (cmd *CheckSecuritygroup) SetApi(api ec2iface.EC2API) {
+func (cmd *CheckNetworkinterface) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CheckSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CheckNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2103,14 +2290,14 @@ func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("check securitygroup: AWS command returned nil output")
+			renv.Log().Warning("check networkinterface: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("check securitygroup '%s' done", extracted)
+		renv.Log().Verbosef("check networkinterface '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("check securitygroup done")
+		renv.Log().Verbose("check networkinterface done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2122,9 +2309,228 @@ func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CheckSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("securitygroup"), nil
-}
+func (cmd *CheckNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("networkinterface"), nil
+}
+
+func (cmd *CheckNetworkinterface) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCheckReplicationgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckReplicationgroup {
+	cmd := new(CheckReplicationgroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elasticache.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CheckReplicationgroup) SetApi(api elasticacheiface.ElastiCacheAPI) {
+	cmd.api = api
+}
+
+func (cmd *CheckReplicationgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CheckReplicationgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("check replicationgroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("check replicationgroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("check replicationgroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CheckReplicationgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("replicationgroup"), nil
+}
+
+func (cmd *CheckReplicationgroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCheckScalinggroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckScalinggroup {
+	cmd := new(CheckScalinggroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = autoscaling.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CheckScalinggroup) SetApi(api autoscalingiface.AutoScalingAPI) {
+	cmd.api = api
+}
+
+func (cmd *CheckScalinggroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CheckScalinggroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("check scalinggroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("check scalinggroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("check scalinggroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CheckScalinggroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("scalinggroup"), nil
+}
+
+func (cmd *CheckScalinggroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCheckSecuritygroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CheckSecuritygroup {
+	cmd := new(CheckSecuritygroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CheckSecuritygroup) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CheckSecuritygroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CheckSecuritygroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("check securitygroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("check securitygroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("check securitygroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CheckSecuritygroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("securitygroup"), nil
+}
 
 func (cmd *CheckSecuritygroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
@@ -2719,32 +3125,32 @@ func (cmd *CreateAppscalingtarget) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateBucket {
-	cmd := new(CreateBucket)
+func NewCreateBackup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateBackup {
+	cmd := new(CreateBackup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = dynamodb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateBucket) SetApi(api s3iface.S3API) {
+func (cmd *CreateBackup) SetApi(api dynamodbiface.DynamoDBAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateBackup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateBackup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2755,13 +3161,13 @@ func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &s3.CreateBucketInput{}
+	input := &dynamodb.CreateBackupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in s3.CreateBucketInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in dynamodb.CreateBackupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateBucket(input)
-	renv.Log().ExtraVerbosef("s3.CreateBucket call took %s", time.Since(start))
+	output, err := cmd.api.CreateBackup(input)
+	renv.Log().ExtraVerbosef("dynamodb.CreateBackup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -2771,14 +3177,14 @@ func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create bucket: AWS command returned nil output")
+			renv.Log().Warning("create backup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create bucket '%s' done", extracted)
+		renv.Log().Verbosef("create backup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create bucket done")
+		renv.Log().Verbose("create backup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2790,40 +3196,40 @@ func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreateBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("bucket"), nil
+func (cmd *CreateBackup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("backup"), nil
 }
 
-func (cmd *CreateBucket) inject(params map[string]interface{}) error {
+func (cmd *CreateBackup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCertificate {
-	cmd := new(CreateCertificate)
+func NewCreateBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateBucket {
+	cmd := new(CreateBucket)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = acm.New(sess)
+		cmd.api = s3.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateCertificate) SetApi(api acmiface.ACMAPI) {
+func (cmd *CreateBucket) SetApi(api s3iface.S3API) {
 	cmd.api = api
 }
 
-func (cmd *CreateCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -2834,7 +3240,13 @@ func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &s3.CreateBucketInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in s3.CreateBucketInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateBucket(input)
+	renv.Log().ExtraVerbosef("s3.CreateBucket call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -2844,14 +3256,14 @@ func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create certificate: AWS command returned nil output")
+			renv.Log().Warning("create bucket: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create certificate '%s' done", extracted)
+		renv.Log().Verbosef("create bucket '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create certificate done")
+		renv.Log().Verbose("create bucket done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -2863,8 +3275,154 @@ func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *CreateCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("certificate"), nil
+func (cmd *CreateBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("bucket"), nil
+}
+
+func (cmd *CreateBucket) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateCachecluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCachecluster {
+	cmd := new(CreateCachecluster)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elasticache.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateCachecluster) SetApi(api elasticacheiface.ElastiCacheAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateCachecluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateCachecluster) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create cachecluster: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create cachecluster '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create cachecluster done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateCachecluster) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cachecluster"), nil
+}
+
+func (cmd *CreateCachecluster) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateCertificate {
+	cmd := new(CreateCertificate)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = acm.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateCertificate) SetApi(api acmiface.ACMAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create certificate: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create certificate '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create certificate done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("certificate"), nil
 }
 
 func (cmd *CreateCertificate) inject(params map[string]interface{}) error {
@@ -3354,6 +3912,85 @@ func (cmd *CreateElasticip) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewCreateFilesystem(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateFilesystem {
+	cmd := new(CreateFilesystem)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = efs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateFilesystem) SetApi(api efsiface.EFSAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateFilesystem) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateFilesystem) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &efs.CreateFileSystemInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in efs.CreateFileSystemInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateFileSystem(input)
+	renv.Log().ExtraVerbosef("efs.CreateFileSystem call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create filesystem: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create filesystem '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create filesystem done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateFilesystem) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("filesystem"), nil
+}
+
+func (cmd *CreateFilesystem) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewCreateFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateFunction {
 	cmd := new(CreateFunction)
 	if len(l) > 0 {
@@ -3512,32 +4149,32 @@ func (cmd *CreateGroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateImage {
-	cmd := new(CreateImage)
+func NewCreateHealthcheck(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateHealthcheck {
+	cmd := new(CreateHealthcheck)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateImage) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateHealthcheck) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *CreateImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateHealthcheck) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateHealthcheck) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3548,13 +4185,13 @@ func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &ec2.CreateImageInput{}
+	input := &route53.CreateHealthCheckInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateImageInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in route53.CreateHealthCheckInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateImage(input)
-	renv.Log().ExtraVerbosef("ec2.CreateImage call took %s", time.Since(start))
+	output, err := cmd.api.CreateHealthCheck(input)
+	renv.Log().ExtraVerbosef("route53.CreateHealthCheck call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3564,14 +4201,14 @@ func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create image: AWS command returned nil output")
+			renv.Log().Warning("create healthcheck: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create image '%s' done", extracted)
+		renv.Log().Verbosef("create healthcheck '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create image done")
+		renv.Log().Verbose("create healthcheck done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -3583,37 +4220,16 @@ func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *CreateImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateImageInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateImageInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateImage(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateImage call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create image ok")
-			return fakeDryRunId("image"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateHealthcheck) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("healthcheck"), nil
 }
 
-func (cmd *CreateImage) inject(params map[string]interface{}) error {
+func (cmd *CreateHealthcheck) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInstance {
-	cmd := new(CreateInstance)
+func NewCreateImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateImage {
+	cmd := new(CreateImage)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -3626,18 +4242,18 @@ func NewCreateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Log
 	return cmd
 }
 
-func (cmd *CreateInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateImage) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -3648,13 +4264,13 @@ func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.RunInstancesInput{}
+	input := &ec2.CreateImageInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.RunInstancesInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateImageInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.RunInstances(input)
-	renv.Log().ExtraVerbosef("ec2.RunInstances call took %s", time.Since(start))
+	output, err := cmd.api.CreateImage(input)
+	renv.Log().ExtraVerbosef("ec2.CreateImage call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -3664,12 +4280,112 @@ func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create instance: AWS command returned nil output")
+			renv.Log().Warning("create image: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create instance '%s' done", extracted)
+		renv.Log().Verbosef("create image '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create image done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateImage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateImageInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateImageInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateImage(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateImage call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create image ok")
+			return fakeDryRunId("image"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateImage) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateInstance {
+	cmd := new(CreateInstance)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateInstance) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.RunInstancesInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.RunInstancesInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.RunInstances(input)
+	renv.Log().ExtraVerbosef("ec2.RunInstances call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create instance: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create instance '%s' done", extracted)
 	} else {
 		renv.Log().Verbose("create instance done")
 	}
@@ -3891,6 +4607,79 @@ func (cmd *CreateInternetgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewCreateKey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateKey {
+	cmd := new(CreateKey)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = kms.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateKey) SetApi(api kmsiface.KMSAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateKey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateKey) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create key: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create key '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create key done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateKey) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("key"), nil
+}
+
+func (cmd *CreateKey) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewCreateKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateKeypair {
 	cmd := new(CreateKeypair)
 	if len(l) > 0 {
@@ -4359,6 +5148,85 @@ func (cmd *CreateMfadevice) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewCreateMounttarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateMounttarget {
+	cmd := new(CreateMounttarget)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = efs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateMounttarget) SetApi(api efsiface.EFSAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateMounttarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateMounttarget) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &efs.CreateMountTargetInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in efs.CreateMountTargetInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateMountTarget(input)
+	renv.Log().ExtraVerbosef("efs.CreateMountTarget call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create mounttarget: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create mounttarget '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create mounttarget done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateMounttarget) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("mounttarget"), nil
+}
+
+func (cmd *CreateMounttarget) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewCreateNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateNatgateway {
 	cmd := new(CreateNatgateway)
 	if len(l) > 0 {
@@ -4538,32 +5406,32 @@ func (cmd *CreateNetworkinterface) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreatePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreatePolicy {
-	cmd := new(CreatePolicy)
+func NewCreateParameter(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateParameter {
+	cmd := new(CreateParameter)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ssm.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreatePolicy) SetApi(api iamiface.IAMAPI) {
+func (cmd *CreateParameter) SetApi(api ssmiface.SSMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreatePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateParameter) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateParameter) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4574,13 +5442,13 @@ func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &iam.CreatePolicyInput{}
+	input := &ssm.PutParameterInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.CreatePolicyInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ssm.PutParameterInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreatePolicy(input)
-	renv.Log().ExtraVerbosef("iam.CreatePolicy call took %s", time.Since(start))
+	output, err := cmd.api.PutParameter(input)
+	renv.Log().ExtraVerbosef("ssm.PutParameter call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -4590,14 +5458,14 @@ func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create policy: AWS command returned nil output")
+			renv.Log().Warning("create parameter: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create policy '%s' done", extracted)
+		renv.Log().Verbosef("create parameter '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create policy done")
+		renv.Log().Verbose("create parameter done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -4609,40 +5477,40 @@ func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreatePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("policy"), nil
+func (cmd *CreateParameter) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("parameter"), nil
 }
 
-func (cmd *CreatePolicy) inject(params map[string]interface{}) error {
+func (cmd *CreateParameter) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateQueue {
-	cmd := new(CreateQueue)
+func NewCreatePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreatePolicy {
+	cmd := new(CreatePolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sqs.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateQueue) SetApi(api sqsiface.SQSAPI) {
+func (cmd *CreatePolicy) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreatePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreatePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -4653,9 +5521,88 @@ func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &sqs.CreateQueueInput{}
+	input := &iam.CreatePolicyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sqs.CreateQueueInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.CreatePolicyInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreatePolicy(input)
+	renv.Log().ExtraVerbosef("iam.CreatePolicy call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create policy: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create policy '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create policy done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreatePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policy"), nil
+}
+
+func (cmd *CreatePolicy) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateQueue {
+	cmd := new(CreateQueue)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sqs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateQueue) SetApi(api sqsiface.SQSAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateQueue) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &sqs.CreateQueueInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in sqs.CreateQueueInput: %s", err)
 	}
 	start := time.Now()
 	output, err := cmd.api.CreateQueue(input)
@@ -4769,6 +5716,79 @@ func (cmd *CreateRecord) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewCreateReplicationgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateReplicationgroup {
+	cmd := new(CreateReplicationgroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elasticache.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateReplicationgroup) SetApi(api elasticacheiface.ElastiCacheAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateReplicationgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateReplicationgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create replicationgroup: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create replicationgroup '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create replicationgroup done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateReplicationgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("replicationgroup"), nil
+}
+
+func (cmd *CreateReplicationgroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewCreateRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRepository {
 	cmd := new(CreateRepository)
 	if len(l) > 0 {
@@ -4848,6 +5868,85 @@ func (cmd *CreateRepository) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewCreateRestapi(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRestapi {
+	cmd := new(CreateRestapi)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = apigateway.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateRestapi) SetApi(api apigatewayiface.APIGatewayAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateRestapi) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateRestapi) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &apigateway.CreateRestApiInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in apigateway.CreateRestApiInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateRestApi(input)
+	renv.Log().ExtraVerbosef("apigateway.CreateRestApi call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create restapi: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create restapi '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create restapi done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateRestapi) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("restapi"), nil
+}
+
+func (cmd *CreateRestapi) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewCreateRole(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateRole {
 	cmd := new(CreateRole)
 	if len(l) > 0 {
@@ -5631,32 +6730,32 @@ func (cmd *CreateStack) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubnet {
-	cmd := new(CreateSubnet)
+func NewCreateStage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateStage {
+	cmd := new(CreateStage)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = apigateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateSubnet) SetApi(api ec2iface.EC2API) {
+func (cmd *CreateStage) SetApi(api apigatewayiface.APIGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateStage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateStage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5667,13 +6766,13 @@ func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.CreateSubnetInput{}
+	input := &apigateway.CreateDeploymentInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in apigateway.CreateDeploymentInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateSubnet(input)
-	renv.Log().ExtraVerbosef("ec2.CreateSubnet call took %s", time.Since(start))
+	output, err := cmd.api.CreateDeployment(input)
+	renv.Log().ExtraVerbosef("apigateway.CreateDeployment call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5683,14 +6782,14 @@ func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create subnet: AWS command returned nil output")
+			renv.Log().Warning("create stage: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create subnet '%s' done", extracted)
+		renv.Log().Verbosef("create stage '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create subnet done")
+		renv.Log().Verbose("create stage done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5702,61 +6801,40 @@ func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *CreateSubnet) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.CreateSubnetInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.CreateSubnet(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.CreateSubnet call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: create subnet ok")
-			return fakeDryRunId("subnet"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *CreateStage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("stage"), nil
 }
 
-func (cmd *CreateSubnet) inject(params map[string]interface{}) error {
+func (cmd *CreateStage) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateSubscription(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubscription {
-	cmd := new(CreateSubscription)
+func NewCreateStatemachine(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateStatemachine {
+	cmd := new(CreateStatemachine)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sns.New(sess)
+		cmd.api = sfn.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateSubscription) SetApi(api snsiface.SNSAPI) {
+func (cmd *CreateStatemachine) SetApi(api sfniface.SFNAPI) {
 	cmd.api = api
 }
 
-func (cmd *CreateSubscription) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateStatemachine) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateStatemachine) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -5767,13 +6845,13 @@ func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &sns.SubscribeInput{}
+	input := &sfn.CreateStateMachineInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sns.SubscribeInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sfn.CreateStateMachineInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.Subscribe(input)
-	renv.Log().ExtraVerbosef("sns.Subscribe call took %s", time.Since(start))
+	output, err := cmd.api.CreateStateMachine(input)
+	renv.Log().ExtraVerbosef("sfn.CreateStateMachine call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -5783,14 +6861,14 @@ func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create subscription: AWS command returned nil output")
+			renv.Log().Warning("create statemachine: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create subscription '%s' done", extracted)
+		renv.Log().Verbosef("create statemachine '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create subscription done")
+		renv.Log().Verbose("create statemachine done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -5802,14 +6880,266 @@ func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *CreateSubscription) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("subscription"), nil
+func (cmd *CreateStatemachine) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("statemachine"), nil
+}
+
+func (cmd *CreateStatemachine) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateSubnet(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubnet {
+	cmd := new(CreateSubnet)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateSubnet) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateSubnet) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateSubnet) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateSubnetInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateSubnet(input)
+	renv.Log().ExtraVerbosef("ec2.CreateSubnet call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create subnet: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create subnet '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create subnet done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateSubnet) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateSubnetInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateSubnetInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateSubnet(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateSubnet call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create subnet ok")
+			return fakeDryRunId("subnet"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateSubnet) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateSubscription(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateSubscription {
+	cmd := new(CreateSubscription)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sns.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateSubscription) SetApi(api snsiface.SNSAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateSubscription) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateSubscription) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &sns.SubscribeInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in sns.SubscribeInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.Subscribe(input)
+	renv.Log().ExtraVerbosef("sns.Subscribe call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create subscription: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create subscription '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create subscription done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateSubscription) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("subscription"), nil
 }
 
 func (cmd *CreateSubscription) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewCreateTable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTable {
+	cmd := new(CreateTable)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = dynamodb.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateTable) SetApi(api dynamodbiface.DynamoDBAPI) {
+	cmd.api = api
+}
+
+func (cmd *CreateTable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateTable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create table: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create table '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create table done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateTable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("table"), nil
+}
+
+func (cmd *CreateTable) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewCreateTag(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateTag {
 	cmd := new(CreateTag)
 	if len(l) > 0 {
@@ -6316,32 +7646,32 @@ func (cmd *CreateVpc) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewCreateZone(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateZone {
-	cmd := new(CreateZone)
+func NewCreateVpcendpoint(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVpcendpoint {
+	cmd := new(CreateVpcendpoint)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *CreateZone) SetApi(api route53iface.Route53API) {
+func (cmd *CreateVpcendpoint) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *CreateZone) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateVpcendpoint) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *CreateVpcendpoint) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6352,13 +7682,13 @@ func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (int
 		}
 	}
 
-	input := &route53.CreateHostedZoneInput{}
+	input := &ec2.CreateVpcEndpointInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in route53.CreateHostedZoneInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcEndpointInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.CreateHostedZone(input)
-	renv.Log().ExtraVerbosef("route53.CreateHostedZone call took %s", time.Since(start))
+	output, err := cmd.api.CreateVpcEndpoint(input)
+	renv.Log().ExtraVerbosef("ec2.CreateVpcEndpoint call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6368,14 +7698,14 @@ func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (int
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("create zone: AWS command returned nil output")
+			renv.Log().Warning("create vpcendpoint: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("create zone '%s' done", extracted)
+		renv.Log().Verbosef("create vpcendpoint '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("create zone done")
+		renv.Log().Verbose("create vpcendpoint done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6387,9 +7717,209 @@ func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *CreateZone) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("zone"), nil
-}
+func (cmd *CreateVpcendpoint) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateVpcEndpointInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcEndpointInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateVpcEndpoint(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateVpcEndpoint call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create vpcendpoint ok")
+			return fakeDryRunId("vpcendpoint"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateVpcendpoint) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateVpcpeering(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateVpcpeering {
+	cmd := new(CreateVpcpeering)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateVpcpeering) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *CreateVpcpeering) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateVpcpeering) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.CreateVpcPeeringConnectionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcPeeringConnectionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateVpcPeeringConnection(input)
+	renv.Log().ExtraVerbosef("ec2.CreateVpcPeeringConnection call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create vpcpeering: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create vpcpeering '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create vpcpeering done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateVpcpeering) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.CreateVpcPeeringConnectionInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.CreateVpcPeeringConnectionInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.CreateVpcPeeringConnection(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.CreateVpcPeeringConnection call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: create vpcpeering ok")
+			return fakeDryRunId("vpcpeering"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *CreateVpcpeering) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewCreateZone(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *CreateZone {
+	cmd := new(CreateZone)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = route53.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *CreateZone) SetApi(api route53iface.Route53API) {
+	cmd.api = api
+}
+
+func (cmd *CreateZone) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *CreateZone) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &route53.CreateHostedZoneInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in route53.CreateHostedZoneInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateHostedZone(input)
+	renv.Log().ExtraVerbosef("route53.CreateHostedZone call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("create zone: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("create zone '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("create zone done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *CreateZone) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("zone"), nil
+}
 
 func (cmd *CreateZone) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
@@ -6711,32 +8241,32 @@ func (cmd *DeleteAppscalingtarget) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteBucket {
-	cmd := new(DeleteBucket)
+func NewDeleteBackup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteBackup {
+	cmd := new(DeleteBackup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = s3.New(sess)
+		cmd.api = dynamodb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteBucket) SetApi(api s3iface.S3API) {
+func (cmd *DeleteBackup) SetApi(api dynamodbiface.DynamoDBAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteBackup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteBackup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6747,13 +8277,13 @@ func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &s3.DeleteBucketInput{}
+	input := &dynamodb.DeleteBackupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in s3.DeleteBucketInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in dynamodb.DeleteBackupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteBucket(input)
-	renv.Log().ExtraVerbosef("s3.DeleteBucket call took %s", time.Since(start))
+	output, err := cmd.api.DeleteBackup(input)
+	renv.Log().ExtraVerbosef("dynamodb.DeleteBackup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6763,14 +8293,14 @@ func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete bucket: AWS command returned nil output")
+			renv.Log().Warning("delete backup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete bucket '%s' done", extracted)
+		renv.Log().Verbosef("delete backup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete bucket done")
+		renv.Log().Verbose("delete backup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6782,40 +8312,40 @@ func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeleteBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("bucket"), nil
+func (cmd *DeleteBackup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("backup"), nil
 }
 
-func (cmd *DeleteBucket) inject(params map[string]interface{}) error {
+func (cmd *DeleteBackup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCertificate {
-	cmd := new(DeleteCertificate)
+func NewDeleteBucket(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteBucket {
+	cmd := new(DeleteBucket)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = acm.New(sess)
+		cmd.api = s3.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteCertificate) SetApi(api acmiface.ACMAPI) {
+func (cmd *DeleteBucket) SetApi(api s3iface.S3API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteBucket) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteBucket) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6826,13 +8356,13 @@ func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{
 		}
 	}
 
-	input := &acm.DeleteCertificateInput{}
+	input := &s3.DeleteBucketInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in acm.DeleteCertificateInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in s3.DeleteBucketInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteCertificate(input)
-	renv.Log().ExtraVerbosef("acm.DeleteCertificate call took %s", time.Since(start))
+	output, err := cmd.api.DeleteBucket(input)
+	renv.Log().ExtraVerbosef("s3.DeleteBucket call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6842,14 +8372,14 @@ func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete certificate: AWS command returned nil output")
+			renv.Log().Warning("delete bucket: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete certificate '%s' done", extracted)
+		renv.Log().Verbosef("delete bucket '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete certificate done")
+		renv.Log().Verbose("delete bucket done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6861,40 +8391,40 @@ func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{
 	return extracted, nil
 }
 
-func (cmd *DeleteCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("certificate"), nil
+func (cmd *DeleteBucket) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("bucket"), nil
 }
 
-func (cmd *DeleteCertificate) inject(params map[string]interface{}) error {
+func (cmd *DeleteBucket) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteClassicLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteClassicLoadbalancer {
-	cmd := new(DeleteClassicLoadbalancer)
+func NewDeleteCachecluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCachecluster {
+	cmd := new(DeleteCachecluster)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elb.New(sess)
+		cmd.api = elasticache.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteClassicLoadbalancer) SetApi(api elbiface.ELBAPI) {
+func (cmd *DeleteCachecluster) SetApi(api elasticacheiface.ElastiCacheAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteClassicLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteCachecluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteClassicLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteCachecluster) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6905,13 +8435,13 @@ func (cmd *DeleteClassicLoadbalancer) run(renv env.Running, params map[string]in
 		}
 	}
 
-	input := &elb.DeleteLoadBalancerInput{}
+	input := &elasticache.DeleteCacheClusterInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elb.DeleteLoadBalancerInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elasticache.DeleteCacheClusterInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteLoadBalancer(input)
-	renv.Log().ExtraVerbosef("elb.DeleteLoadBalancer call took %s", time.Since(start))
+	output, err := cmd.api.DeleteCacheCluster(input)
+	renv.Log().ExtraVerbosef("elasticache.DeleteCacheCluster call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -6921,14 +8451,14 @@ func (cmd *DeleteClassicLoadbalancer) run(renv env.Running, params map[string]in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete classicloadbalancer: AWS command returned nil output")
+			renv.Log().Warning("delete cachecluster: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete classicloadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("delete cachecluster '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete classicloadbalancer done")
+		renv.Log().Verbose("delete cachecluster done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -6940,40 +8470,40 @@ func (cmd *DeleteClassicLoadbalancer) run(renv env.Running, params map[string]in
 	return extracted, nil
 }
 
-func (cmd *DeleteClassicLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("classicloadbalancer"), nil
+func (cmd *DeleteCachecluster) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cachecluster"), nil
 }
 
-func (cmd *DeleteClassicLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *DeleteCachecluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteContainercluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainercluster {
-	cmd := new(DeleteContainercluster)
+func NewDeleteCachesubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCachesubnetgroup {
+	cmd := new(DeleteCachesubnetgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = elasticache.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteContainercluster) SetApi(api ecsiface.ECSAPI) {
+func (cmd *DeleteCachesubnetgroup) SetApi(api elasticacheiface.ElastiCacheAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteContainercluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteCachesubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteCachesubnetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -6984,13 +8514,13 @@ func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &ecs.DeleteClusterInput{}
+	input := &elasticache.DeleteCacheSubnetGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecs.DeleteClusterInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elasticache.DeleteCacheSubnetGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteCluster(input)
-	renv.Log().ExtraVerbosef("ecs.DeleteCluster call took %s", time.Since(start))
+	output, err := cmd.api.DeleteCacheSubnetGroup(input)
+	renv.Log().ExtraVerbosef("elasticache.DeleteCacheSubnetGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7000,14 +8530,14 @@ func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete containercluster: AWS command returned nil output")
+			renv.Log().Warning("delete cachesubnetgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete containercluster '%s' done", extracted)
+		renv.Log().Verbosef("delete cachesubnetgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete containercluster done")
+		renv.Log().Verbose("delete cachesubnetgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7019,40 +8549,40 @@ func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]inter
 	return extracted, nil
 }
 
-func (cmd *DeleteContainercluster) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("containercluster"), nil
+func (cmd *DeleteCachesubnetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("cachesubnetgroup"), nil
 }
 
-func (cmd *DeleteContainercluster) inject(params map[string]interface{}) error {
+func (cmd *DeleteCachesubnetgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainertask {
-	cmd := new(DeleteContainertask)
+func NewDeleteCertificate(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteCertificate {
+	cmd := new(DeleteCertificate)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecs.New(sess)
+		cmd.api = acm.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteContainertask) SetApi(api ecsiface.ECSAPI) {
+func (cmd *DeleteCertificate) SetApi(api acmiface.ACMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteCertificate) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteCertificate) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7063,7 +8593,13 @@ func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &acm.DeleteCertificateInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in acm.DeleteCertificateInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteCertificate(input)
+	renv.Log().ExtraVerbosef("acm.DeleteCertificate call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7073,14 +8609,14 @@ func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete containertask: AWS command returned nil output")
+			renv.Log().Warning("delete certificate: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete containertask '%s' done", extracted)
+		renv.Log().Verbosef("delete certificate '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete containertask done")
+		renv.Log().Verbose("delete certificate done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7092,36 +8628,40 @@ func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DeleteContainertask) inject(params map[string]interface{}) error {
+func (cmd *DeleteCertificate) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("certificate"), nil
+}
+
+func (cmd *DeleteCertificate) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDatabase {
-	cmd := new(DeleteDatabase)
+func NewDeleteClassicLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteClassicLoadbalancer {
+	cmd := new(DeleteClassicLoadbalancer)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = elb.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteDatabase) SetApi(api rdsiface.RDSAPI) {
+func (cmd *DeleteClassicLoadbalancer) SetApi(api elbiface.ELBAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteClassicLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteClassicLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7132,13 +8672,13 @@ func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &rds.DeleteDBInstanceInput{}
+	input := &elb.DeleteLoadBalancerInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.DeleteDBInstanceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elb.DeleteLoadBalancerInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteDBInstance(input)
-	renv.Log().ExtraVerbosef("rds.DeleteDBInstance call took %s", time.Since(start))
+	output, err := cmd.api.DeleteLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elb.DeleteLoadBalancer call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7148,14 +8688,14 @@ func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete database: AWS command returned nil output")
+			renv.Log().Warning("delete classicloadbalancer: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete database '%s' done", extracted)
+		renv.Log().Verbosef("delete classicloadbalancer '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete database done")
+		renv.Log().Verbose("delete classicloadbalancer done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7167,40 +8707,40 @@ func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("database"), nil
+func (cmd *DeleteClassicLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("classicloadbalancer"), nil
 }
 
-func (cmd *DeleteDatabase) inject(params map[string]interface{}) error {
+func (cmd *DeleteClassicLoadbalancer) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteDbsubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDbsubnetgroup {
-	cmd := new(DeleteDbsubnetgroup)
+func NewDeleteContainercluster(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainercluster {
+	cmd := new(DeleteContainercluster)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = rds.New(sess)
+		cmd.api = ecs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteDbsubnetgroup) SetApi(api rdsiface.RDSAPI) {
+func (cmd *DeleteContainercluster) SetApi(api ecsiface.ECSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteDbsubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteContainercluster) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteContainercluster) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7211,13 +8751,13 @@ func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interfac
 		}
 	}
 
-	input := &rds.DeleteDBSubnetGroupInput{}
+	input := &ecs.DeleteClusterInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in rds.DeleteDBSubnetGroupInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ecs.DeleteClusterInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteDBSubnetGroup(input)
-	renv.Log().ExtraVerbosef("rds.DeleteDBSubnetGroup call took %s", time.Since(start))
+	output, err := cmd.api.DeleteCluster(input)
+	renv.Log().ExtraVerbosef("ecs.DeleteCluster call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7227,14 +8767,14 @@ func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interfac
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete dbsubnetgroup: AWS command returned nil output")
+			renv.Log().Warning("delete containercluster: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete dbsubnetgroup '%s' done", extracted)
+		renv.Log().Verbosef("delete containercluster '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete dbsubnetgroup done")
+		renv.Log().Verbose("delete containercluster done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7246,40 +8786,40 @@ func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interfac
 	return extracted, nil
 }
 
-func (cmd *DeleteDbsubnetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("dbsubnetgroup"), nil
+func (cmd *DeleteContainercluster) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("containercluster"), nil
 }
 
-func (cmd *DeleteDbsubnetgroup) inject(params map[string]interface{}) error {
+func (cmd *DeleteContainercluster) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDistribution {
-	cmd := new(DeleteDistribution)
+func NewDeleteContainertask(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteContainertask {
+	cmd := new(DeleteContainertask)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudfront.New(sess)
+		cmd.api = ecs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
+func (cmd *DeleteContainertask) SetApi(api ecsiface.ECSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteContainertask) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteContainertask) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7300,14 +8840,14 @@ func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete distribution: AWS command returned nil output")
+			renv.Log().Warning("delete containertask: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete distribution '%s' done", extracted)
+		renv.Log().Verbosef("delete containertask '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete distribution done")
+		renv.Log().Verbose("delete containertask done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7319,40 +8859,36 @@ func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("distribution"), nil
-}
-
-func (cmd *DeleteDistribution) inject(params map[string]interface{}) error {
+func (cmd *DeleteContainertask) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteElasticip {
-	cmd := new(DeleteElasticip)
+func NewDeleteDatabase(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDatabase {
+	cmd := new(DeleteDatabase)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteElasticip) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteDatabase) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDatabase) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDatabase) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7363,13 +8899,13 @@ func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.ReleaseAddressInput{}
+	input := &rds.DeleteDBInstanceInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in rds.DeleteDBInstanceInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.ReleaseAddress(input)
-	renv.Log().ExtraVerbosef("ec2.ReleaseAddress call took %s", time.Since(start))
+	output, err := cmd.api.DeleteDBInstance(input)
+	renv.Log().ExtraVerbosef("rds.DeleteDBInstance call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7379,14 +8915,14 @@ func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete elasticip: AWS command returned nil output")
+			renv.Log().Warning("delete database: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete elasticip '%s' done", extracted)
+		renv.Log().Verbosef("delete database '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete elasticip done")
+		renv.Log().Verbose("delete database done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7398,61 +8934,40 @@ func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.ReleaseAddressInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.ReleaseAddress(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.ReleaseAddress call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete elasticip ok")
-			return fakeDryRunId("elasticip"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *DeleteDatabase) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("database"), nil
 }
 
-func (cmd *DeleteElasticip) inject(params map[string]interface{}) error {
+func (cmd *DeleteDatabase) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteFunction {
-	cmd := new(DeleteFunction)
+func NewDeleteDbsubnetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDbsubnetgroup {
+	cmd := new(DeleteDbsubnetgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = lambda.New(sess)
+		cmd.api = rds.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteFunction) SetApi(api lambdaiface.LambdaAPI) {
+func (cmd *DeleteDbsubnetgroup) SetApi(api rdsiface.RDSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDbsubnetgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDbsubnetgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7463,13 +8978,13 @@ func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &lambda.DeleteFunctionInput{}
+	input := &rds.DeleteDBSubnetGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in lambda.DeleteFunctionInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in rds.DeleteDBSubnetGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteFunction(input)
-	renv.Log().ExtraVerbosef("lambda.DeleteFunction call took %s", time.Since(start))
+	output, err := cmd.api.DeleteDBSubnetGroup(input)
+	renv.Log().ExtraVerbosef("rds.DeleteDBSubnetGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7479,14 +8994,14 @@ func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete function: AWS command returned nil output")
+			renv.Log().Warning("delete dbsubnetgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete function '%s' done", extracted)
+		renv.Log().Verbosef("delete dbsubnetgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete function done")
+		renv.Log().Verbose("delete dbsubnetgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7498,40 +9013,40 @@ func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteFunction) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("function"), nil
+func (cmd *DeleteDbsubnetgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("dbsubnetgroup"), nil
 }
 
-func (cmd *DeleteFunction) inject(params map[string]interface{}) error {
+func (cmd *DeleteDbsubnetgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteGroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteGroup {
-	cmd := new(DeleteGroup)
+func NewDeleteDistribution(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteDistribution {
+	cmd := new(DeleteDistribution)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = cloudfront.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteGroup) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteDistribution) SetApi(api cloudfrontiface.CloudFrontAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteGroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDistribution) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteDistribution) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7542,13 +9057,7 @@ func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &iam.DeleteGroupInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteGroupInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeleteGroup(input)
-	renv.Log().ExtraVerbosef("iam.DeleteGroup call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7558,14 +9067,14 @@ func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete group: AWS command returned nil output")
+			renv.Log().Warning("delete distribution: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete group '%s' done", extracted)
+		renv.Log().Verbosef("delete distribution '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete group done")
+		renv.Log().Verbose("delete distribution done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7577,16 +9086,16 @@ func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteGroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("group"), nil
+func (cmd *DeleteDistribution) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("distribution"), nil
 }
 
-func (cmd *DeleteGroup) inject(params map[string]interface{}) error {
+func (cmd *DeleteDistribution) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteImage {
-	cmd := new(DeleteImage)
+func NewDeleteElasticip(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteElasticip {
+	cmd := new(DeleteElasticip)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -7599,18 +9108,18 @@ func NewDeleteImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger
 	return cmd
 }
 
-func (cmd *DeleteImage) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteElasticip) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteElasticip) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteElasticip) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7621,7 +9130,13 @@ func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ec2.ReleaseAddressInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.ReleaseAddress(input)
+	renv.Log().ExtraVerbosef("ec2.ReleaseAddress call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7631,14 +9146,778 @@ func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete image: AWS command returned nil output")
+			renv.Log().Warning("delete elasticip: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete elasticip '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete elasticip done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteElasticip) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.ReleaseAddressInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.ReleaseAddressInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.ReleaseAddress(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.ReleaseAddress call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete elasticip ok")
+			return fakeDryRunId("elasticip"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteElasticip) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteFilesystem(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteFilesystem {
+	cmd := new(DeleteFilesystem)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = efs.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteFilesystem) SetApi(api efsiface.EFSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteFilesystem) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteFilesystem) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &efs.DeleteFileSystemInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in efs.DeleteFileSystemInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteFileSystem(input)
+	renv.Log().ExtraVerbosef("efs.DeleteFileSystem call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete filesystem: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete filesystem '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete filesystem done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteFilesystem) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("filesystem"), nil
+}
+
+func (cmd *DeleteFilesystem) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteFunction(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteFunction {
+	cmd := new(DeleteFunction)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = lambda.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteFunction) SetApi(api lambdaiface.LambdaAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteFunction) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteFunction) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &lambda.DeleteFunctionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in lambda.DeleteFunctionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteFunction(input)
+	renv.Log().ExtraVerbosef("lambda.DeleteFunction call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete function: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete function '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete function done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteFunction) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("function"), nil
+}
+
+func (cmd *DeleteFunction) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteGroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteGroup {
+	cmd := new(DeleteGroup)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteGroup) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteGroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteGroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeleteGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeleteGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteGroup(input)
+	renv.Log().ExtraVerbosef("iam.DeleteGroup call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete group: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete group '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete group done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteGroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("group"), nil
+}
+
+func (cmd *DeleteGroup) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteHealthcheck(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteHealthcheck {
+	cmd := new(DeleteHealthcheck)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = route53.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteHealthcheck) SetApi(api route53iface.Route53API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteHealthcheck) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteHealthcheck) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &route53.DeleteHealthCheckInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in route53.DeleteHealthCheckInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteHealthCheck(input)
+	renv.Log().ExtraVerbosef("route53.DeleteHealthCheck call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete healthcheck: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete healthcheck '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete healthcheck done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteHealthcheck) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("healthcheck"), nil
+}
+
+func (cmd *DeleteHealthcheck) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteImage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteImage {
+	cmd := new(DeleteImage)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteImage) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteImage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete image: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
 		renv.Log().Verbosef("delete image '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete image done")
+		renv.Log().Verbose("delete image done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteImage) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstance {
+	cmd := new(DeleteInstance)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteInstance) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.TerminateInstancesInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.TerminateInstances(input)
+	renv.Log().ExtraVerbosef("ec2.TerminateInstances call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete instance: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete instance '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete instance done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.TerminateInstancesInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.TerminateInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.TerminateInstances call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete instance ok")
+			return fakeDryRunId("instance"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteInstance) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstanceprofile {
+	cmd := new(DeleteInstanceprofile)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = iam.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteInstanceprofile) SetApi(api iamiface.IAMAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &iam.DeleteInstanceProfileInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in iam.DeleteInstanceProfileInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteInstanceProfile(input)
+	renv.Log().ExtraVerbosef("iam.DeleteInstanceProfile call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete instanceprofile: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete instanceprofile '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete instanceprofile done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("instanceprofile"), nil
+}
+
+func (cmd *DeleteInstanceprofile) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInternetgateway {
+	cmd := new(DeleteInternetgateway)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteInternetgateway) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteInternetGatewayInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteInternetGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteInternetGateway call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete internetgateway: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete internetgateway '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete internetgateway done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteInternetGatewayInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteInternetGateway(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteInternetGateway call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete internetgateway ok")
+			return fakeDryRunId("internetgateway"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteInternetgateway) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteKey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteKey {
+	cmd := new(DeleteKey)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = kms.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteKey) SetApi(api kmsiface.KMSAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteKey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteKey) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &kms.ScheduleKeyDeletionInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in kms.ScheduleKeyDeletionInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.ScheduleKeyDeletion(input)
+	renv.Log().ExtraVerbosef("kms.ScheduleKeyDeletion call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete key: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete key '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete key done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7650,12 +9929,16 @@ func (cmd *DeleteImage) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteImage) inject(params map[string]interface{}) error {
+func (cmd *DeleteKey) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("key"), nil
+}
+
+func (cmd *DeleteKey) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstance {
-	cmd := new(DeleteInstance)
+func NewDeleteKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteKeypair {
+	cmd := new(DeleteKeypair)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -7668,18 +9951,18 @@ func NewDeleteInstance(sess *session.Session, g cloud.GraphAPI, l ...*logger.Log
 	return cmd
 }
 
-func (cmd *DeleteInstance) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteKeypair) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteInstance) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteKeypair) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7690,13 +9973,13 @@ func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &ec2.TerminateInstancesInput{}
+	input := &ec2.DeleteKeyPairInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.TerminateInstances(input)
-	renv.Log().ExtraVerbosef("ec2.TerminateInstances call took %s", time.Since(start))
+	output, err := cmd.api.DeleteKeyPair(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteKeyPair call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7706,14 +9989,14 @@ func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete instance: AWS command returned nil output")
+			renv.Log().Warning("delete keypair: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete instance '%s' done", extracted)
+		renv.Log().Verbosef("delete keypair '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete instance done")
+		renv.Log().Verbose("delete keypair done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7725,61 +10008,61 @@ func (cmd *DeleteInstance) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteInstance) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteKeypair) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.TerminateInstancesInput{}
+	input := &ec2.DeleteKeyPairInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.TerminateInstancesInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.TerminateInstances(input)
+	_, err := cmd.api.DeleteKeyPair(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.TerminateInstances call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete instance ok")
-			return fakeDryRunId("instance"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteKeyPair call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete keypair ok")
+			return fakeDryRunId("keypair"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DeleteInstance) inject(params map[string]interface{}) error {
+func (cmd *DeleteKeypair) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteInstanceprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInstanceprofile {
-	cmd := new(DeleteInstanceprofile)
+func NewDeleteLaunchconfiguration(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLaunchconfiguration {
+	cmd := new(DeleteLaunchconfiguration)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = autoscaling.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteInstanceprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteLaunchconfiguration) SetApi(api autoscalingiface.AutoScalingAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteInstanceprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteLaunchconfiguration) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7790,13 +10073,13 @@ func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &iam.DeleteInstanceProfileInput{}
+	input := &autoscaling.DeleteLaunchConfigurationInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteInstanceProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in autoscaling.DeleteLaunchConfigurationInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteInstanceProfile(input)
-	renv.Log().ExtraVerbosef("iam.DeleteInstanceProfile call took %s", time.Since(start))
+	output, err := cmd.api.DeleteLaunchConfiguration(input)
+	renv.Log().ExtraVerbosef("autoscaling.DeleteLaunchConfiguration call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7806,14 +10089,14 @@ func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete instanceprofile: AWS command returned nil output")
+			renv.Log().Warning("delete launchconfiguration: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete instanceprofile '%s' done", extracted)
+		renv.Log().Verbosef("delete launchconfiguration '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete instanceprofile done")
+		renv.Log().Verbose("delete launchconfiguration done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7825,40 +10108,40 @@ func (cmd *DeleteInstanceprofile) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *DeleteInstanceprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("instanceprofile"), nil
+func (cmd *DeleteLaunchconfiguration) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("launchconfiguration"), nil
 }
 
-func (cmd *DeleteInstanceprofile) inject(params map[string]interface{}) error {
+func (cmd *DeleteLaunchconfiguration) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteInternetgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteInternetgateway {
-	cmd := new(DeleteInternetgateway)
+func NewDeleteListener(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteListener {
+	cmd := new(DeleteListener)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = elbv2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteInternetgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteListener) SetApi(api elbv2iface.ELBV2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteInternetgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7869,13 +10152,13 @@ func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interf
 		}
 	}
 
-	input := &ec2.DeleteInternetGatewayInput{}
+	input := &elbv2.DeleteListenerInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elbv2.DeleteListenerInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteInternetGateway(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteInternetGateway call took %s", time.Since(start))
+	output, err := cmd.api.DeleteListener(input)
+	renv.Log().ExtraVerbosef("elbv2.DeleteListener call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7885,14 +10168,14 @@ func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interf
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete internetgateway: AWS command returned nil output")
+			renv.Log().Warning("delete listener: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete internetgateway '%s' done", extracted)
+		renv.Log().Verbosef("delete listener '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete internetgateway done")
+		renv.Log().Verbose("delete listener done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -7904,61 +10187,119 @@ func (cmd *DeleteInternetgateway) run(renv env.Running, params map[string]interf
 	return extracted, nil
 }
 
-func (cmd *DeleteInternetgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteListener) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("listener"), nil
+}
+
+func (cmd *DeleteListener) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoadbalancer {
+	cmd := new(DeleteLoadbalancer)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = elbv2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DeleteInternetGatewayInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteInternetGatewayInput: %s", err)
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
 	}
 
+	input := &elbv2.DeleteLoadBalancerInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elbv2.DeleteLoadBalancerInput: %s", err)
+	}
 	start := time.Now()
-	_, err := cmd.api.DeleteInternetGateway(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteInternetGateway call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete internetgateway ok")
-			return fakeDryRunId("internetgateway"), nil
+	output, err := cmd.api.DeleteLoadBalancer(input)
+	renv.Log().ExtraVerbosef("elbv2.DeleteLoadBalancer call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete loadbalancer: AWS command returned nil output")
 		}
 	}
 
-	return nil, err
+	if extracted != nil {
+		renv.Log().Verbosef("delete loadbalancer '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete loadbalancer done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
 }
 
-func (cmd *DeleteInternetgateway) inject(params map[string]interface{}) error {
+func (cmd *DeleteLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loadbalancer"), nil
+}
+
+func (cmd *DeleteLoadbalancer) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteKeypair(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteKeypair {
-	cmd := new(DeleteKeypair)
+func NewDeleteLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoginprofile {
+	cmd := new(DeleteLoginprofile)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteKeypair) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteLoginprofile) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteKeypair) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -7969,13 +10310,13 @@ func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (
 		}
 	}
 
-	input := &ec2.DeleteKeyPairInput{}
+	input := &iam.DeleteLoginProfileInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.DeleteLoginProfileInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteKeyPair(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteKeyPair call took %s", time.Since(start))
+	output, err := cmd.api.DeleteLoginProfile(input)
+	renv.Log().ExtraVerbosef("iam.DeleteLoginProfile call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -7985,14 +10326,14 @@ func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete keypair: AWS command returned nil output")
+			renv.Log().Warning("delete loginprofile: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete keypair '%s' done", extracted)
+		renv.Log().Verbosef("delete loginprofile '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete keypair done")
+		renv.Log().Verbose("delete loginprofile done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8004,61 +10345,40 @@ func (cmd *DeleteKeypair) run(renv env.Running, params map[string]interface{}) (
 	return extracted, nil
 }
 
-func (cmd *DeleteKeypair) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteKeyPairInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteKeyPairInput: %s", err)
-	}
-
-	start := time.Now()
-	_, err := cmd.api.DeleteKeyPair(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteKeyPair call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete keypair ok")
-			return fakeDryRunId("keypair"), nil
-		}
-	}
-
-	return nil, err
+func (cmd *DeleteLoginprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("loginprofile"), nil
 }
 
-func (cmd *DeleteKeypair) inject(params map[string]interface{}) error {
+func (cmd *DeleteLoginprofile) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteLaunchconfiguration(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLaunchconfiguration {
-	cmd := new(DeleteLaunchconfiguration)
+func NewDeleteMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteMfadevice {
+	cmd := new(DeleteMfadevice)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = autoscaling.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteLaunchconfiguration) SetApi(api autoscalingiface.AutoScalingAPI) {
+func (cmd *DeleteMfadevice) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteLaunchconfiguration) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8069,13 +10389,13 @@ func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]in
 		}
 	}
 
-	input := &autoscaling.DeleteLaunchConfigurationInput{}
+	input := &iam.DeleteVirtualMFADeviceInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in autoscaling.DeleteLaunchConfigurationInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.DeleteVirtualMFADeviceInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteLaunchConfiguration(input)
-	renv.Log().ExtraVerbosef("autoscaling.DeleteLaunchConfiguration call took %s", time.Since(start))
+	output, err := cmd.api.DeleteVirtualMFADevice(input)
+	renv.Log().ExtraVerbosef("iam.DeleteVirtualMFADevice call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8085,14 +10405,14 @@ func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete launchconfiguration: AWS command returned nil output")
+			renv.Log().Warning("delete mfadevice: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete launchconfiguration '%s' done", extracted)
+		renv.Log().Verbosef("delete mfadevice '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete launchconfiguration done")
+		renv.Log().Verbose("delete mfadevice done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8104,40 +10424,40 @@ func (cmd *DeleteLaunchconfiguration) run(renv env.Running, params map[string]in
 	return extracted, nil
 }
 
-func (cmd *DeleteLaunchconfiguration) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("launchconfiguration"), nil
+func (cmd *DeleteMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("mfadevice"), nil
 }
 
-func (cmd *DeleteLaunchconfiguration) inject(params map[string]interface{}) error {
+func (cmd *DeleteMfadevice) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteListener(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteListener {
-	cmd := new(DeleteListener)
+func NewDeleteMounttarget(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteMounttarget {
+	cmd := new(DeleteMounttarget)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = efs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteListener) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *DeleteMounttarget) SetApi(api efsiface.EFSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteListener) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteMounttarget) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteMounttarget) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8148,13 +10468,13 @@ func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &elbv2.DeleteListenerInput{}
+	input := &efs.DeleteMountTargetInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.DeleteListenerInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in efs.DeleteMountTargetInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteListener(input)
-	renv.Log().ExtraVerbosef("elbv2.DeleteListener call took %s", time.Since(start))
+	output, err := cmd.api.DeleteMountTarget(input)
+	renv.Log().ExtraVerbosef("efs.DeleteMountTarget call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8164,14 +10484,14 @@ func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete listener: AWS command returned nil output")
+			renv.Log().Warning("delete mounttarget: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete listener '%s' done", extracted)
+		renv.Log().Verbosef("delete mounttarget '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete listener done")
+		renv.Log().Verbose("delete mounttarget done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8183,40 +10503,40 @@ func (cmd *DeleteListener) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteListener) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("listener"), nil
+func (cmd *DeleteMounttarget) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("mounttarget"), nil
 }
 
-func (cmd *DeleteListener) inject(params map[string]interface{}) error {
+func (cmd *DeleteMounttarget) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteLoadbalancer(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoadbalancer {
-	cmd := new(DeleteLoadbalancer)
+func NewDeleteNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNatgateway {
+	cmd := new(DeleteNatgateway)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = elbv2.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteLoadbalancer) SetApi(api elbv2iface.ELBV2API) {
+func (cmd *DeleteNatgateway) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteLoadbalancer) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8227,13 +10547,13 @@ func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &elbv2.DeleteLoadBalancerInput{}
+	input := &ec2.DeleteNatGatewayInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in elbv2.DeleteLoadBalancerInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteNatGatewayInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteLoadBalancer(input)
-	renv.Log().ExtraVerbosef("elbv2.DeleteLoadBalancer call took %s", time.Since(start))
+	output, err := cmd.api.DeleteNatGateway(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteNatGateway call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8243,14 +10563,14 @@ func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete loadbalancer: AWS command returned nil output")
+			renv.Log().Warning("delete natgateway: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete loadbalancer '%s' done", extracted)
+		renv.Log().Verbosef("delete natgateway '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete loadbalancer done")
+		renv.Log().Verbose("delete natgateway done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8262,40 +10582,40 @@ func (cmd *DeleteLoadbalancer) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteLoadbalancer) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loadbalancer"), nil
+func (cmd *DeleteNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("natgateway"), nil
 }
 
-func (cmd *DeleteLoadbalancer) inject(params map[string]interface{}) error {
+func (cmd *DeleteNatgateway) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteLoginprofile {
-	cmd := new(DeleteLoginprofile)
+func NewDeleteNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNetworkinterface {
+	cmd := new(DeleteNetworkinterface)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ec2.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteLoginprofile) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteNetworkinterface) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteLoginprofile) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8306,13 +10626,13 @@ func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface
 		}
 	}
 
-	input := &iam.DeleteLoginProfileInput{}
+	input := &ec2.DeleteNetworkInterfaceInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteLoginProfileInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteLoginProfile(input)
-	renv.Log().ExtraVerbosef("iam.DeleteLoginProfile call took %s", time.Since(start))
+	output, err := cmd.api.DeleteNetworkInterface(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteNetworkInterface call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8322,14 +10642,14 @@ func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete loginprofile: AWS command returned nil output")
+			renv.Log().Warning("delete networkinterface: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete loginprofile '%s' done", extracted)
+		renv.Log().Verbosef("delete networkinterface '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete loginprofile done")
+		renv.Log().Verbose("delete networkinterface done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8341,40 +10661,61 @@ func (cmd *DeleteLoginprofile) run(renv env.Running, params map[string]interface
 	return extracted, nil
 }
 
-func (cmd *DeleteLoginprofile) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("loginprofile"), nil
+func (cmd *DeleteNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteNetworkInterfaceInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteNetworkInterface(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteNetworkInterface call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete networkinterface ok")
+			return fakeDryRunId("networkinterface"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteLoginprofile) inject(params map[string]interface{}) error {
+func (cmd *DeleteNetworkinterface) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteMfadevice(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteMfadevice {
-	cmd := new(DeleteMfadevice)
+func NewDeleteParameter(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteParameter {
+	cmd := new(DeleteParameter)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = ssm.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteMfadevice) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteParameter) SetApi(api ssmiface.SSMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteMfadevice) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteParameter) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteParameter) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8385,13 +10726,13 @@ func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{})
 		}
 	}
 
-	input := &iam.DeleteVirtualMFADeviceInput{}
+	input := &ssm.DeleteParameterInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeleteVirtualMFADeviceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ssm.DeleteParameterInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteVirtualMFADevice(input)
-	renv.Log().ExtraVerbosef("iam.DeleteVirtualMFADevice call took %s", time.Since(start))
+	output, err := cmd.api.DeleteParameter(input)
+	renv.Log().ExtraVerbosef("ssm.DeleteParameter call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8401,14 +10742,14 @@ func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{})
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete mfadevice: AWS command returned nil output")
+			renv.Log().Warning("delete parameter: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete mfadevice '%s' done", extracted)
+		renv.Log().Verbosef("delete parameter '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete mfadevice done")
+		renv.Log().Verbose("delete parameter done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8420,40 +10761,40 @@ func (cmd *DeleteMfadevice) run(renv env.Running, params map[string]interface{})
 	return extracted, nil
 }
 
-func (cmd *DeleteMfadevice) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("mfadevice"), nil
+func (cmd *DeleteParameter) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("parameter"), nil
 }
 
-func (cmd *DeleteMfadevice) inject(params map[string]interface{}) error {
+func (cmd *DeleteParameter) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteNatgateway(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNatgateway {
-	cmd := new(DeleteNatgateway)
+func NewDeletePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeletePolicy {
+	cmd := new(DeletePolicy)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = iam.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteNatgateway) SetApi(api ec2iface.EC2API) {
+func (cmd *DeletePolicy) SetApi(api iamiface.IAMAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteNatgateway) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeletePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8464,13 +10805,13 @@ func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ec2.DeleteNatGatewayInput{}
+	input := &iam.DeletePolicyInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteNatGatewayInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in iam.DeletePolicyInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteNatGateway(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteNatGateway call took %s", time.Since(start))
+	output, err := cmd.api.DeletePolicy(input)
+	renv.Log().ExtraVerbosef("iam.DeletePolicy call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8480,14 +10821,14 @@ func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete natgateway: AWS command returned nil output")
+			renv.Log().Warning("delete policy: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete natgateway '%s' done", extracted)
+		renv.Log().Verbosef("delete policy '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete natgateway done")
+		renv.Log().Verbose("delete policy done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8499,40 +10840,40 @@ func (cmd *DeleteNatgateway) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *DeleteNatgateway) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("natgateway"), nil
+func (cmd *DeletePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("policy"), nil
 }
 
-func (cmd *DeleteNatgateway) inject(params map[string]interface{}) error {
+func (cmd *DeletePolicy) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteNetworkinterface(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteNetworkinterface {
-	cmd := new(DeleteNetworkinterface)
+func NewDeleteQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteQueue {
+	cmd := new(DeleteQueue)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ec2.New(sess)
+		cmd.api = sqs.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteNetworkinterface) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteQueue) SetApi(api sqsiface.SQSAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteNetworkinterface) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8543,13 +10884,13 @@ func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]inter
 		}
 	}
 
-	input := &ec2.DeleteNetworkInterfaceInput{}
+	input := &sqs.DeleteQueueInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sqs.DeleteQueueInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteNetworkInterface(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteNetworkInterface call took %s", time.Since(start))
+	output, err := cmd.api.DeleteQueue(input)
+	renv.Log().ExtraVerbosef("sqs.DeleteQueue call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8559,80 +10900,59 @@ func (cmd *DeleteNetworkinterface) run(renv env.Running, params map[string]inter
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete networkinterface: AWS command returned nil output")
+			renv.Log().Warning("delete queue: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete networkinterface '%s' done", extracted)
+		renv.Log().Verbosef("delete queue '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete networkinterface done")
-	}
-
-	if v, ok := implementsAfterRun(cmd); ok {
-		if brErr := v.AfterRun(renv, output); brErr != nil {
-			return nil, fmt.Errorf("after run: %s", brErr)
-		}
-	}
-
-	return extracted, nil
-}
-
-func (cmd *DeleteNetworkinterface) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	if err := cmd.inject(params); err != nil {
-		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
-	}
-
-	input := &ec2.DeleteNetworkInterfaceInput{}
-	input.SetDryRun(true)
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteNetworkInterfaceInput: %s", err)
+		renv.Log().Verbose("delete queue done")
 	}
 
-	start := time.Now()
-	_, err := cmd.api.DeleteNetworkInterface(input)
-	if awsErr, ok := err.(awserr.Error); ok {
-		switch code := awsErr.Code(); {
-		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteNetworkInterface call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete networkinterface ok")
-			return fakeDryRunId("networkinterface"), nil
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
 		}
 	}
 
-	return nil, err
+	return extracted, nil
 }
 
-func (cmd *DeleteNetworkinterface) inject(params map[string]interface{}) error {
+func (cmd *DeleteQueue) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("queue"), nil
+}
+
+func (cmd *DeleteQueue) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeletePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeletePolicy {
-	cmd := new(DeletePolicy)
+func NewDeleteRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRecord {
+	cmd := new(DeleteRecord)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = iam.New(sess)
+		cmd.api = route53.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeletePolicy) SetApi(api iamiface.IAMAPI) {
+func (cmd *DeleteRecord) SetApi(api route53iface.Route53API) {
 	cmd.api = api
 }
 
-func (cmd *DeletePolicy) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8643,13 +10963,7 @@ func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &iam.DeletePolicyInput{}
-	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in iam.DeletePolicyInput: %s", err)
-	}
-	start := time.Now()
-	output, err := cmd.api.DeletePolicy(input)
-	renv.Log().ExtraVerbosef("iam.DeletePolicy call took %s", time.Since(start))
+	output, err := cmd.ManualRun(renv)
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8659,14 +10973,14 @@ func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete policy: AWS command returned nil output")
+			renv.Log().Warning("delete record: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete policy '%s' done", extracted)
+		renv.Log().Verbosef("delete record '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete policy done")
+		renv.Log().Verbose("delete record done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8678,40 +10992,40 @@ func (cmd *DeletePolicy) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeletePolicy) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("policy"), nil
+func (cmd *DeleteRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("record"), nil
 }
 
-func (cmd *DeletePolicy) inject(params map[string]interface{}) error {
+func (cmd *DeleteRecord) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteQueue(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteQueue {
-	cmd := new(DeleteQueue)
+func NewDeleteReplicationgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteReplicationgroup {
+	cmd := new(DeleteReplicationgroup)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = sqs.New(sess)
+		cmd.api = elasticache.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteQueue) SetApi(api sqsiface.SQSAPI) {
+func (cmd *DeleteReplicationgroup) SetApi(api elasticacheiface.ElastiCacheAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteQueue) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteReplicationgroup) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteReplicationgroup) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8722,13 +11036,13 @@ func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &sqs.DeleteQueueInput{}
+	input := &elasticache.DeleteReplicationGroupInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in sqs.DeleteQueueInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in elasticache.DeleteReplicationGroupInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteQueue(input)
-	renv.Log().ExtraVerbosef("sqs.DeleteQueue call took %s", time.Since(start))
+	output, err := cmd.api.DeleteReplicationGroup(input)
+	renv.Log().ExtraVerbosef("elasticache.DeleteReplicationGroup call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8738,14 +11052,14 @@ func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete queue: AWS command returned nil output")
+			renv.Log().Warning("delete replicationgroup: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete queue '%s' done", extracted)
+		renv.Log().Verbosef("delete replicationgroup '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete queue done")
+		renv.Log().Verbose("delete replicationgroup done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8757,40 +11071,40 @@ func (cmd *DeleteQueue) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteQueue) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("queue"), nil
+func (cmd *DeleteReplicationgroup) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("replicationgroup"), nil
 }
 
-func (cmd *DeleteQueue) inject(params map[string]interface{}) error {
+func (cmd *DeleteReplicationgroup) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRecord(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRecord {
-	cmd := new(DeleteRecord)
+func NewDeleteRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRepository {
+	cmd := new(DeleteRepository)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = route53.New(sess)
+		cmd.api = ecr.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteRecord) SetApi(api route53iface.Route53API) {
+func (cmd *DeleteRepository) SetApi(api ecriface.ECRAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRecord) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRepository) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8801,7 +11115,13 @@ func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	output, err := cmd.ManualRun(renv)
+	input := &ecr.DeleteRepositoryInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ecr.DeleteRepositoryInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteRepository(input)
+	renv.Log().ExtraVerbosef("ecr.DeleteRepository call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8811,14 +11131,14 @@ func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete record: AWS command returned nil output")
+			renv.Log().Warning("delete repository: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete record '%s' done", extracted)
+		renv.Log().Verbosef("delete repository '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete record done")
+		renv.Log().Verbose("delete repository done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8830,40 +11150,40 @@ func (cmd *DeleteRecord) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeleteRecord) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("record"), nil
+func (cmd *DeleteRepository) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("repository"), nil
 }
 
-func (cmd *DeleteRecord) inject(params map[string]interface{}) error {
+func (cmd *DeleteRepository) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteRepository(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRepository {
-	cmd := new(DeleteRepository)
+func NewDeleteRestapi(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteRestapi {
+	cmd := new(DeleteRestapi)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = ecr.New(sess)
+		cmd.api = apigateway.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteRepository) SetApi(api ecriface.ECRAPI) {
+func (cmd *DeleteRestapi) SetApi(api apigatewayiface.APIGatewayAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteRepository) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRestapi) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteRestapi) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -8874,13 +11194,13 @@ func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}
 		}
 	}
 
-	input := &ecr.DeleteRepositoryInput{}
+	input := &apigateway.DeleteRestApiInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ecr.DeleteRepositoryInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in apigateway.DeleteRestApiInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteRepository(input)
-	renv.Log().ExtraVerbosef("ecr.DeleteRepository call took %s", time.Since(start))
+	output, err := cmd.api.DeleteRestApi(input)
+	renv.Log().ExtraVerbosef("apigateway.DeleteRestApi call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -8890,14 +11210,14 @@ func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete repository: AWS command returned nil output")
+			renv.Log().Warning("delete restapi: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete repository '%s' done", extracted)
+		renv.Log().Verbosef("delete restapi '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete repository done")
+		renv.Log().Verbose("delete restapi done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -8909,11 +11229,11 @@ func (cmd *DeleteRepository) run(renv env.Running, params map[string]interface{}
 	return extracted, nil
 }
 
-func (cmd *DeleteRepository) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("repository"), nil
+func (cmd *DeleteRestapi) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("restapi"), nil
 }
 
-func (cmd *DeleteRepository) inject(params map[string]interface{}) error {
+func (cmd *DeleteRestapi) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
@@ -9635,24 +11955,182 @@ func NewDeleteStack(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger
 		cmd.logger = logger.DiscardLogger
 	}
 	if sess != nil {
-		cmd.api = cloudformation.New(sess)
+		cmd.api = cloudformation.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteStack) SetApi(api cloudformationiface.CloudFormationAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &cloudformation.DeleteStackInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in cloudformation.DeleteStackInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteStack(input)
+	renv.Log().ExtraVerbosef("cloudformation.DeleteStack call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete stack: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete stack '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete stack done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteStack) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("stack"), nil
+}
+
+func (cmd *DeleteStack) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteStage(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteStage {
+	cmd := new(DeleteStage)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = apigateway.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteStage) SetApi(api apigatewayiface.APIGatewayAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteStage) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteStage) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &apigateway.DeleteStageInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in apigateway.DeleteStageInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteStage(input)
+	renv.Log().ExtraVerbosef("apigateway.DeleteStage call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete stage: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete stage '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete stage done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteStage) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("stage"), nil
+}
+
+func (cmd *DeleteStage) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteStatemachine(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteStatemachine {
+	cmd := new(DeleteStatemachine)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = sfn.New(sess)
 	}
 	cmd.graph = g
 	return cmd
 }
 
-func (cmd *DeleteStack) SetApi(api cloudformationiface.CloudFormationAPI) {
+func (cmd *DeleteStatemachine) SetApi(api sfniface.SFNAPI) {
 	cmd.api = api
 }
 
-func (cmd *DeleteStack) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteStatemachine) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteStatemachine) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -9663,13 +12141,13 @@ func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (in
 		}
 	}
 
-	input := &cloudformation.DeleteStackInput{}
+	input := &sfn.DeleteStateMachineInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in cloudformation.DeleteStackInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in sfn.DeleteStateMachineInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteStack(input)
-	renv.Log().ExtraVerbosef("cloudformation.DeleteStack call took %s", time.Since(start))
+	output, err := cmd.api.DeleteStateMachine(input)
+	renv.Log().ExtraVerbosef("sfn.DeleteStateMachine call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -9679,14 +12157,14 @@ func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (in
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete stack: AWS command returned nil output")
+			renv.Log().Warning("delete statemachine: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete stack '%s' done", extracted)
+		renv.Log().Verbosef("delete statemachine '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete stack done")
+		renv.Log().Verbose("delete statemachine done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -9698,11 +12176,11 @@ func (cmd *DeleteStack) run(renv env.Running, params map[string]interface{}) (in
 	return extracted, nil
 }
 
-func (cmd *DeleteStack) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("stack"), nil
+func (cmd *DeleteStatemachine) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("statemachine"), nil
 }
 
-func (cmd *DeleteStack) inject(params map[string]interface{}) error {
+func (cmd *DeleteStatemachine) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
@@ -9885,6 +12363,85 @@ func (cmd *DeleteSubscription) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewDeleteTable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTable {
+	cmd := new(DeleteTable)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = dynamodb.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteTable) SetApi(api dynamodbiface.DynamoDBAPI) {
+	cmd.api = api
+}
+
+func (cmd *DeleteTable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteTable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &dynamodb.DeleteTableInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in dynamodb.DeleteTableInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteTable(input)
+	renv.Log().ExtraVerbosef("dynamodb.DeleteTable call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete table: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete table '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete table done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteTable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("table"), nil
+}
+
+func (cmd *DeleteTable) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewDeleteTag(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteTag {
 	cmd := new(DeleteTag)
 	if len(l) > 0 {
@@ -10169,9 +12726,188 @@ func (cmd *DeleteUser) run(renv env.Running, params map[string]interface{}) (int
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete user '%s' done", extracted)
+		renv.Log().Verbosef("delete user '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete user done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("user"), nil
+}
+
+func (cmd *DeleteUser) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVolume {
+	cmd := new(DeleteVolume)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteVolume) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteVolumeInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteVolume(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVolume call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete volume: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete volume '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("delete volume done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *DeleteVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteVolumeInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteVolume(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVolume call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete volume ok")
+			return fakeDryRunId("volume"), nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cmd *DeleteVolume) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
+func NewDeleteVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpc {
+	cmd := new(DeleteVpc)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ec2.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *DeleteVpc) SetApi(api ec2iface.EC2API) {
+	cmd.api = api
+}
+
+func (cmd *DeleteVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &ec2.DeleteVpcInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.DeleteVpc(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVpc call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("delete vpc: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("delete vpc '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete user done")
+		renv.Log().Verbose("delete vpc done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10183,16 +12919,37 @@ func (cmd *DeleteUser) run(renv env.Running, params map[string]interface{}) (int
 	return extracted, nil
 }
 
-func (cmd *DeleteUser) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
-	return fakeDryRunId("user"), nil
+func (cmd *DeleteVpc) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	input := &ec2.DeleteVpcInput{}
+	input.SetDryRun(true)
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
+	}
+
+	start := time.Now()
+	_, err := cmd.api.DeleteVpc(input)
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch code := awsErr.Code(); {
+		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpc call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete vpc ok")
+			return fakeDryRunId("vpc"), nil
+		}
+	}
+
+	return nil, err
 }
 
-func (cmd *DeleteUser) inject(params map[string]interface{}) error {
+func (cmd *DeleteVpc) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVolume {
-	cmd := new(DeleteVolume)
+func NewDeleteVpcendpoint(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpcendpoint {
+	cmd := new(DeleteVpcendpoint)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -10205,18 +12962,18 @@ func NewDeleteVolume(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logge
 	return cmd
 }
 
-func (cmd *DeleteVolume) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteVpcendpoint) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteVolume) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVpcendpoint) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVpcendpoint) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10227,13 +12984,13 @@ func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (i
 		}
 	}
 
-	input := &ec2.DeleteVolumeInput{}
+	input := &ec2.DeleteVpcEndpointsInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcEndpointsInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteVolume(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteVolume call took %s", time.Since(start))
+	output, err := cmd.api.DeleteVpcEndpoints(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVpcEndpoints call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10243,14 +13000,14 @@ func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (i
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete volume: AWS command returned nil output")
+			renv.Log().Warning("delete vpcendpoint: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete volume '%s' done", extracted)
+		renv.Log().Verbosef("delete vpcendpoint '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete volume done")
+		renv.Log().Verbose("delete vpcendpoint done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10262,37 +13019,37 @@ func (cmd *DeleteVolume) run(renv env.Running, params map[string]interface{}) (i
 	return extracted, nil
 }
 
-func (cmd *DeleteVolume) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVpcendpoint) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DeleteVolumeInput{}
+	input := &ec2.DeleteVpcEndpointsInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVolumeInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcEndpointsInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DeleteVolume(input)
+	_, err := cmd.api.DeleteVpcEndpoints(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVolume call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete volume ok")
-			return fakeDryRunId("volume"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpcEndpoints call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete vpcendpoint ok")
+			return fakeDryRunId("vpcendpoint"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DeleteVolume) inject(params map[string]interface{}) error {
+func (cmd *DeleteVpcendpoint) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
-func NewDeleteVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpc {
-	cmd := new(DeleteVpc)
+func NewDeleteVpcpeering(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *DeleteVpcpeering {
+	cmd := new(DeleteVpcpeering)
 	if len(l) > 0 {
 		cmd.logger = l[0]
 	} else {
@@ -10305,18 +13062,18 @@ func NewDeleteVpc(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger)
 	return cmd
 }
 
-func (cmd *DeleteVpc) SetApi(api ec2iface.EC2API) {
+func (cmd *DeleteVpcpeering) SetApi(api ec2iface.EC2API) {
 	cmd.api = api
 }
 
-func (cmd *DeleteVpc) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVpcpeering) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if renv.IsDryRun() {
 		return cmd.dryRun(renv, params)
 	}
 	return cmd.run(renv, params)
 }
 
-func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVpcpeering) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
@@ -10327,13 +13084,13 @@ func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (inte
 		}
 	}
 
-	input := &ec2.DeleteVpcInput{}
+	input := &ec2.DeleteVpcPeeringConnectionInput{}
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcPeeringConnectionInput: %s", err)
 	}
 	start := time.Now()
-	output, err := cmd.api.DeleteVpc(input)
-	renv.Log().ExtraVerbosef("ec2.DeleteVpc call took %s", time.Since(start))
+	output, err := cmd.api.DeleteVpcPeeringConnection(input)
+	renv.Log().ExtraVerbosef("ec2.DeleteVpcPeeringConnection call took %s", time.Since(start))
 	if err != nil {
 		return nil, decorateAWSError(err)
 	}
@@ -10343,14 +13100,14 @@ func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (inte
 		if output != nil {
 			extracted = v.ExtractResult(output)
 		} else {
-			renv.Log().Warning("delete vpc: AWS command returned nil output")
+			renv.Log().Warning("delete vpcpeering: AWS command returned nil output")
 		}
 	}
 
 	if extracted != nil {
-		renv.Log().Verbosef("delete vpc '%s' done", extracted)
+		renv.Log().Verbosef("delete vpcpeering '%s' done", extracted)
 	} else {
-		renv.Log().Verbose("delete vpc done")
+		renv.Log().Verbose("delete vpcpeering done")
 	}
 
 	if v, ok := implementsAfterRun(cmd); ok {
@@ -10362,32 +13119,32 @@ func (cmd *DeleteVpc) run(renv env.Running, params map[string]interface{}) (inte
 	return extracted, nil
 }
 
-func (cmd *DeleteVpc) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+func (cmd *DeleteVpcpeering) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
 	if err := cmd.inject(params); err != nil {
 		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
 	}
 
-	input := &ec2.DeleteVpcInput{}
+	input := &ec2.DeleteVpcPeeringConnectionInput{}
 	input.SetDryRun(true)
 	if err := structInjector(cmd, input, renv.Context()); err != nil {
-		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcInput: %s", err)
+		return nil, fmt.Errorf("cannot inject in ec2.DeleteVpcPeeringConnectionInput: %s", err)
 	}
 
 	start := time.Now()
-	_, err := cmd.api.DeleteVpc(input)
+	_, err := cmd.api.DeleteVpcPeeringConnection(input)
 	if awsErr, ok := err.(awserr.Error); ok {
 		switch code := awsErr.Code(); {
 		case code == dryRunOperation, strings.HasSuffix(code, notFound), strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile name"):
-			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpc call took %s", time.Since(start))
-			renv.Log().Verbose("dry run: delete vpc ok")
-			return fakeDryRunId("vpc"), nil
+			renv.Log().ExtraVerbosef("dry run: ec2.DeleteVpcPeeringConnection call took %s", time.Since(start))
+			renv.Log().Verbose("dry run: delete vpcpeering ok")
+			return fakeDryRunId("vpcpeering"), nil
 		}
 	}
 
 	return nil, err
 }
 
-func (cmd *DeleteVpc) inject(params map[string]interface{}) error {
+func (cmd *DeleteVpcpeering) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
@@ -13113,6 +15870,79 @@ func (cmd *UpdateInstance) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewUpdateKey(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateKey {
+	cmd := new(UpdateKey)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = kms.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *UpdateKey) SetApi(api kmsiface.KMSAPI) {
+	cmd.api = api
+}
+
+func (cmd *UpdateKey) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *UpdateKey) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("update key: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("update key '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("update key done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *UpdateKey) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("key"), nil
+}
+
+func (cmd *UpdateKey) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewUpdateLoginprofile(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateLoginprofile {
 	cmd := new(UpdateLoginprofile)
 	if len(l) > 0 {
@@ -13192,6 +16022,79 @@ func (cmd *UpdateLoginprofile) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewUpdateParameter(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateParameter {
+	cmd := new(UpdateParameter)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = ssm.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *UpdateParameter) SetApi(api ssmiface.SSMAPI) {
+	cmd.api = api
+}
+
+func (cmd *UpdateParameter) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *UpdateParameter) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	output, err := cmd.ManualRun(renv)
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("update parameter: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("update parameter '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("update parameter done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *UpdateParameter) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("parameter"), nil
+}
+
+func (cmd *UpdateParameter) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewUpdatePolicy(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdatePolicy {
 	cmd := new(UpdatePolicy)
 	if len(l) > 0 {
@@ -13729,6 +16632,85 @@ func (cmd *UpdateSubnet) inject(params map[string]interface{}) error {
 	return structSetter(cmd, params)
 }
 
+func NewUpdateTable(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateTable {
+	cmd := new(UpdateTable)
+	if len(l) > 0 {
+		cmd.logger = l[0]
+	} else {
+		cmd.logger = logger.DiscardLogger
+	}
+	if sess != nil {
+		cmd.api = dynamodb.New(sess)
+	}
+	cmd.graph = g
+	return cmd
+}
+
+func (cmd *UpdateTable) SetApi(api dynamodbiface.DynamoDBAPI) {
+	cmd.api = api
+}
+
+func (cmd *UpdateTable) Run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if renv.IsDryRun() {
+		return cmd.dryRun(renv, params)
+	}
+	return cmd.run(renv, params)
+}
+
+func (cmd *UpdateTable) run(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	if err := cmd.inject(params); err != nil {
+		return nil, fmt.Errorf("cannot set params on command struct: %s", err)
+	}
+
+	if v, ok := implementsBeforeRun(cmd); ok {
+		if brErr := v.BeforeRun(renv); brErr != nil {
+			return nil, fmt.Errorf("before run: %s", brErr)
+		}
+	}
+
+	input := &dynamodb.UpdateTableInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in dynamodb.UpdateTableInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.UpdateTable(input)
+	renv.Log().ExtraVerbosef("dynamodb.UpdateTable call took %s", time.Since(start))
+	if err != nil {
+		return nil, decorateAWSError(err)
+	}
+
+	var extracted interface{}
+	if v, ok := implementsResultExtractor(cmd); ok {
+		if output != nil {
+			extracted = v.ExtractResult(output)
+		} else {
+			renv.Log().Warning("update table: AWS command returned nil output")
+		}
+	}
+
+	if extracted != nil {
+		renv.Log().Verbosef("update table '%s' done", extracted)
+	} else {
+		renv.Log().Verbose("update table done")
+	}
+
+	if v, ok := implementsAfterRun(cmd); ok {
+		if brErr := v.AfterRun(renv, output); brErr != nil {
+			return nil, fmt.Errorf("after run: %s", brErr)
+		}
+	}
+
+	return extracted, nil
+}
+
+func (cmd *UpdateTable) dryRun(renv env.Running, params map[string]interface{}) (interface{}, error) {
+	return fakeDryRunId("table"), nil
+}
+
+func (cmd *UpdateTable) inject(params map[string]interface{}) error {
+	return structSetter(cmd, params)
+}
+
 func NewUpdateTargetgroup(sess *session.Session, g cloud.GraphAPI, l ...*logger.Logger) *UpdateTargetgroup {
 	cmd := new(UpdateTargetgroup)
 	if len(l) > 0 {
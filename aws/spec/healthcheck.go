@@ -0,0 +1,135 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/wallix/awless/logger"
+)
+
+var healthCheckTypes = []string{
+	route53.HealthCheckTypeHttp,
+	route53.HealthCheckTypeHttps,
+	route53.HealthCheckTypeHttpStrMatch,
+	route53.HealthCheckTypeHttpsStrMatch,
+	route53.HealthCheckTypeTcp,
+}
+
+type CreateHealthcheck struct {
+	_               string `action:"create" entity:"healthcheck" awsAPI:"route53" awsCall:"CreateHealthCheck" awsInput:"route53.CreateHealthCheckInput" awsOutput:"route53.CreateHealthCheckOutput"`
+	logger          *logger.Logger
+	graph           cloud.GraphAPI
+	api             route53iface.Route53API
+	Type            *string `awsName:"HealthCheckConfig.Type" awsType:"awsstr" templateName:"type"`
+	Ip              *string `awsName:"HealthCheckConfig.IPAddress" awsType:"awsstr" templateName:"ip"`
+	Fqdn            *string `awsName:"HealthCheckConfig.FullyQualifiedDomainName" awsType:"awsstr" templateName:"fqdn"`
+	Port            *int64  `awsName:"HealthCheckConfig.Port" awsType:"awsint64" templateName:"port"`
+	Path            *string `awsName:"HealthCheckConfig.ResourcePath" awsType:"awsstr" templateName:"path"`
+	Interval        *int64  `awsName:"HealthCheckConfig.RequestInterval" awsType:"awsint64" templateName:"interval"`
+	Threshold       *int64  `awsName:"HealthCheckConfig.FailureThreshold" awsType:"awsint64" templateName:"threshold"`
+	Callerreference *string `awsName:"CallerReference" awsType:"awsstr" templateName:"callerreference"`
+	Name            *string `templateName:"name"`
+}
+
+func (cmd *CreateHealthcheck) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("type"),
+		params.Opt(params.Suggested("name", "ip", "fqdn", "port", "path"), "callerreference", "interval", "threshold"),
+	),
+		params.Validators{
+			"type": func(i interface{}, others map[string]interface{}) error {
+				if err := params.IsInEnumIgnoreCase(healthCheckTypes...)(i, others); err != nil {
+					return err
+				}
+				_, hasIP := others["ip"]
+				_, hasFqdn := others["fqdn"]
+				if !hasIP && !hasFqdn {
+					return errors.New("expecting at least one of 'ip' or 'fqdn' to know the endpoint to check")
+				}
+				return nil
+			},
+		})
+}
+
+// maxCallerReferenceLength is the longest caller reference Route53 accepts
+const maxCallerReferenceLength = 64
+
+func (cmd *CreateHealthcheck) BeforeRun(renv env.Running) error {
+	if cmd.Callerreference == nil {
+		ref := fmt.Sprintf("awless-%d", time.Now().UnixNano())
+		if cmd.Name != nil {
+			// the name is truncated rather than the timestamp, which keeps the reference unique
+			name := []rune(StringValue(cmd.Name))
+			if max := maxCallerReferenceLength - len(ref) - 1; len(name) > max {
+				name = name[:max]
+			}
+			ref = fmt.Sprintf("%s-%s", string(name), ref)
+		}
+		cmd.Callerreference = String(ref)
+	}
+	for _, t := range healthCheckTypes {
+		if strings.EqualFold(StringValue(cmd.Type), t) {
+			cmd.Type = String(t)
+		}
+	}
+	return nil
+}
+
+func (cmd *CreateHealthcheck) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*route53.CreateHealthCheckOutput).HealthCheck.Id)
+}
+
+// AfterRun names the health check through its "Name" tag, which is how the
+// Route53 console and awless display health checks.
+func (cmd *CreateHealthcheck) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Name == nil {
+		return nil
+	}
+	id := cmd.ExtractResult(output)
+	start := time.Now()
+	if _, err := cmd.api.ChangeTagsForResource(&route53.ChangeTagsForResourceInput{
+		ResourceId:   awssdk.String(id),
+		ResourceType: awssdk.String(route53.TagResourceTypeHealthcheck),
+		AddTags:      []*route53.Tag{{Key: awssdk.String("Name"), Value: cmd.Name}},
+	}); err != nil {
+		return fmt.Errorf("healthcheck %s: name: %s", id, err)
+	}
+	cmd.logger.ExtraVerbosef("route53.ChangeTagsForResource call took %s", time.Since(start))
+	return nil
+}
+
+type DeleteHealthcheck struct {
+	_      string `action:"delete" entity:"healthcheck" awsAPI:"route53" awsCall:"DeleteHealthCheck" awsInput:"route53.DeleteHealthCheckInput" awsOutput:"route53.DeleteHealthCheckOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    route53iface.Route53API
+	Id     *string `awsName:"HealthCheckId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteHealthcheck) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
@@ -0,0 +1,181 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+const aliasPrefix = "alias/"
+
+type CreateKey struct {
+	_           string `action:"create" entity:"key" awsAPI:"kms"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         kmsiface.KMSAPI
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	PolicyFile  *string `awsName:"Policy" awsType:"awsfiletostring" templateName:"policy-file"`
+	Alias       *string `templateName:"alias"`
+	Rotation    *bool   `templateName:"rotation"`
+}
+
+func (cmd *CreateKey) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("description"), params.Opt("alias", "policy-file", "rotation")),
+		params.Validators{
+			"policy-file": params.IsFilepath,
+		})
+}
+
+func (cmd *CreateKey) BeforeRun(renv env.Running) error {
+	if cmd.Alias != nil && !strings.HasPrefix(StringValue(cmd.Alias), aliasPrefix) {
+		cmd.Alias = String(aliasPrefix + StringValue(cmd.Alias))
+	}
+	return nil
+}
+
+func (cmd *CreateKey) ManualRun(renv env.Running) (interface{}, error) {
+	input := &kms.CreateKeyInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in kms.CreateKeyInput: %s", err)
+	}
+
+	start := time.Now()
+	output, err := cmd.api.CreateKey(input)
+	if err != nil {
+		return nil, err
+	}
+	cmd.logger.ExtraVerbosef("kms.CreateKey call took %s", time.Since(start))
+
+	keyID := output.KeyMetadata.KeyId
+	if cmd.Alias != nil {
+		if _, err = cmd.api.CreateAlias(&kms.CreateAliasInput{AliasName: cmd.Alias, TargetKeyId: keyID}); err != nil {
+			return nil, fmt.Errorf("key %s created but alias failed: %s", StringValue(keyID), err)
+		}
+	}
+	if BoolValue(cmd.Rotation) {
+		if _, err = cmd.api.EnableKeyRotation(&kms.EnableKeyRotationInput{KeyId: keyID}); err != nil {
+			return nil, fmt.Errorf("key %s created but enabling rotation failed: %s", StringValue(keyID), err)
+		}
+	}
+
+	return output, nil
+}
+
+func (cmd *CreateKey) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*kms.CreateKeyOutput).KeyMetadata.KeyId)
+}
+
+type UpdateKey struct {
+	_           string `action:"update" entity:"key" awsAPI:"kms"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         kmsiface.KMSAPI
+	Id          *string `templateName:"id"`
+	Description *string `templateName:"description"`
+	PolicyFile  *string `templateName:"policy-file"`
+	Rotation    *bool   `templateName:"rotation"`
+	Enabled     *bool   `templateName:"enabled"`
+}
+
+func (cmd *UpdateKey) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.AtLeastOneOf(params.Key("description"), params.Key("enabled"), params.Key("policy-file"), params.Key("rotation"))),
+		params.Validators{
+			"policy-file": params.IsFilepath,
+		})
+}
+
+func (cmd *UpdateKey) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	if cmd.Description != nil {
+		if _, err := cmd.api.UpdateKeyDescription(&kms.UpdateKeyDescriptionInput{KeyId: cmd.Id, Description: cmd.Description}); err != nil {
+			return nil, err
+		}
+	}
+	if cmd.PolicyFile != nil {
+		input := &kms.PutKeyPolicyInput{KeyId: cmd.Id, PolicyName: String("default")}
+		if err := setFieldWithType(cmd.PolicyFile, input, "Policy", awsfiletostring, renv.Context()); err != nil {
+			return nil, fmt.Errorf("policy-file: %s", err)
+		}
+		if _, err := cmd.api.PutKeyPolicy(input); err != nil {
+			return nil, err
+		}
+	}
+	if cmd.Enabled != nil {
+		var err error
+		if BoolValue(cmd.Enabled) {
+			_, err = cmd.api.EnableKey(&kms.EnableKeyInput{KeyId: cmd.Id})
+		} else {
+			_, err = cmd.api.DisableKey(&kms.DisableKeyInput{KeyId: cmd.Id})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cmd.Rotation != nil {
+		var err error
+		if BoolValue(cmd.Rotation) {
+			_, err = cmd.api.EnableKeyRotation(&kms.EnableKeyRotationInput{KeyId: cmd.Id})
+		} else {
+			_, err = cmd.api.DisableKeyRotation(&kms.DisableKeyRotationInput{KeyId: cmd.Id})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	cmd.logger.ExtraVerbosef("kms update key calls took %s", time.Since(start))
+	return nil, nil
+}
+
+type DeleteKey struct {
+	_           string `action:"delete" entity:"key" awsAPI:"kms" awsCall:"ScheduleKeyDeletion" awsInput:"kms.ScheduleKeyDeletionInput" awsOutput:"kms.ScheduleKeyDeletionOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         kmsiface.KMSAPI
+	Id          *string `awsName:"KeyId" awsType:"awsstr" templateName:"id"`
+	PendingDays *int64  `awsName:"PendingWindowInDays" awsType:"awsint64" templateName:"pending-days"`
+}
+
+func (cmd *DeleteKey) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Opt("pending-days")),
+		params.Validators{
+			"pending-days": isPendingDays,
+		})
+}
+
+// Keys are deleted after a waiting period of 7 to 30 days (default: 30)
+func isPendingDays(i interface{}, others map[string]interface{}) error {
+	days, ok := i.(int)
+	if !ok {
+		return fmt.Errorf("invalid pending days '%v', expected an integer", i)
+	}
+	if days < 7 || days > 30 {
+		return fmt.Errorf("invalid pending days %d, expected between 7 and 30", days)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateMounttarget struct {
+	_              string `action:"create" entity:"mounttarget" awsAPI:"efs" awsCall:"CreateMountTarget" awsInput:"efs.CreateMountTargetInput" awsOutput:"efs.MountTargetDescription"`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            efsiface.EFSAPI
+	Filesystem     *string   `awsName:"FileSystemId" awsType:"awsstr" templateName:"filesystem"`
+	Subnet         *string   `awsName:"SubnetId" awsType:"awsstr" templateName:"subnet"`
+	IP             *string   `awsName:"IpAddress" awsType:"awsstr" templateName:"ip"`
+	Securitygroups []*string `awsName:"SecurityGroups" awsType:"awsstringslice" templateName:"securitygroups"`
+}
+
+func (cmd *CreateMounttarget) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("filesystem"), params.Key("subnet"),
+		params.Opt("ip", params.Suggested("securitygroups")),
+	))
+}
+
+func (cmd *CreateMounttarget) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*efs.MountTargetDescription).MountTargetId)
+}
+
+type DeleteMounttarget struct {
+	_      string `action:"delete" entity:"mounttarget" awsAPI:"efs" awsCall:"DeleteMountTarget" awsInput:"efs.DeleteMountTargetInput" awsOutput:"efs.DeleteMountTargetOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    efsiface.EFSAPI
+	Id     *string `awsName:"MountTargetId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteMounttarget) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+type CheckMounttarget struct {
+	_       string `action:"check" entity:"mounttarget" awsAPI:"efs"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     efsiface.EFSAPI
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *CheckMounttarget) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase(efs.LifeCycleStateAvailable, efs.LifeCycleStateCreating,
+				efs.LifeCycleStateDeleted, efs.LifeCycleStateDeleting, notFoundState),
+		},
+	)
+}
+
+func (cmd *CheckMounttarget) ManualRun(renv env.Running) (interface{}, error) {
+	input := &efs.DescribeMountTargetsInput{
+		MountTargetId: cmd.Id,
+	}
+
+	c := &checker{
+		description: fmt.Sprintf("mounttarget %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeMountTargets(input)
+			if err != nil {
+				if awserr, ok := err.(awserr.Error); ok && awserr.Code() == efs.ErrCodeMountTargetNotFound {
+					return notFoundState, nil
+				}
+				return "", err
+			}
+			for _, target := range output.MountTargets {
+				if StringValue(target.MountTargetId) == StringValue(cmd.Id) {
+					return StringValue(target.LifeCycleState), nil
+				}
+			}
+			return notFoundState, nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
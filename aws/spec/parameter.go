@@ -0,0 +1,144 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+var parameterTypes = []string{ssm.ParameterTypeString, ssm.ParameterTypeStringList, ssm.ParameterTypeSecureString}
+
+type CreateParameter struct {
+	_           string `action:"create" entity:"parameter" awsAPI:"ssm" awsCall:"PutParameter" awsInput:"ssm.PutParameterInput" awsOutput:"ssm.PutParameterOutput"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         ssmiface.SSMAPI
+	Name        *string   `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Value       []*string `awsName:"Value" awsType:"awscsvstr" templateName:"value"`
+	Type        *string   `awsName:"Type" awsType:"awsstr" templateName:"type"`
+	Description *string   `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	Key         *string   `awsName:"KeyId" awsType:"awsstr" templateName:"key"`
+}
+
+func (cmd *CreateParameter) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"), params.Key("type"), params.Key("value"), params.Opt("description", "key")),
+		params.Validators{
+			"type": params.IsInEnumIgnoreCase(parameterTypes...),
+			"key": func(i interface{}, others map[string]interface{}) error {
+				if typ := fmt.Sprint(others["type"]); !strings.EqualFold(typ, ssm.ParameterTypeSecureString) {
+					return fmt.Errorf("a key can only encrypt parameters of type %s, got %s", ssm.ParameterTypeSecureString, typ)
+				}
+				return nil
+			},
+		})
+}
+
+// SecretParams hides the values of SecureString parameters from the logs
+func (cmd *CreateParameter) SecretParams(params map[string]interface{}) []string {
+	if strings.EqualFold(fmt.Sprint(params["type"]), ssm.ParameterTypeSecureString) {
+		return []string{"value"}
+	}
+	return nil
+}
+
+func (cmd *CreateParameter) BeforeRun(renv env.Running) error {
+	for _, typ := range parameterTypes {
+		if strings.EqualFold(StringValue(cmd.Type), typ) {
+			cmd.Type = String(typ)
+		}
+	}
+	return nil
+}
+
+func (cmd *CreateParameter) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+type UpdateParameter struct {
+	_           string `action:"update" entity:"parameter" awsAPI:"ssm"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         ssmiface.SSMAPI
+	Name        *string   `templateName:"name"`
+	Value       []*string `templateName:"value"`
+	Description *string   `templateName:"description"`
+}
+
+func (cmd *UpdateParameter) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("value"), params.Opt("description")))
+}
+
+// SecretParams always hides the values from the logs, as the type of the parameter is only known when running
+func (cmd *UpdateParameter) SecretParams(params map[string]interface{}) []string {
+	return []string{"value"}
+}
+
+// Parameters are overwritten with their current type and key, as PutParameter requires the type
+func (cmd *UpdateParameter) ManualRun(renv env.Running) (interface{}, error) {
+	start := time.Now()
+	described, err := cmd.api.DescribeParameters(&ssm.DescribeParametersInput{
+		Filters: []*ssm.ParametersFilter{{Key: String(ssm.ParametersFilterKeyName), Values: []*string{cmd.Name}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(described.Parameters) == 0 {
+		return nil, fmt.Errorf("parameter %s not found", StringValue(cmd.Name))
+	}
+	current := described.Parameters[0]
+
+	input := &ssm.PutParameterInput{
+		Name:        cmd.Name,
+		Type:        current.Type,
+		KeyId:       current.KeyId,
+		Description: current.Description,
+		Overwrite:   Bool(true),
+	}
+	if cmd.Description != nil {
+		input.Description = cmd.Description
+	}
+	if err = setFieldWithType(cmd.Value, input, "Value", awscsvstr, renv.Context()); err != nil {
+		return nil, fmt.Errorf("value: %s", err)
+	}
+	output, err := cmd.api.PutParameter(input)
+	if err != nil {
+		return nil, err
+	}
+	cmd.logger.ExtraVerbosef("ssm update parameter calls took %s", time.Since(start))
+	return output, nil
+}
+
+type DeleteParameter struct {
+	_      string `action:"delete" entity:"parameter" awsAPI:"ssm" awsCall:"DeleteParameter" awsInput:"ssm.DeleteParameterInput" awsOutput:"ssm.DeleteParameterOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ssmiface.SSMAPI
+	Name   *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteParameter) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
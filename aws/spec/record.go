@@ -16,7 +16,12 @@ limitations under the License.
 package awsspec
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/wallix/awless/cloud"
@@ -26,35 +31,41 @@ import (
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/params"
 
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
 	"github.com/wallix/awless/logger"
 )
 
 type CreateRecord struct {
-	_       string `action:"create" entity:"record" awsAPI:"route53"`
-	logger  *logger.Logger
-	graph   cloud.GraphAPI
-	api     route53iface.Route53API
-	Zone    *string   `templateName:"zone"`
-	Name    *string   `templateName:"name"`
-	Type    *string   `templateName:"type"`
-	Values  []*string `templateName:"values"`
-	Ttl     *int64    `templateName:"ttl"`
-	Comment *string   `templateName:"comment"`
+	_             string `action:"create" entity:"record" awsAPI:"route53"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           route53iface.Route53API
+	Zone          *string   `templateName:"zone"`
+	Name          *string   `templateName:"name"`
+	Type          *string   `templateName:"type"`
+	Values        []*string `templateName:"values"`
+	Ttl           *int64    `templateName:"ttl"`
+	Comment       *string   `templateName:"comment"`
+	SetIdentifier *string   `templateName:"set-identifier"`
+	Weight        *int64    `templateName:"weight"`
+	Region        *string   `templateName:"region"`
+	Failover      *string   `templateName:"failover"`
+	Healthcheck   *string   `templateName:"healthcheck"`
 }
 
 func (cmd *CreateRecord) ParamsSpec() params.Spec {
 	builder := params.SpecBuilder(params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone"),
-		params.Opt("comment"),
-	))
+		params.Opt("comment", "failover", "healthcheck", "region", "set-identifier", "weight"),
+	), recordRoutingValidators)
 	builder.AddReducer(valueToValues, "value")
 	return builder.Done()
 }
 
 func (cmd *CreateRecord) ManualRun(renv env.Running) (interface{}, error) {
 	start := time.Now()
-	output, err := changeResourceRecordSets(cmd.api, String("CREATE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, cmd.Comment, cmd.Ttl)
+	output, err := changeResourceRecordSets(cmd.api, String("CREATE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, cmd.Comment, cmd.Ttl, cmd.routing())
 	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
 	return output, err
 }
@@ -63,53 +74,104 @@ func (cmd *CreateRecord) ExtractResult(i interface{}) string {
 	return StringValue(i.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo.Id)
 }
 
+func (cmd *CreateRecord) routing() recordRouting {
+	return recordRouting{setIdentifier: cmd.SetIdentifier, weight: cmd.Weight, region: cmd.Region, failover: cmd.Failover, healthcheck: cmd.Healthcheck}
+}
+
 type UpdateRecord struct {
-	_      string `action:"update" entity:"record" awsAPI:"route53"`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    route53iface.Route53API
-	Zone   *string   `templateName:"zone"`
-	Name   *string   `templateName:"name"`
-	Type   *string   `templateName:"type"`
-	Values []*string `templateName:"values"`
-	Ttl    *int64    `templateName:"ttl"`
+	_             string `action:"update" entity:"record" awsAPI:"route53"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           route53iface.Route53API
+	Zone          *string   `templateName:"zone"`
+	Name          *string   `templateName:"name"`
+	Type          *string   `templateName:"type"`
+	Values        []*string `templateName:"values"`
+	Ttl           *int64    `templateName:"ttl"`
+	SetIdentifier *string   `templateName:"set-identifier"`
+	Weight        *int64    `templateName:"weight"`
+	Region        *string   `templateName:"region"`
+	Failover      *string   `templateName:"failover"`
+	Healthcheck   *string   `templateName:"healthcheck"`
 }
 
 func (cmd *UpdateRecord) ParamsSpec() params.Spec {
-	builder := params.SpecBuilder(params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone")))
+	builder := params.SpecBuilder(params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone"),
+		params.Opt("failover", "healthcheck", "region", "set-identifier", "weight"),
+	), recordRoutingValidators)
 	builder.AddReducer(valueToValues, "value")
 	return builder.Done()
 }
 
+type updateRecordOutput struct {
+	change   *route53.ChangeResourceRecordSetsOutput
+	previous *route53.ResourceRecordSet
+}
+
+// ManualRun looks up the record set about to be overwritten before UPSERTing it,
+// so that the update can be reverted.
 func (cmd *UpdateRecord) ManualRun(renv env.Running) (interface{}, error) {
 	start := time.Now()
-	output, err := changeResourceRecordSets(cmd.api, String("UPSERT"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, nil, cmd.Ttl)
+	previous, err := findRecordSet(cmd.api, cmd.Zone, cmd.Name, cmd.Type, cmd.SetIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	cmd.logger.ExtraVerbosef("route53.ListResourceRecordSets call took %s", time.Since(start))
+
+	start = time.Now()
+	output, err := changeResourceRecordSets(cmd.api, String("UPSERT"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, nil, cmd.Ttl, cmd.routing())
 	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
-	return output, err
+	if err != nil {
+		return nil, err
+	}
+	return &updateRecordOutput{change: output, previous: previous}, nil
 }
 
+// ExtractResult returns the params of the record as it was before the update,
+// or an empty string when the update created the record. When the previous
+// record can not be expressed with params (e.g. an alias), the change id is
+// returned and the update can not be reverted.
 func (cmd *UpdateRecord) ExtractResult(i interface{}) string {
-	return StringValue(i.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo.Id)
+	out := i.(*updateRecordOutput)
+	if out.previous == nil {
+		return ""
+	}
+	if p, ok := recordSetParams(cmd.Zone, cmd.Name, out.previous); ok {
+		return p
+	}
+	return StringValue(out.change.ChangeInfo.Id)
+}
+
+func (cmd *UpdateRecord) routing() recordRouting {
+	return recordRouting{setIdentifier: cmd.SetIdentifier, weight: cmd.Weight, region: cmd.Region, failover: cmd.Failover, healthcheck: cmd.Healthcheck}
 }
 
 type DeleteRecord struct {
-	_      string `action:"delete" entity:"record" awsAPI:"route53"`
-	logger *logger.Logger
-	graph  cloud.GraphAPI
-	api    route53iface.Route53API
-	Zone   *string   `templateName:"zone"`
-	Name   *string   `templateName:"name"`
-	Type   *string   `templateName:"type"`
-	Values []*string `templateName:"values"`
-	Ttl    *int64    `templateName:"ttl"`
+	_             string `action:"delete" entity:"record" awsAPI:"route53"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           route53iface.Route53API
+	Zone          *string   `templateName:"zone"`
+	Name          *string   `templateName:"name"`
+	Type          *string   `templateName:"type"`
+	Values        []*string `templateName:"values"`
+	Ttl           *int64    `templateName:"ttl"`
+	SetIdentifier *string   `templateName:"set-identifier"`
+	Weight        *int64    `templateName:"weight"`
+	Region        *string   `templateName:"region"`
+	Failover      *string   `templateName:"failover"`
+	Healthcheck   *string   `templateName:"healthcheck"`
 }
 
 func (cmd *DeleteRecord) ParamsSpec() params.Spec {
 	builder := params.SpecBuilder(
 		params.OnlyOneOf(
-			params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone")),
+			params.AllOf(params.Key("name"), params.Key("ttl"), params.Key("type"), params.OnlyOneOf(params.Key("values"), params.Key("value")), params.Key("zone"),
+				params.Opt("failover", "healthcheck", "region", "set-identifier", "weight"),
+			),
 			params.AllOf(params.Key("id")),
 		),
+		recordRoutingValidators,
 	)
 	builder.AddReducer(valueToValues, "value")
 	builder.AddReducer(
@@ -136,6 +198,17 @@ func (cmd *DeleteRecord) ParamsSpec() params.Spec {
 				if rec, ok := r.Property(properties.Records); ok {
 					values["values"] = rec
 				}
+				for prop, param := range map[string]string{
+					properties.Set:         "set-identifier",
+					properties.Weight:      "weight",
+					properties.Region:      "region",
+					properties.Failover:    "failover",
+					properties.HealthCheck: "healthcheck",
+				} {
+					if v, ok := r.Property(prop); ok {
+						values[param] = v
+					}
+				}
 				parents, err := cmd.graph.ResourceRelations(r, rdf.ParentOf, false)
 				if err != nil {
 					return values, fmt.Errorf("cannot get record's zone: %s", err)
@@ -154,7 +227,7 @@ func (cmd *DeleteRecord) ParamsSpec() params.Spec {
 
 func (cmd *DeleteRecord) ManualRun(renv env.Running) (interface{}, error) {
 	start := time.Now()
-	output, err := changeResourceRecordSets(cmd.api, String("DELETE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, nil, cmd.Ttl)
+	output, err := changeResourceRecordSets(cmd.api, String("DELETE"), cmd.Zone, cmd.Name, cmd.Type, cmd.Values, nil, cmd.Ttl, cmd.routing())
 	cmd.logger.ExtraVerbosef("route53.ChangeResourceRecordSets call took %s", time.Since(start))
 	return output, err
 }
@@ -163,7 +236,138 @@ func (cmd *DeleteRecord) ExtractResult(i interface{}) string {
 	return StringValue(i.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo.Id)
 }
 
-func changeResourceRecordSets(api route53iface.Route53API, action, zone, name, recordType *string, values []*string, comment *string, ttl *int64) (*route53.ChangeResourceRecordSetsOutput, error) {
+func (cmd *DeleteRecord) routing() recordRouting {
+	return recordRouting{setIdentifier: cmd.SetIdentifier, weight: cmd.Weight, region: cmd.Region, failover: cmd.Failover, healthcheck: cmd.Healthcheck}
+}
+
+// recordRouting is the routing policy of a record set. Record sets sharing
+// a name and a type are told apart by their set identifier.
+type recordRouting struct {
+	setIdentifier, region, failover, healthcheck *string
+	weight                                       *int64
+}
+
+var recordRoutingPolicies = []string{"weight", "region", "failover"}
+
+var recordRoutingValidators = params.Validators{
+	"set-identifier": func(i interface{}, others map[string]interface{}) error {
+		for _, policy := range recordRoutingPolicies {
+			if _, ok := others[policy]; ok {
+				return nil
+			}
+		}
+		return fmt.Errorf("expecting one of %s routing policy along with 'set-identifier'", strings.Join(recordRoutingPolicies, ", "))
+	},
+	"weight": isRecordRoutingPolicy("weight"),
+	"region": isRecordRoutingPolicy("region"),
+	"failover": func(i interface{}, others map[string]interface{}) error {
+		if err := params.IsInEnumIgnoreCase(route53.ResourceRecordSetFailoverPrimary, route53.ResourceRecordSetFailoverSecondary)(i, others); err != nil {
+			return err
+		}
+		return isRecordRoutingPolicy("failover")(i, others)
+	},
+}
+
+func isRecordRoutingPolicy(policy string) func(i interface{}, others map[string]interface{}) error {
+	return func(i interface{}, others map[string]interface{}) error {
+		if _, ok := others["set-identifier"]; !ok {
+			return fmt.Errorf("%s routing policy requires a 'set-identifier'", policy)
+		}
+		for _, other := range recordRoutingPolicies {
+			if _, ok := others[other]; ok && other != policy {
+				return errors.New("only one of weight, region or failover routing policy can be set on a record")
+			}
+		}
+		return nil
+	}
+}
+
+// findRecordSet returns the record set of the zone matching name, type and set identifier,
+// or nil when there is none.
+func findRecordSet(api route53iface.Route53API, zone, name, recordType, setIdentifier *string) (*route53.ResourceRecordSet, error) {
+	out, err := api.ListResourceRecordSets(&route53.ListResourceRecordSetsInput{
+		HostedZoneId:          zone,
+		StartRecordName:       name,
+		StartRecordType:       recordType,
+		StartRecordIdentifier: setIdentifier,
+		MaxItems:              String("1"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, set := range out.ResourceRecordSets {
+		if strings.EqualFold(strings.TrimSuffix(StringValue(set.Name), "."), strings.TrimSuffix(StringValue(name), ".")) &&
+			strings.EqualFold(StringValue(set.Type), StringValue(recordType)) &&
+			StringValue(set.SetIdentifier) == StringValue(setIdentifier) {
+			return set, nil
+		}
+	}
+	return nil, nil
+}
+
+// recordSetParams renders a record set as template params, or returns false when
+// the record set has properties (alias, geolocation, ...) the record drivers do not handle
+// or values that can not be written as template params.
+func recordSetParams(zone, name *string, set *route53.ResourceRecordSet) (string, bool) {
+	if set.TTL == nil || set.AliasTarget != nil || set.GeoLocation != nil || set.MultiValueAnswer != nil || set.TrafficPolicyInstanceId != nil {
+		return "", false
+	}
+	params := map[string]*string{
+		"name":           name,
+		"type":           set.Type,
+		"zone":           zone,
+		"set-identifier": set.SetIdentifier,
+		"region":         set.Region,
+		"failover":       set.Failover,
+		"healthcheck":    set.HealthCheckId,
+	}
+	var p []string
+	for k, v := range params {
+		if v == nil {
+			continue
+		}
+		quoted, ok := quoteRecordParam(StringValue(v))
+		if !ok {
+			return "", false
+		}
+		p = append(p, k+"="+quoted)
+	}
+	var values []string
+	for _, r := range set.ResourceRecords {
+		quoted, ok := quoteRecordParam(StringValue(r.Value))
+		if !ok {
+			return "", false
+		}
+		values = append(values, quoted)
+	}
+	p = append(p, "ttl="+strconv.FormatInt(awssdk.Int64Value(set.TTL), 10), "values=["+strings.Join(values, ",")+"]")
+	if set.Weight != nil {
+		p = append(p, "weight="+strconv.FormatInt(awssdk.Int64Value(set.Weight), 10))
+	}
+	sort.Strings(p)
+	return strings.Join(p, " "), true
+}
+
+var simpleRecordParam = regexp.MustCompile("^[a-zA-Z0-9-._:/+;~@<>*]+$")
+
+// quoteRecordParam quotes a param value as the template parser expects it, or returns false
+// when the value holds both single and double quotes, which the parser can not read back.
+func quoteRecordParam(s string) (string, bool) {
+	if _, err := strconv.ParseFloat(s, 64); err != nil && simpleRecordParam.MatchString(s) {
+		return s, true
+	}
+	hasSingle, hasDouble := strings.ContainsRune(s, '\''), strings.ContainsRune(s, '"')
+	switch {
+	case hasSingle && hasDouble:
+		return "", false
+	case hasSingle:
+		return "\"" + s + "\"", true
+	default:
+		return "'" + s + "'", true
+	}
+}
+
+func changeResourceRecordSets(api route53iface.Route53API, action, zone, name, recordType *string, values []*string, comment *string, ttl *int64, routing recordRouting) (*route53.ChangeResourceRecordSetsOutput, error) {
 	input := &route53.ChangeResourceRecordSetsInput{}
 	var err error
 	// Required params
@@ -193,6 +397,15 @@ func changeResourceRecordSets(api route53iface.Route53API, action, zone, name, r
 		change.ResourceRecordSet.ResourceRecords = append(change.ResourceRecordSet.ResourceRecords, resourceRecord)
 	}
 
+	// Routing policy
+	change.ResourceRecordSet.SetIdentifier = routing.setIdentifier
+	change.ResourceRecordSet.Weight = routing.weight
+	change.ResourceRecordSet.Region = routing.region
+	if routing.failover != nil {
+		change.ResourceRecordSet.Failover = String(strings.ToUpper(StringValue(routing.failover)))
+	}
+	change.ResourceRecordSet.HealthCheckId = routing.healthcheck
+
 	// Extra params
 	if comment != nil {
 		if err = setFieldWithType(comment, input, "ChangeBatch.Comment", awsstr); err != nil {
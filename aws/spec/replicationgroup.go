@@ -0,0 +1,160 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateReplicationgroup struct {
+	_              string `action:"create" entity:"replicationgroup" awsAPI:"elasticache"`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            elasticacheiface.ElastiCacheAPI
+	Id             *string   `awsName:"ReplicationGroupId" awsType:"awsstr" templateName:"id"`
+	Description    *string   `awsName:"ReplicationGroupDescription" awsType:"awsstr" templateName:"description"`
+	Primary        *string   `awsName:"PrimaryClusterId" awsType:"awsstr" templateName:"primary"`
+	Type           *string   `awsName:"CacheNodeType" awsType:"awsstr" templateName:"type"`
+	Engine         *string   `awsName:"Engine" awsType:"awsstr" templateName:"engine"`
+	Nodes          *int64    `awsName:"NumCacheClusters" awsType:"awsint64" templateName:"nodes"`
+	Version        *string   `awsName:"EngineVersion" awsType:"awsstr" templateName:"version"`
+	Port           *int64    `awsName:"Port" awsType:"awsint64" templateName:"port"`
+	Subnetgroup    *string   `awsName:"CacheSubnetGroupName" awsType:"awsstr" templateName:"subnetgroup"`
+	Subnets        []*string `templateName:"subnets"`
+	Securitygroups []*string `awsName:"SecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroups"`
+	Failover       *bool     `awsName:"AutomaticFailoverEnabled" awsType:"awsbool" templateName:"failover"`
+	Encrypted      *bool     `awsName:"AtRestEncryptionEnabled" awsType:"awsbool" templateName:"encrypted"`
+}
+
+func (cmd *CreateReplicationgroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("description"), params.Key("id"),
+		params.OnlyOneOf(params.Key("primary"), params.AllOf(params.Key("engine"), params.Key("type"))),
+		params.Opt("encrypted", "failover", "nodes", "port", "securitygroups", params.Suggested("subnets"), "subnetgroup", "version"),
+	),
+		params.Validators{
+			"engine":  params.IsInEnumIgnoreCase("redis"),
+			"subnets": excludesCacheSubnetgroup,
+		})
+}
+
+func (cmd *CreateReplicationgroup) ManualRun(renv env.Running) (interface{}, error) {
+	if cmd.Engine != nil {
+		cmd.Engine = String(strings.ToLower(StringValue(cmd.Engine)))
+	}
+	if cmd.Primary == nil && cmd.Nodes == nil {
+		if BoolValue(cmd.Failover) {
+			cmd.Nodes = Int64(2)
+		} else {
+			cmd.Nodes = Int64(1)
+		}
+	}
+	if len(cmd.Subnets) > 0 {
+		name, err := createCacheSubnetGroup(cmd.api, cmd.logger, StringValue(cmd.Id), cmd.Subnets)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Subnetgroup = name
+	}
+
+	input := &elasticache.CreateReplicationGroupInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in elasticache.CreateReplicationGroupInput: %s", err)
+	}
+	start := time.Now()
+	output, err := cmd.api.CreateReplicationGroup(input)
+	cmd.logger.ExtraVerbosef("elasticache.CreateReplicationGroup call took %s", time.Since(start))
+	return output, err
+}
+
+func (cmd *CreateReplicationgroup) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*elasticache.CreateReplicationGroupOutput).ReplicationGroup.ReplicationGroupId)
+}
+
+type DeleteReplicationgroup struct {
+	_             string `action:"delete" entity:"replicationgroup" awsAPI:"elasticache" awsCall:"DeleteReplicationGroup" awsInput:"elasticache.DeleteReplicationGroupInput" awsOutput:"elasticache.DeleteReplicationGroupOutput"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           elasticacheiface.ElastiCacheAPI
+	Id            *string `awsName:"ReplicationGroupId" awsType:"awsstr" templateName:"id"`
+	Snapshot      *string `awsName:"FinalSnapshotIdentifier" awsType:"awsstr" templateName:"snapshot"`
+	RetainPrimary *bool   `awsName:"RetainPrimaryCluster" awsType:"awsbool" templateName:"retain-primary"`
+}
+
+func (cmd *DeleteReplicationgroup) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id"), params.Opt("retain-primary", "snapshot")))
+}
+
+type CheckReplicationgroup struct {
+	_       string `action:"check" entity:"replicationgroup" awsAPI:"elasticache"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     elasticacheiface.ElastiCacheAPI
+	Id      *string `templateName:"id"`
+	State   *string `templateName:"state"`
+	Timeout *int64  `templateName:"timeout"`
+}
+
+func (cmd *CheckReplicationgroup) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("id"), params.Key("state"), params.Key("timeout")),
+		params.Validators{
+			"state": params.IsInEnumIgnoreCase("available", "create-failed", "creating", "deleting", "modifying", "snapshotting", notFoundState),
+		},
+	)
+}
+
+func (cmd *CheckReplicationgroup) ManualRun(renv env.Running) (interface{}, error) {
+	input := &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: cmd.Id,
+	}
+
+	c := &checker{
+		description: fmt.Sprintf("replicationgroup %s", StringValue(cmd.Id)),
+		timeout:     time.Duration(Int64AsIntValue(cmd.Timeout)) * time.Second,
+		frequency:   5 * time.Second,
+		fetchFunc: func() (string, error) {
+			output, err := cmd.api.DescribeReplicationGroups(input)
+			if err != nil {
+				if awserr, ok := err.(awserr.Error); ok && awserr.Code() == elasticache.ErrCodeReplicationGroupNotFoundFault {
+					return notFoundState, nil
+				}
+				return "", err
+			}
+			for _, group := range output.ReplicationGroups {
+				if StringValue(group.ReplicationGroupId) == StringValue(cmd.Id) {
+					return StringValue(group.Status), nil
+				}
+			}
+			return notFoundState, nil
+		},
+		expect: StringValue(cmd.State),
+		logger: cmd.logger,
+	}
+	return nil, c.check()
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateRestapi struct {
+	_           string `action:"create" entity:"restapi" awsAPI:"apigateway" awsCall:"CreateRestApi" awsInput:"apigateway.CreateRestApiInput" awsOutput:"apigateway.RestApi"`
+	logger      *logger.Logger
+	graph       cloud.GraphAPI
+	api         apigatewayiface.APIGatewayAPI
+	Name        *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Description *string `awsName:"Description" awsType:"awsstr" templateName:"description"`
+	Version     *string `awsName:"Version" awsType:"awsstr" templateName:"version"`
+	Function    *string `templateName:"function"`
+	Role        *string `templateName:"role"`
+}
+
+func (cmd *CreateRestapi) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"),
+		params.Opt("description", params.Suggested("function"), "role", "version"),
+	),
+		params.Validators{
+			"function": isLambdaFunctionArnWithRole,
+		})
+}
+
+func (cmd *CreateRestapi) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*apigateway.RestApi).Id)
+}
+
+// AfterRun proxies every request made on the API (on its root resource and on
+// a greedy {proxy+} resource) to the given lambda function. API Gateway assumes
+// the given role to invoke the function.
+func (cmd *CreateRestapi) AfterRun(renv env.Running, output interface{}) error {
+	if cmd.Function == nil {
+		return nil
+	}
+	id := cmd.ExtractResult(output)
+	uri, err := lambdaInvocationURI(StringValue(cmd.Function))
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	resources, err := cmd.api.GetResources(&apigateway.GetResourcesInput{RestApiId: awssdk.String(id)})
+	if err != nil {
+		return fmt.Errorf("restapi %s: get resources: %s", id, err)
+	}
+	cmd.logger.ExtraVerbosef("apigateway.GetResources call took %s", time.Since(start))
+
+	var rootID string
+	for _, res := range resources.Items {
+		if StringValue(res.Path) == "/" {
+			rootID = StringValue(res.Id)
+		}
+	}
+	if rootID == "" {
+		return fmt.Errorf("restapi %s: no root resource found", id)
+	}
+
+	start = time.Now()
+	proxy, err := cmd.api.CreateResource(&apigateway.CreateResourceInput{
+		RestApiId: awssdk.String(id),
+		ParentId:  awssdk.String(rootID),
+		PathPart:  awssdk.String("{proxy+}"),
+	})
+	if err != nil {
+		return fmt.Errorf("restapi %s: create proxy resource: %s", id, err)
+	}
+	cmd.logger.ExtraVerbosef("apigateway.CreateResource call took %s", time.Since(start))
+
+	for _, resourceID := range []string{rootID, StringValue(proxy.Id)} {
+		if err := cmd.putLambdaProxyIntegration(id, resourceID, uri); err != nil {
+			return fmt.Errorf("restapi %s: %s", id, err)
+		}
+	}
+	return nil
+}
+
+func (cmd *CreateRestapi) putLambdaProxyIntegration(restapiID, resourceID, uri string) error {
+	start := time.Now()
+	if _, err := cmd.api.PutMethod(&apigateway.PutMethodInput{
+		RestApiId:         awssdk.String(restapiID),
+		ResourceId:        awssdk.String(resourceID),
+		HttpMethod:        awssdk.String("ANY"),
+		AuthorizationType: awssdk.String("NONE"),
+	}); err != nil {
+		return fmt.Errorf("put method: %s", err)
+	}
+	cmd.logger.ExtraVerbosef("apigateway.PutMethod call took %s", time.Since(start))
+
+	start = time.Now()
+	if _, err := cmd.api.PutIntegration(&apigateway.PutIntegrationInput{
+		RestApiId:             awssdk.String(restapiID),
+		ResourceId:            awssdk.String(resourceID),
+		HttpMethod:            awssdk.String("ANY"),
+		IntegrationHttpMethod: awssdk.String("POST"),
+		Type:                  awssdk.String(apigateway.IntegrationTypeAwsProxy),
+		Uri:                   awssdk.String(uri),
+		Credentials:           cmd.Role,
+	}); err != nil {
+		return fmt.Errorf("put integration: %s", err)
+	}
+	cmd.logger.ExtraVerbosef("apigateway.PutIntegration call took %s", time.Since(start))
+	return nil
+}
+
+// The role is required: without it API Gateway has no permission to invoke the function
+func isLambdaFunctionArnWithRole(i interface{}, others map[string]interface{}) error {
+	if _, ok := others["role"]; !ok {
+		return errors.New("a 'role' that API Gateway assumes to invoke the function is required")
+	}
+	s, ok := i.(string)
+	if !ok {
+		return nil
+	}
+	_, err := lambdaInvocationURI(s)
+	return err
+}
+
+func lambdaInvocationURI(function string) (string, error) {
+	parsed, err := arn.Parse(function)
+	if err != nil {
+		return "", fmt.Errorf("invalid function '%s': %s", function, err)
+	}
+	if parsed.Service != "lambda" || !strings.HasPrefix(parsed.Resource, "function:") {
+		return "", fmt.Errorf("invalid function '%s': expecting a lambda function ARN", function)
+	}
+	return fmt.Sprintf("arn:%s:apigateway:%s:lambda:path/2015-03-31/functions/%s/invocations", parsed.Partition, parsed.Region, function), nil
+}
+
+type DeleteRestapi struct {
+	_      string `action:"delete" entity:"restapi" awsAPI:"apigateway" awsCall:"DeleteRestApi" awsInput:"apigateway.DeleteRestApiInput" awsOutput:"apigateway.DeleteRestApiOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    apigatewayiface.APIGatewayAPI
+	Id     *string `awsName:"RestApiId" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteRestapi) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
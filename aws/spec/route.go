@@ -31,11 +31,12 @@ type CreateRoute struct {
 	Table   *string `awsName:"RouteTableId" awsType:"awsstr" templateName:"table"`
 	CIDR    *string `awsName:"DestinationCidrBlock" awsType:"awsstr" templateName:"cidr"`
 	Gateway *string `awsName:"GatewayId" awsType:"awsstr" templateName:"gateway"`
+	Peering *string `awsName:"VpcPeeringConnectionId" awsType:"awsstr" templateName:"peering"`
 }
 
 func (cmd *CreateRoute) ParamsSpec() params.Spec {
 	return params.NewSpec(
-		params.AllOf(params.Key("cidr"), params.Key("gateway"), params.Key("table")),
+		params.AllOf(params.Key("cidr"), params.Key("table"), params.OnlyOneOf(params.Key("gateway"), params.Key("peering"))),
 		params.Validators{"cidr": params.IsCIDR})
 }
 
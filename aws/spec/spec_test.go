@@ -3,6 +3,9 @@ package awsspec
 import (
 	"strings"
 	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/wallix/awless/template/params"
 )
 
 func TestEnumValidator(t *testing.T) {
@@ -36,3 +39,57 @@ func TestEnumValidator(t *testing.T) {
 		}
 	}
 }
+
+func TestCreateRestapiRequiresRoleWithFunction(t *testing.T) {
+	fn := "arn:aws:lambda:eu-west-1:123456789012:function:shop"
+	tcases := []struct {
+		params         map[string]interface{}
+		expErrContains string
+	}{
+		{map[string]interface{}{"name": "shop-api"}, ""},
+		{map[string]interface{}{"name": "shop-api", "function": fn, "role": "arn:aws:iam::123456789012:role/invoke"}, ""},
+		{map[string]interface{}{"name": "shop-api", "function": fn}, "'role'"},
+		{map[string]interface{}{"name": "shop-api", "function": "shop", "role": "arn:aws:iam::123456789012:role/invoke"}, "invalid function"},
+	}
+	for i, tcase := range tcases {
+		err := params.Validate(new(CreateRestapi).ParamsSpec().Validators(), tcase.params)
+		if tcase.expErrContains == "" {
+			if err != nil {
+				t.Fatalf("%d: %s", i+1, err.Error())
+			}
+		} else if err == nil || !strings.Contains(err.Error(), tcase.expErrContains) {
+			t.Fatalf("%d: got %v, want %s", i+1, err, tcase.expErrContains)
+		}
+	}
+}
+
+func TestRecordSetParamsOnlyRendersParsableValues(t *testing.T) {
+	zone, name := String("/hostedzone/1234"), String("www.domain.com")
+	set := &route53.ResourceRecordSet{Type: String("TXT"), TTL: Int64(300), ResourceRecords: []*route53.ResourceRecord{{Value: String(`"v=spf1 -all"`)}}}
+	p, ok := recordSetParams(zone, name, set)
+	if !ok {
+		t.Fatal("expected record to be rendered")
+	}
+	if got, want := p, `name=www.domain.com ttl=300 type=TXT values=['"v=spf1 -all"'] zone=/hostedzone/1234`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	set.ResourceRecords = []*route53.ResourceRecord{{Value: String(`"it's"`)}}
+	if p, ok := recordSetParams(zone, name, set); ok {
+		t.Fatalf("expected value with both quotes not to be rendered, got %s", p)
+	}
+}
+
+func TestCreateHealthcheckCallerReferenceLength(t *testing.T) {
+	cmd := &CreateHealthcheck{Name: String(strings.Repeat("www-blue", 10))}
+	if err := cmd.BeforeRun(nil); err != nil {
+		t.Fatal(err)
+	}
+	ref := StringValue(cmd.Callerreference)
+	if len(ref) > maxCallerReferenceLength {
+		t.Fatalf("got caller reference of %d characters: %s", len(ref), ref)
+	}
+	if !strings.HasPrefix(ref, "www-blue") || !strings.Contains(ref, "-awless-") {
+		t.Fatalf("unexpected caller reference %s", ref)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateStage struct {
+	_                     string `action:"create" entity:"stage" awsAPI:"apigateway" awsCall:"CreateDeployment" awsInput:"apigateway.CreateDeploymentInput" awsOutput:"apigateway.Deployment"`
+	logger                *logger.Logger
+	graph                 cloud.GraphAPI
+	api                   apigatewayiface.APIGatewayAPI
+	Restapi               *string `awsName:"RestApiId" awsType:"awsstr" templateName:"restapi"`
+	Name                  *string `awsName:"StageName" awsType:"awsstr" templateName:"name"`
+	Description           *string `awsName:"StageDescription" awsType:"awsstr" templateName:"description"`
+	DeploymentDescription *string `awsName:"Description" awsType:"awsstr" templateName:"deployment-description"`
+}
+
+func (cmd *CreateStage) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("restapi"),
+		params.Opt("deployment-description", "description"),
+	))
+}
+
+// BeforeRun refuses existing stages: CreateDeployment would silently redeploy them
+// and reverting would then delete a stage this run did not create
+func (cmd *CreateStage) BeforeRun(renv env.Running) error {
+	start := time.Now()
+	_, err := cmd.api.GetStage(&apigateway.GetStageInput{RestApiId: cmd.Restapi, StageName: cmd.Name})
+	cmd.logger.ExtraVerbosef("apigateway.GetStage call took %s", time.Since(start))
+	if awserr, ok := err.(awserr.Error); ok && awserr.Code() == apigateway.ErrCodeNotFoundException {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("check stage '%s': %s", StringValue(cmd.Name), err)
+	}
+	return fmt.Errorf("stage '%s' already exists in restapi %s", StringValue(cmd.Name), StringValue(cmd.Restapi))
+}
+
+func (cmd *CreateStage) ExtractResult(i interface{}) string {
+	return StringValue(cmd.Name)
+}
+
+type DeleteStage struct {
+	_       string `action:"delete" entity:"stage" awsAPI:"apigateway" awsCall:"DeleteStage" awsInput:"apigateway.DeleteStageInput" awsOutput:"apigateway.DeleteStageOutput"`
+	logger  *logger.Logger
+	graph   cloud.GraphAPI
+	api     apigatewayiface.APIGatewayAPI
+	Restapi *string `awsName:"RestApiId" awsType:"awsstr" templateName:"restapi"`
+	Name    *string `awsName:"StageName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteStage) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("restapi")))
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/params"
+)
+
+type CreateStatemachine struct {
+	_              string `action:"create" entity:"statemachine" awsAPI:"sfn" awsCall:"CreateStateMachine" awsInput:"sfn.CreateStateMachineInput" awsOutput:"sfn.CreateStateMachineOutput"`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            sfniface.SFNAPI
+	Name           *string `awsName:"Name" awsType:"awsstr" templateName:"name"`
+	Role           *string `awsName:"RoleArn" awsType:"awsstr" templateName:"role"`
+	Definition     *string `awsName:"Definition" awsType:"awsstr" templateName:"definition"`
+	DefinitionFile *string `awsName:"Definition" awsType:"awsfiletostring" templateName:"definition-file"`
+}
+
+func (cmd *CreateStatemachine) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name"), params.Key("role"),
+		params.OnlyOneOf(params.Key("definition"), params.Key("definition-file")),
+	),
+		params.Validators{
+			"definition-file": params.IsFilepath,
+		})
+}
+
+func (cmd *CreateStatemachine) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*sfn.CreateStateMachineOutput).StateMachineArn)
+}
+
+type DeleteStatemachine struct {
+	_      string `action:"delete" entity:"statemachine" awsAPI:"sfn" awsCall:"DeleteStateMachine" awsInput:"sfn.DeleteStateMachineInput" awsOutput:"sfn.DeleteStateMachineOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    sfniface.SFNAPI
+	Id     *string `awsName:"StateMachineArn" awsType:"awsstr" templateName:"id"`
+}
+
+func (cmd *DeleteStatemachine) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
@@ -0,0 +1,173 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+)
+
+const streamDisabled = "disabled"
+
+type CreateTable struct {
+	_             string `action:"create" entity:"table" awsAPI:"dynamodb"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           dynamodbiface.DynamoDBAPI
+	Name          *string `awsName:"TableName" awsType:"awsstr" templateName:"name"`
+	HashKey       *string `templateName:"hash-key"`
+	RangeKey      *string `templateName:"range-key"`
+	ReadCapacity  *int64  `awsName:"ProvisionedThroughput.ReadCapacityUnits" awsType:"awsint64" templateName:"read-capacity"`
+	WriteCapacity *int64  `awsName:"ProvisionedThroughput.WriteCapacityUnits" awsType:"awsint64" templateName:"write-capacity"`
+	Stream        *string `awsName:"StreamSpecification.StreamViewType" awsType:"awsstr" templateName:"stream"`
+	StreamEnabled *bool   `awsName:"StreamSpecification.StreamEnabled" awsType:"awsbool"`
+}
+
+func (cmd *CreateTable) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"), params.Key("hash-key"), params.Key("read-capacity"), params.Key("write-capacity"), params.Opt("range-key", "stream")),
+		params.Validators{
+			"hash-key":  isKeyAttribute,
+			"range-key": isKeyAttribute,
+			"stream":    params.IsInEnumIgnoreCase(dynamodb.StreamViewTypeNewImage, dynamodb.StreamViewTypeOldImage, dynamodb.StreamViewTypeNewAndOldImages, dynamodb.StreamViewTypeKeysOnly),
+		})
+}
+
+func (cmd *CreateTable) BeforeRun(renv env.Running) error {
+	if cmd.Stream != nil {
+		cmd.Stream = String(strings.ToUpper(StringValue(cmd.Stream)))
+		cmd.StreamEnabled = Bool(true)
+	}
+	return nil
+}
+
+func (cmd *CreateTable) ManualRun(renv env.Running) (interface{}, error) {
+	input := &dynamodb.CreateTableInput{}
+	if err := structInjector(cmd, input, renv.Context()); err != nil {
+		return nil, fmt.Errorf("cannot inject in dynamodb.CreateTableInput: %s", err)
+	}
+
+	keys := []struct {
+		attribute *string
+		keyType   string
+	}{{cmd.HashKey, dynamodb.KeyTypeHash}, {cmd.RangeKey, dynamodb.KeyTypeRange}}
+	for _, key := range keys {
+		if key.attribute == nil {
+			continue
+		}
+		name, typ, err := parseKeyAttribute(StringValue(key.attribute))
+		if err != nil {
+			return nil, err
+		}
+		input.KeySchema = append(input.KeySchema, &dynamodb.KeySchemaElement{AttributeName: String(name), KeyType: String(key.keyType)})
+		input.AttributeDefinitions = append(input.AttributeDefinitions, &dynamodb.AttributeDefinition{AttributeName: String(name), AttributeType: String(typ)})
+	}
+
+	start := time.Now()
+	output, err := cmd.api.CreateTable(input)
+	if err != nil {
+		return nil, err
+	}
+	cmd.logger.ExtraVerbosef("dynamodb.CreateTable call took %s", time.Since(start))
+
+	return output, nil
+}
+
+func (cmd *CreateTable) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*dynamodb.CreateTableOutput).TableDescription.TableName)
+}
+
+type UpdateTable struct {
+	_             string `action:"update" entity:"table" awsAPI:"dynamodb" awsCall:"UpdateTable" awsInput:"dynamodb.UpdateTableInput" awsOutput:"dynamodb.UpdateTableOutput"`
+	logger        *logger.Logger
+	graph         cloud.GraphAPI
+	api           dynamodbiface.DynamoDBAPI
+	Name          *string `awsName:"TableName" awsType:"awsstr" templateName:"name"`
+	ReadCapacity  *int64  `awsName:"ProvisionedThroughput.ReadCapacityUnits" awsType:"awsint64" templateName:"read-capacity"`
+	WriteCapacity *int64  `awsName:"ProvisionedThroughput.WriteCapacityUnits" awsType:"awsint64" templateName:"write-capacity"`
+	Stream        *string `awsName:"StreamSpecification.StreamViewType" awsType:"awsstr" templateName:"stream"`
+	StreamEnabled *bool   `awsName:"StreamSpecification.StreamEnabled" awsType:"awsbool"`
+}
+
+func (cmd *UpdateTable) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("name"), params.Opt("read-capacity", "stream", "write-capacity")),
+		params.Validators{
+			"stream": params.IsInEnumIgnoreCase(dynamodb.StreamViewTypeNewImage, dynamodb.StreamViewTypeOldImage, dynamodb.StreamViewTypeNewAndOldImages, dynamodb.StreamViewTypeKeysOnly, streamDisabled),
+		})
+}
+
+func (cmd *UpdateTable) BeforeRun(renv env.Running) error {
+	if (cmd.ReadCapacity == nil) != (cmd.WriteCapacity == nil) {
+		return errors.New("update table: read-capacity and write-capacity must be set together")
+	}
+	if cmd.Stream != nil {
+		if strings.EqualFold(StringValue(cmd.Stream), streamDisabled) {
+			cmd.Stream = nil
+			cmd.StreamEnabled = Bool(false)
+		} else {
+			cmd.Stream = String(strings.ToUpper(StringValue(cmd.Stream)))
+			cmd.StreamEnabled = Bool(true)
+		}
+	}
+	return nil
+}
+
+func (cmd *UpdateTable) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*dynamodb.UpdateTableOutput).TableDescription.TableName)
+}
+
+type DeleteTable struct {
+	_      string `action:"delete" entity:"table" awsAPI:"dynamodb" awsCall:"DeleteTable" awsInput:"dynamodb.DeleteTableInput" awsOutput:"dynamodb.DeleteTableOutput"`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    dynamodbiface.DynamoDBAPI
+	Name   *string `awsName:"TableName" awsType:"awsstr" templateName:"name"`
+}
+
+func (cmd *DeleteTable) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("name")))
+}
+
+// Key attributes are given as name:type where type is S (string), N (number) or B (binary)
+func isKeyAttribute(i interface{}, others map[string]interface{}) error {
+	_, _, err := parseKeyAttribute(fmt.Sprint(i))
+	return err
+}
+
+func parseKeyAttribute(s string) (string, string, error) {
+	splits := strings.Split(s, ":")
+	if len(splits) != 2 || splits[0] == "" {
+		return "", "", fmt.Errorf("invalid key attribute '%s', expected name:type (ex: id:S)", s)
+	}
+	typ := strings.ToUpper(splits[1])
+	switch typ {
+	case dynamodb.ScalarAttributeTypeS, dynamodb.ScalarAttributeTypeN, dynamodb.ScalarAttributeTypeB:
+		return splits[0], typ, nil
+	default:
+		return "", "", fmt.Errorf("invalid type '%s' of key attribute '%s', expected S, N or B", splits[1], splits[0])
+	}
+}
@@ -0,0 +1,111 @@
+/* Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsspec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/template/env"
+	"github.com/wallix/awless/template/params"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/wallix/awless/logger"
+)
+
+type CreateVpcendpoint struct {
+	_              string `action:"create" entity:"vpcendpoint" awsAPI:"ec2" awsCall:"CreateVpcEndpoint" awsInput:"ec2.CreateVpcEndpointInput" awsOutput:"ec2.CreateVpcEndpointOutput" awsDryRun:""`
+	logger         *logger.Logger
+	graph          cloud.GraphAPI
+	api            ec2iface.EC2API
+	Vpc            *string   `awsName:"VpcId" awsType:"awsstr" templateName:"vpc"`
+	Service        *string   `awsName:"ServiceName" awsType:"awsstr" templateName:"service"`
+	Type           *string   `awsName:"VpcEndpointType" awsType:"awsstr" templateName:"type"`
+	Routetables    []*string `awsName:"RouteTableIds" awsType:"awsstringslice" templateName:"routetables"`
+	Subnets        []*string `awsName:"SubnetIds" awsType:"awsstringslice" templateName:"subnets"`
+	Securitygroups []*string `awsName:"SecurityGroupIds" awsType:"awsstringslice" templateName:"securitygroups"`
+	PrivateDNS     *bool     `awsName:"PrivateDnsEnabled" awsType:"awsbool" templateName:"private-dns"`
+	Policy         *string   `awsName:"PolicyDocument" awsType:"awsstr" templateName:"policy"`
+}
+
+func (cmd *CreateVpcendpoint) ParamsSpec() params.Spec {
+	return params.NewSpec(
+		params.AllOf(params.Key("service"), params.Key("type"), params.Key("vpc"),
+			params.Opt("policy", "private-dns", "routetables", "securitygroups", "subnets"),
+		),
+		params.Validators{
+			"type":           params.IsInEnumIgnoreCase(ec2.VpcEndpointTypeGateway, ec2.VpcEndpointTypeInterface),
+			"routetables":    vpcendpointParamOfType(ec2.VpcEndpointTypeGateway),
+			"policy":         vpcendpointParamOfType(ec2.VpcEndpointTypeGateway),
+			"subnets":        vpcendpointParamOfType(ec2.VpcEndpointTypeInterface),
+			"securitygroups": vpcendpointParamOfType(ec2.VpcEndpointTypeInterface),
+			"private-dns":    vpcendpointParamOfType(ec2.VpcEndpointTypeInterface),
+		})
+}
+
+func (cmd *CreateVpcendpoint) BeforeRun(renv env.Running) error {
+	for _, typ := range []string{ec2.VpcEndpointTypeGateway, ec2.VpcEndpointTypeInterface} {
+		if strings.EqualFold(StringValue(cmd.Type), typ) {
+			cmd.Type = String(typ)
+		}
+	}
+	return nil
+}
+
+func (cmd *CreateVpcendpoint) ExtractResult(i interface{}) string {
+	return awssdk.StringValue(i.(*ec2.CreateVpcEndpointOutput).VpcEndpoint.VpcEndpointId)
+}
+
+func vpcendpointParamOfType(expected string) func(interface{}, map[string]interface{}) error {
+	return func(i interface{}, others map[string]interface{}) error {
+		if typ := fmt.Sprint(others["type"]); !strings.EqualFold(typ, expected) {
+			return fmt.Errorf("only available for vpcendpoint of type %s, got %s", strings.ToLower(expected), typ)
+		}
+		return nil
+	}
+}
+
+type DeleteVpcendpoint struct {
+	_      string `action:"delete" entity:"vpcendpoint" awsAPI:"ec2" awsCall:"DeleteVpcEndpoints" awsInput:"ec2.DeleteVpcEndpointsInput" awsOutput:"ec2.DeleteVpcEndpointsOutput" awsDryRun:""`
+	logger *logger.Logger
+	graph  cloud.GraphAPI
+	api    ec2iface.EC2API
+	Id     []*string `awsName:"VpcEndpointIds" awsType:"awsstringslice" templateName:"id"`
+}
+
+func (cmd *DeleteVpcendpoint) ParamsSpec() params.Spec {
+	return params.NewSpec(params.AllOf(params.Key("id")))
+}
+
+func (cmd *DeleteVpcendpoint) AfterRun(renv env.Running, output interface{}) error {
+	out, ok := output.(*ec2.DeleteVpcEndpointsOutput)
+	if !ok || out == nil {
+		return nil
+	}
+	var errs []string
+	for _, item := range out.Unsuccessful {
+		if item.Error != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", StringValue(item.ResourceId), StringValue(item.Error.Message)))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("cannot delete vpcendpoint(s): %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
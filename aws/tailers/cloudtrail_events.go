@@ -0,0 +1,196 @@
+package awstailers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/fatih/color"
+	"github.com/wallix/awless/aws/services"
+)
+
+type cloudtrailEventsTailer struct {
+	resourceNames    []string
+	since            time.Duration
+	follow           bool
+	pollingFrequency time.Duration
+	nbEvents         int
+	lastEventTime    time.Time
+	// events already displayed sharing lastEventTime
+	seenIDs map[string]bool
+
+	// resolveName returns the name of a resource given its ID or ARN, or an empty string
+	resolveName func(string) string
+	// isKnownTemplate tells whether a template execution ID is in the local log
+	isKnownTemplate func(string) bool
+}
+
+// NewCloudTrailEventsTailer tails the CloudTrail events of the given resources (all events when empty).
+// A number of events lower or equal to 0 displays all the events found since the given duration.
+func NewCloudTrailEventsTailer(resourceNames []string, since time.Duration, nbEvents int, follow bool, frequency time.Duration, resolveName func(string) string, isKnownTemplate func(string) bool) *cloudtrailEventsTailer {
+	if resolveName == nil {
+		resolveName = func(string) string { return "" }
+	}
+	if isKnownTemplate == nil {
+		isKnownTemplate = func(string) bool { return false }
+	}
+	return &cloudtrailEventsTailer{
+		resourceNames:    resourceNames,
+		since:            since,
+		nbEvents:         nbEvents,
+		follow:           follow,
+		pollingFrequency: frequency,
+		seenIDs:          make(map[string]bool),
+		resolveName:      resolveName,
+		isKnownTemplate:  isKnownTemplate,
+	}
+}
+
+func (t *cloudtrailEventsTailer) Name() string {
+	return "cloudtrail"
+}
+
+func (t *cloudtrailEventsTailer) Tail(w io.Writer) error {
+	logs, ok := awsservices.LogsService.(*awsservices.Logs)
+	if !ok {
+		return fmt.Errorf("invalid cloud service, expected awsservices.Logs, got %T", awsservices.LogsService)
+	}
+
+	if t.follow && t.pollingFrequency < 5*time.Second {
+		return fmt.Errorf("invalid polling frequency: %s, must be greater than 5s", t.pollingFrequency)
+	}
+
+	events, err := t.fetchEvents(logs, time.Now().Add(-t.since))
+	if err != nil {
+		return err
+	}
+	if t.nbEvents > 0 && len(events) > t.nbEvents {
+		events = events[len(events)-t.nbEvents:]
+	}
+	if err := t.display(events, w); err != nil {
+		return err
+	}
+
+	if !t.follow {
+		return nil
+	}
+
+	ticker := time.NewTicker(t.pollingFrequency)
+	defer ticker.Stop()
+	for range ticker.C {
+		start := t.lastEventTime
+		if start.IsZero() {
+			start = time.Now().Add(-t.pollingFrequency)
+		}
+		events, err := t.fetchEvents(logs, start)
+		if err != nil {
+			return err
+		}
+		if err := t.display(events, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetchEvents returns the not yet displayed events since the given time, oldest first.
+// As the lookup API only accepts one attribute at a time, resources are looked up one after the other.
+func (t *cloudtrailEventsTailer) fetchEvents(logs *awsservices.Logs, start time.Time) ([]*cloudtrail.Event, error) {
+	var inputs []*cloudtrail.LookupEventsInput
+	if len(t.resourceNames) == 0 {
+		inputs = append(inputs, &cloudtrail.LookupEventsInput{StartTime: awssdk.Time(start)})
+	}
+	for _, name := range t.resourceNames {
+		inputs = append(inputs, &cloudtrail.LookupEventsInput{
+			StartTime: awssdk.Time(start),
+			LookupAttributes: []*cloudtrail.LookupAttribute{
+				{AttributeKey: awssdk.String(cloudtrail.LookupAttributeKeyResourceName), AttributeValue: awssdk.String(name)},
+			},
+		})
+	}
+
+	var events []*cloudtrail.Event
+	found := make(map[string]bool)
+	for _, input := range inputs {
+		err := logs.LookupEventsPages(input, func(page *cloudtrail.LookupEventsOutput, lastPage bool) bool {
+			for _, e := range page.Events {
+				id := awssdk.StringValue(e.EventId)
+				if t.seenIDs[id] || found[id] {
+					continue
+				}
+				found[id] = true
+				events = append(events, e)
+			}
+			return page.NextToken != nil
+		})
+		if err != nil {
+			return events, err
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return awssdk.TimeValue(events[i].EventTime).Before(awssdk.TimeValue(events[j].EventTime))
+	})
+	return events, nil
+}
+
+func (t *cloudtrailEventsTailer) display(events []*cloudtrail.Event, w io.Writer) error {
+	for _, e := range events {
+		stamp := awssdk.TimeValue(e.EventTime)
+		if stamp.After(t.lastEventTime) {
+			t.lastEventTime = stamp
+			t.seenIDs = make(map[string]bool)
+		}
+		if stamp.Equal(t.lastEventTime) {
+			t.seenIDs[awssdk.StringValue(e.EventId)] = true
+		}
+
+		var resources []string
+		for _, r := range e.Resources {
+			name := awssdk.StringValue(r.ResourceName)
+			if resolved := t.resolveName(name); resolved != "" {
+				name = "@" + resolved
+			}
+			resources = append(resources, name)
+		}
+
+		line := fmt.Sprintf("%s %s %s %s", color.New(color.FgYellow).Sprint(stamp.UTC().Format(time.RFC3339)),
+			color.New(color.FgCyan).Sprint(awssdk.StringValue(e.Username)), awssdk.StringValue(e.EventName), strings.Join(resources, ", "))
+		if fromAwless, templateID := awlessOrigin(awssdk.StringValue(e.CloudTrailEvent)); templateID != "" && t.isKnownTemplate(templateID) {
+			line += color.New(color.FgGreen).Sprintf(" (awless template %s)", templateID)
+		} else if fromAwless {
+			line += color.New(color.FgGreen).Sprint(" (awless)")
+		}
+		if _, err := fmt.Fprintln(w, strings.TrimSpace(line)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// awlessOrigin tells from the user agent of a raw CloudTrail event whether it was made
+// by awless, and if so, the ID of the template execution it belongs to, if any
+func awlessOrigin(rawEvent string) (fromAwless bool, templateID string) {
+	var event struct {
+		UserAgent string `json:"userAgent"`
+	}
+	if err := json.Unmarshal([]byte(rawEvent), &event); err != nil {
+		return
+	}
+	for _, field := range strings.Fields(strings.Trim(event.UserAgent, "[]")) {
+		field = strings.Trim(field, "[]")
+		switch {
+		case field == awsservices.UserAgent:
+			fromAwless = true
+		case strings.HasPrefix(field, awsservices.TemplateUserAgentPrefix):
+			fromAwless = true
+			templateID = strings.TrimPrefix(field, awsservices.TemplateUserAgentPrefix)
+		}
+	}
+	return
+}
@@ -0,0 +1,27 @@
+package awstailers
+
+import "testing"
+
+func TestAwlessOrigin(t *testing.T) {
+	tcases := []struct {
+		event      string
+		fromAwless bool
+		templateID string
+	}{
+		{event: `{"userAgent": "aws-sdk-go/1.12.8 (go1.9; linux; amd64) awless"}`, fromAwless: true},
+		{event: `{"userAgent": "[aws-sdk-go/1.12.8 (go1.9; linux; amd64) awless awless-template/01BZ8XKPB4Y5VY5ZXRVG2H1T9P]"}`, fromAwless: true, templateID: "01BZ8XKPB4Y5VY5ZXRVG2H1T9P"},
+		{event: `{"userAgent": "aws-cli/1.11.13 Python/2.7.12 Linux/4.4.0"}`},
+		{event: `{"userAgent": "aws-cli/1.11.13 awlessly/1.0"}`},
+		{event: `{"eventName": "RunInstances"}`},
+		{event: `not json`},
+	}
+	for i, tcase := range tcases {
+		fromAwless, templateID := awlessOrigin(tcase.event)
+		if got, want := fromAwless, tcase.fromAwless; got != want {
+			t.Fatalf("%d: got %t, want %t", i+1, got, want)
+		}
+		if got, want := templateID, tcase.templateID; got != want {
+			t.Fatalf("%d: got %s, want %s", i+1, got, want)
+		}
+	}
+}
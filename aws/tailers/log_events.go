@@ -0,0 +1,160 @@
+package awstailers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/fatih/color"
+	"github.com/wallix/awless/aws/services"
+)
+
+type logEventsTailer struct {
+	groupName        string
+	streamPrefix     string
+	filterPattern    string
+	since            time.Duration
+	follow           bool
+	pollingFrequency time.Duration
+	nbEvents         int
+	lastEventTime    int64
+	// events already displayed sharing lastEventTime
+	seenIDs map[string]bool
+}
+
+// NewLogEventsTailer tails the events of a log group.
+// A number of events lower or equal to 0 displays all the events found since the given duration.
+func NewLogEventsTailer(groupName, streamPrefix, filterPattern string, since time.Duration, nbEvents int, follow bool, frequency time.Duration) *logEventsTailer {
+	return &logEventsTailer{
+		groupName:        groupName,
+		streamPrefix:     streamPrefix,
+		filterPattern:    filterPattern,
+		since:            since,
+		nbEvents:         nbEvents,
+		follow:           follow,
+		pollingFrequency: frequency,
+		seenIDs:          make(map[string]bool),
+	}
+}
+
+func (t *logEventsTailer) Name() string {
+	return "logs"
+}
+
+func (t *logEventsTailer) Tail(w io.Writer) error {
+	logs, ok := awsservices.LogsService.(*awsservices.Logs)
+	if !ok {
+		return fmt.Errorf("invalid cloud service, expected awsservices.Logs, got %T", awsservices.LogsService)
+	}
+
+	if t.follow && t.pollingFrequency < 5*time.Second {
+		return fmt.Errorf("invalid polling frequency: %s, must be greater than 5s", t.pollingFrequency)
+	}
+
+	events, err := t.fetchLastEvents(logs, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := t.display(events, w); err != nil {
+		return err
+	}
+
+	if !t.follow {
+		return nil
+	}
+
+	ticker := time.NewTicker(t.pollingFrequency)
+	defer ticker.Stop()
+	for range ticker.C {
+		start := t.lastEventTime
+		if start == 0 {
+			start = time.Now().Add(-t.pollingFrequency).UnixNano() / int64(time.Millisecond)
+		}
+		events, err := t.fetchEvents(logs, start)
+		if err != nil {
+			return err
+		}
+		if err := t.display(events, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// initialLogEventsWindow is the first time window searched for the last events of a log group
+const initialLogEventsWindow = time.Minute
+
+// fetchLastEvents returns the last events since the tailer duration, oldest first. As events
+// can only be filtered oldest first, the searched window is doubled until it holds enough events
+// rather than paging through all the events of the duration.
+func (t *logEventsTailer) fetchLastEvents(api cloudwatchlogsiface.CloudWatchLogsAPI, now time.Time) ([]*cloudwatchlogs.FilteredLogEvent, error) {
+	window := initialLogEventsWindow
+	if t.nbEvents <= 0 || window > t.since {
+		window = t.since
+	}
+	for {
+		events, err := t.fetchEvents(api, now.Add(-window).UnixNano()/int64(time.Millisecond))
+		if err != nil {
+			return nil, err
+		}
+		if t.nbEvents > 0 && len(events) >= t.nbEvents {
+			return events[len(events)-t.nbEvents:], nil
+		}
+		if window >= t.since {
+			return events, nil
+		}
+		if window *= 2; window > t.since {
+			window = t.since
+		}
+	}
+}
+
+// fetchEvents returns the not yet displayed events since the given time (in milliseconds), oldest first.
+// As the filter API only selects streams by exact names, streams are matched on prefix client side.
+func (t *logEventsTailer) fetchEvents(api cloudwatchlogsiface.CloudWatchLogsAPI, start int64) ([]*cloudwatchlogs.FilteredLogEvent, error) {
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: awssdk.String(t.groupName),
+		StartTime:    awssdk.Int64(start),
+		Interleaved:  awssdk.Bool(true),
+	}
+	if t.filterPattern != "" {
+		input.FilterPattern = awssdk.String(t.filterPattern)
+	}
+
+	var events []*cloudwatchlogs.FilteredLogEvent
+	err := api.FilterLogEventsPages(input, func(page *cloudwatchlogs.FilterLogEventsOutput, lastPage bool) bool {
+		for _, e := range page.Events {
+			if t.seenIDs[awssdk.StringValue(e.EventId)] {
+				continue
+			}
+			if t.streamPrefix != "" && !strings.HasPrefix(awssdk.StringValue(e.LogStreamName), t.streamPrefix) {
+				continue
+			}
+			events = append(events, e)
+		}
+		return page.NextToken != nil
+	})
+	return events, err
+}
+
+func (t *logEventsTailer) display(events []*cloudwatchlogs.FilteredLogEvent, w io.Writer) error {
+	for _, e := range events {
+		stamp := awssdk.Int64Value(e.Timestamp)
+		if stamp > t.lastEventTime {
+			t.lastEventTime = stamp
+			t.seenIDs = make(map[string]bool)
+		}
+		if stamp == t.lastEventTime {
+			t.seenIDs[awssdk.StringValue(e.EventId)] = true
+		}
+		ts := time.Unix(0, stamp*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+		if _, err := fmt.Fprintf(w, "%s %s %s\n", color.New(color.FgYellow).Sprint(ts), color.New(color.FgCyan).Sprint(awssdk.StringValue(e.LogStreamName)), strings.TrimRight(awssdk.StringValue(e.Message), "\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
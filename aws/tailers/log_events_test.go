@@ -0,0 +1,134 @@
+package awstailers
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/fatih/color"
+)
+
+func TestFetchLogEvents(t *testing.T) {
+	api := &logEventsMock{events: []*cloudwatchlogs.FilteredLogEvent{
+		logEvent("1", "web/1", 1000, "started"),
+		logEvent("2", "worker/1", 1000, "job done"),
+		logEvent("3", "web/2", 2000, "GET /"),
+		logEvent("4", "web/1", 3000, "GET /health"),
+	}}
+	tailer := NewLogEventsTailer("app", "web/", "", time.Hour, 0, false, 0)
+
+	events, err := tailer.fetchEvents(api, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := eventIDs(events), []string{"1", "3", "4"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	color.NoColor = true
+	var buf bytes.Buffer
+	if err := tailer.display(events, &buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := "1970-01-01T00:00:01Z web/1 started\n" +
+		"1970-01-01T00:00:02Z web/2 GET /\n" +
+		"1970-01-01T00:00:03Z web/1 GET /health\n"
+	if got, want := buf.String(), expected; got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+
+	api.events = append(api.events, logEvent("5", "web/2", 3000, "GET /login"))
+	events, err = tailer.fetchEvents(api, tailer.lastEventTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := eventIDs(events), []string{"5"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestFetchLastLogEvents(t *testing.T) {
+	now := time.Unix(3600, 0)
+	ago := func(d time.Duration) int64 { return now.Add(-d).UnixNano() / int64(time.Millisecond) }
+	api := &logEventsMock{events: []*cloudwatchlogs.FilteredLogEvent{
+		logEvent("1", "web/1", ago(50*time.Minute), "started"),
+		logEvent("2", "web/1", ago(3*time.Minute), "GET /"),
+		logEvent("3", "web/1", ago(90*time.Second), "GET /health"),
+		logEvent("4", "web/1", ago(30*time.Second), "GET /login"),
+	}}
+
+	tcases := []struct {
+		since     time.Duration
+		nbEvents  int
+		expIDs    []string
+		expStarts []int64
+	}{
+		{since: time.Hour, nbEvents: 1, expIDs: []string{"4"}, expStarts: []int64{ago(time.Minute)}},
+		{since: time.Hour, nbEvents: 2, expIDs: []string{"3", "4"}, expStarts: []int64{ago(time.Minute), ago(2 * time.Minute)}},
+		{since: time.Hour, nbEvents: 3, expIDs: []string{"2", "3", "4"}, expStarts: []int64{ago(time.Minute), ago(2 * time.Minute), ago(4 * time.Minute)}},
+		{since: 5 * time.Minute, nbEvents: 10, expIDs: []string{"2", "3", "4"}, expStarts: []int64{ago(time.Minute), ago(2 * time.Minute), ago(4 * time.Minute), ago(5 * time.Minute)}},
+		{since: time.Hour, nbEvents: 0, expIDs: []string{"1", "2", "3", "4"}, expStarts: []int64{ago(time.Hour)}},
+		{since: 30 * time.Second, nbEvents: 5, expIDs: []string{"4"}, expStarts: []int64{ago(30 * time.Second)}},
+	}
+	for i, tcase := range tcases {
+		api.starts = nil
+		tailer := NewLogEventsTailer("app", "", "", tcase.since, tcase.nbEvents, false, 0)
+		events, err := tailer.fetchLastEvents(api, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := eventIDs(events), tcase.expIDs; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+		if got, want := api.starts, tcase.expStarts; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got start times %v, want %v", i+1, got, want)
+		}
+	}
+}
+
+type logEventsMock struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	events []*cloudwatchlogs.FilteredLogEvent
+	starts []int64
+}
+
+// FilterLogEventsPages returns the events since the input start time, two per page
+func (m *logEventsMock) FilterLogEventsPages(input *cloudwatchlogs.FilterLogEventsInput, fn func(*cloudwatchlogs.FilterLogEventsOutput, bool) bool) error {
+	start := awssdk.Int64Value(input.StartTime)
+	m.starts = append(m.starts, start)
+	var matching []*cloudwatchlogs.FilteredLogEvent
+	for _, e := range m.events {
+		if awssdk.Int64Value(e.Timestamp) >= start {
+			matching = append(matching, e)
+		}
+	}
+	for i := 0; i < len(matching); i += 2 {
+		end := i + 2
+		if end > len(matching) {
+			end = len(matching)
+		}
+		page := &cloudwatchlogs.FilterLogEventsOutput{Events: matching[i:end]}
+		if end < len(matching) {
+			page.NextToken = awssdk.String("next")
+		}
+		if !fn(page, end == len(matching)) {
+			break
+		}
+	}
+	return nil
+}
+
+func logEvent(id, stream string, timestamp int64, msg string) *cloudwatchlogs.FilteredLogEvent {
+	return &cloudwatchlogs.FilteredLogEvent{EventId: awssdk.String(id), LogStreamName: awssdk.String(stream), Timestamp: awssdk.Int64(timestamp), Message: awssdk.String(msg + "\n")}
+}
+
+func eventIDs(events []*cloudwatchlogs.FilteredLogEvent) (ids []string) {
+	for _, e := range events {
+		ids = append(ids, awssdk.StringValue(e.EventId))
+	}
+	return
+}
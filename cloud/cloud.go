@@ -46,6 +46,8 @@ const (
 	Snapshot         string = "snapshot"
 	NetworkInterface string = "networkinterface"
 	Certificate      string = "certificate"
+	VpcPeering       string = "vpcpeering"
+	VpcEndpoint      string = "vpcendpoint"
 	//loadbalancer
 	ClassicLoadBalancer string = "classicloadbalancer"
 	LoadBalancer        string = "loadbalancer"
@@ -54,6 +56,9 @@ const (
 	//database
 	Database      string = "database"
 	DbSubnetGroup string = "dbsubnetgroup"
+	//cache
+	CacheCluster     string = "cachecluster"
+	ReplicationGroup string = "replicationgroup"
 	//access
 	User         string = "user"
 	Role         string = "role"
@@ -66,16 +71,23 @@ const (
 	Bucket   string = "bucket"
 	S3Object string = "s3object"
 	Acl      string = "storageacl"
+	//filesystem
+	FileSystem  string = "filesystem"
+	MountTarget string = "mounttarget"
 	//notification
 	Subscription string = "subscription"
 	Topic        string = "topic"
 	//queue
 	Queue string = "queue"
 	//dns
-	Zone   string = "zone"
-	Record string = "record"
+	Zone        string = "zone"
+	Record      string = "record"
+	HealthCheck string = "healthcheck"
 	//lambda
-	Function string = "function"
+	Function     string = "function"
+	RestApi      string = "restapi"
+	Stage        string = "stage"
+	StateMachine string = "statemachine"
 	//autoscaling
 	LaunchConfiguration string = "launchconfiguration"
 	ScalingGroup        string = "scalinggroup"
@@ -83,10 +95,18 @@ const (
 	//monitoring
 	Metric string = "metric"
 	Alarm  string = "alarm"
+	//logs
+	LogGroup string = "loggroup"
 	//cdn
 	Distribution string = "distribution"
 	//cloudformation
 	Stack string = "stack"
+	//dynamodb
+	Table       string = "table"
+	GlobalTable string = "globaltable"
+	//security
+	Key       string = "key"
+	Parameter string = "parameter"
 	//container
 	Repository        string = "repository"
 	Registry          string = "registry"
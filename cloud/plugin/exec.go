@@ -0,0 +1,288 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/cloud/rdf"
+	"github.com/wallix/awless/graph"
+)
+
+const (
+	ManifestFilename = "manifest.json"
+
+	FetchAction = "fetch"
+)
+
+// Request is written as JSON on the stdin of external plugins
+type Request struct {
+	Action  string `json:"action"`
+	Profile string `json:"profile"`
+	Region  string `json:"region"`
+	// ResourceType to fetch only, all types of the plugin when empty
+	ResourceType string `json:"resourceType,omitempty"`
+	// Filters given by the user when listing (ex: team=ops)
+	Filters []string `json:"filters,omitempty"`
+}
+
+// Response is read as JSON on the stdout of external plugins
+type Response struct {
+	Resources []*Resource `json:"resources"`
+	Error     string      `json:"error,omitempty"`
+}
+
+type Resource struct {
+	Type       string                 `json:"type"`
+	Id         string                 `json:"id"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+	// Parents of the resource (ex: the instance of a CMDB record)
+	Parents []Ref `json:"parents,omitempty"`
+	// DependsOn are the resources the resource applies on (ex: the security groups of an instance)
+	DependsOn []Ref `json:"dependsOn,omitempty"`
+}
+
+// Ref references a resource of any service (ex: {"type": "instance", "id": "i-123"})
+type Ref struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+type execPlugin struct {
+	manifest *Manifest
+	dir      string
+}
+
+// LoadDir registers the external plugins declared by the manifests of the given directory (<dir>/<plugin>/manifest.json).
+// Invalid plugins are reported in the returned error while valid ones are still registered.
+func LoadDir(dir string) error {
+	paths, _ := filepath.Glob(filepath.Join(dir, "*", ManifestFilename))
+	var errs []string
+	for _, path := range paths {
+		p, err := loadManifest(path)
+		if err == nil {
+			err = Register(p)
+		}
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("loading plugins: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func loadManifest(path string) (*execPlugin, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	if err = json.Unmarshal(b, m); err != nil {
+		return nil, fmt.Errorf("plugin manifest %s: %s", path, err)
+	}
+	if len(m.Command) == 0 {
+		return nil, fmt.Errorf("plugin %s: missing command in %s", m.Name, path)
+	}
+	return &execPlugin{manifest: m, dir: filepath.Dir(path)}, nil
+}
+
+func (p *execPlugin) Manifest() *Manifest {
+	return p.manifest
+}
+
+func (p *execPlugin) NewService(profile, region string) (cloud.Service, error) {
+	command := append([]string{}, p.manifest.Command...)
+	if !filepath.IsAbs(command[0]) && strings.ContainsRune(command[0], filepath.Separator) {
+		command[0] = filepath.Join(p.dir, command[0])
+	}
+	if p.manifest.Global {
+		region = "global"
+	}
+	return &execService{manifest: p.manifest, command: command, profile: profile, region: region}, nil
+}
+
+type execService struct {
+	manifest        *Manifest
+	command         []string
+	profile, region string
+}
+
+func (s *execService) Region() string          { return s.region }
+func (s *execService) Profile() string         { return s.profile }
+func (s *execService) Name() string            { return s.manifest.Name }
+func (s *execService) ResourceTypes() []string { return s.manifest.ResourceTypes }
+func (s *execService) IsSyncDisabled() bool    { return false }
+
+func (s *execService) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
+	return s.fetch(ctx, "", s.manifest.ResourceTypes)
+}
+
+func (s *execService) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
+	return s.fetch(ctx, t, []string{t})
+}
+
+func (s *execService) fetch(ctx context.Context, resourceType string, types []string) (*graph.Graph, error) {
+	req := &Request{Action: FetchAction, Profile: s.profile, Region: s.region, ResourceType: resourceType}
+	req.Filters, _ = ctx.Value("filters").([]string)
+
+	g := graph.NewGraph()
+	resources, err := s.call(ctx, req)
+	if err == nil {
+		err = s.addResources(g, resources, types)
+	}
+
+	now := time.Now().UTC()
+	for _, t := range types {
+		f := &graph.Freshness{ResourceType: t, FetchedAt: now}
+		if err != nil {
+			f.Err = err.Error()
+		} else {
+			for _, res := range resources {
+				if res.Type == t {
+					f.Count++
+				}
+			}
+		}
+		g.SetFreshness(f)
+	}
+	return g, err
+}
+
+func (s *execService) call(ctx context.Context, req *Request) ([]*Resource, error) {
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.command[0], s.command[1:]...)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(), "AWLESS_PLUGIN="+s.manifest.Name)
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("plugin %s: %s: %s", s.manifest.Name, err, msg)
+		}
+		return nil, fmt.Errorf("plugin %s: %s", s.manifest.Name, err)
+	}
+	resp := &Response{}
+	if err := json.Unmarshal(stdout.Bytes(), resp); err != nil {
+		return nil, fmt.Errorf("plugin %s: invalid response: %s", s.manifest.Name, err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("plugin %s: %s", s.manifest.Name, resp.Error)
+	}
+	return resp.Resources, nil
+}
+
+func (s *execService) addResources(g *graph.Graph, resources []*Resource, types []string) error {
+	expected := make(map[string]bool)
+	for _, t := range types {
+		expected[t] = true
+	}
+	for _, r := range resources {
+		if !expected[r.Type] {
+			return fmt.Errorf("plugin %s: unexpected resource type '%s' for resource '%s'", s.manifest.Name, r.Type, r.Id)
+		}
+		if r.Id == "" {
+			return fmt.Errorf("plugin %s: %s without id", s.manifest.Name, r.Type)
+		}
+		res, err := r.toGraphResource()
+		if err != nil {
+			return fmt.Errorf("plugin %s: %s", s.manifest.Name, err)
+		}
+		if err := g.AddResource(res); err != nil {
+			return fmt.Errorf("plugin %s: %s", s.manifest.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *Resource) toGraphResource() (*graph.Resource, error) {
+	res := graph.InitResource(r.Type, r.Id)
+	res.SetProperty(properties.ID, r.Id)
+	for label, value := range r.Properties {
+		v, err := convertProperty(label, value)
+		if err != nil {
+			return res, fmt.Errorf("%s %s: %s", r.Type, r.Id, err)
+		}
+		res.SetProperty(label, v)
+	}
+	for _, parent := range r.Parents {
+		res.AddRelation(rdf.ChildrenOfRel, graph.InitResource(parent.Type, parent.Id))
+	}
+	for _, dep := range r.DependsOn {
+		res.AddRelation(rdf.DependingOnRel, graph.InitResource(dep.Type, dep.Id))
+	}
+	return res, nil
+}
+
+// convertProperty converts a JSON decoded value to the type of the RDF property with the given label
+func convertProperty(label string, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	id, ok := rdf.Labels[label]
+	if !ok {
+		return nil, fmt.Errorf("unknown property '%s' (declare it in the plugin manifest)", label)
+	}
+	prop, err := rdf.Properties.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if prop.RdfsDefinedBy == rdf.RdfsList {
+		list, ok := value.([]interface{})
+		if !ok || prop.RdfsDataType != rdf.XsdString {
+			return nil, fmt.Errorf("property '%s': expecting a list of strings, got %v", label, value)
+		}
+		var strs []string
+		for _, v := range list {
+			strs = append(strs, fmt.Sprint(v))
+		}
+		return strs, nil
+	}
+	switch prop.RdfsDataType {
+	case rdf.XsdInt:
+		if f, ok := value.(float64); ok {
+			return int(f), nil
+		}
+	case rdf.XsdBoolean:
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+	case rdf.XsdDateTime:
+		if str, ok := value.(string); ok {
+			return time.Parse(time.RFC3339, str)
+		}
+	default:
+		return fmt.Sprint(value), nil
+	}
+	return nil, fmt.Errorf("property '%s': unexpected value %v for type %s", label, value, prop.RdfsDataType)
+}
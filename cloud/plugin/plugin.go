@@ -0,0 +1,180 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package plugin provides third-party cloud services to awless (ex: records of an internal CMDB),
+synced, listed, shown and queried as the AWS ones.
+
+A plugin is either:
+
+  - a Go implementation of the Plugin interface, registered with Register in the init function
+    of its package, compiled in awless with a blank import in commands/plugins.go.
+  - an external executable declared by a manifest file in $HOME/.awless/plugins/<name>/manifest.json,
+    speaking JSON over stdin/stdout.
+
+A manifest declares the service name, its resource types and the RDF schema additions for the
+properties of its resources (properties already known to awless, such as Name, State or Tags, can be used as is):
+
+	{
+	  "name": "cmdb",
+	  "command": ["./awless-cmdb", "--endpoint", "https://cmdb.internal"],
+	  "global": true,
+	  "resourceTypes": ["cmdbrecord"],
+	  "properties": [
+	    {"label": "Team", "rdfId": "cmdb:team"},
+	    {"label": "Criticality", "rdfId": "cmdb:criticality", "dataType": "xsd:int"},
+	    {"label": "Contacts", "rdfId": "cmdb:contacts", "list": true}
+	  ]
+	}
+
+For each fetch, the command (a relative path is resolved from the manifest directory) is run with a Request
+as JSON on its stdin, and must write a Response as JSON on its stdout before exiting, ex:
+
+	stdin:  {"action": "fetch", "profile": "default", "region": "eu-west-1", "resourceType": "cmdbrecord"}
+	stdout: {"resources": [{"type": "cmdbrecord", "id": "rec-1", "properties": {"Name": "redis", "Team": "ops"},
+	         "parents": [{"type": "instance", "id": "i-123"}]}]}
+
+A non-zero exit status or a non-empty "error" field in the response fails the fetch.
+*/
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	gosync "sync"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/rdf"
+)
+
+// Plugin provides an extra cloud service, with its resource types and RDF schema additions
+type Plugin interface {
+	Manifest() *Manifest
+	NewService(profile, region string) (cloud.Service, error)
+}
+
+type Manifest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Command run by external plugins (not used by Go plugins)
+	Command []string `json:"command,omitempty"`
+	// Global services have resources not bound to a region, synced once per profile
+	Global        bool       `json:"global,omitempty"`
+	ResourceTypes []string   `json:"resourceTypes"`
+	Properties    []Property `json:"properties,omitempty"`
+}
+
+// Property is an RDF schema addition for the properties of the plugin resources
+type Property struct {
+	// Label as displayed and queried (ex: Team)
+	Label string `json:"label"`
+	// RdfId of the property (ex: cmdb:team)
+	RdfId string `json:"rdfId"`
+	// DataType of the values: xsd:string (default), xsd:int, xsd:boolean or xsd:dateTime
+	DataType string `json:"dataType,omitempty"`
+	// List for multi-valued properties (only of xsd:string values)
+	List bool `json:"list,omitempty"`
+}
+
+var (
+	mu       gosync.Mutex
+	registry = make(map[string]Plugin)
+)
+
+// Register validates the manifest of the plugin and adds its properties to the RDF schema
+func Register(p Plugin) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	m := p.Manifest()
+	if err := m.validate(); err != nil {
+		return err
+	}
+	if _, ok := registry[m.Name]; ok {
+		return fmt.Errorf("plugin %s: already registered", m.Name)
+	}
+	for _, prop := range m.Properties {
+		if prop.RdfId == "" {
+			continue
+		}
+		definedBy := rdf.RdfsLiteral
+		if prop.List {
+			definedBy = rdf.RdfsList
+		}
+		if err := rdf.RegisterProperty(prop.Label, prop.RdfId, definedBy, prop.dataType()); err != nil {
+			return fmt.Errorf("plugin %s: %s", m.Name, err)
+		}
+	}
+	registry[m.Name] = p
+	return nil
+}
+
+// All returns the registered plugins sorted by name
+func All() (all []Plugin) {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, p := range registry {
+		all = append(all, p)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Manifest().Name < all[j].Manifest().Name })
+	return
+}
+
+func (m *Manifest) validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("plugin manifest: missing name")
+	}
+	if len(m.ResourceTypes) == 0 {
+		return fmt.Errorf("plugin %s: no resource types declared", m.Name)
+	}
+	for _, t := range m.ResourceTypes {
+		if t == "" || strings.ToLower(t) != t || strings.ContainsAny(t, " :/") {
+			return fmt.Errorf("plugin %s: invalid resource type '%s': expecting a lowercase word", m.Name, t)
+		}
+	}
+	for _, prop := range m.Properties {
+		if prop.Label == "" {
+			return fmt.Errorf("plugin %s: property without label", m.Name)
+		}
+		if prop.RdfId == "" {
+			if _, ok := rdf.Labels[prop.Label]; !ok {
+				return fmt.Errorf("plugin %s: property '%s': missing rdfId (ex: %s:%s)", m.Name, prop.Label, m.Name, strings.ToLower(prop.Label))
+			}
+			continue
+		}
+		if !strings.Contains(prop.RdfId, ":") {
+			return fmt.Errorf("plugin %s: property '%s': invalid rdfId '%s': expecting a prefixed id (ex: %s:%s)", m.Name, prop.Label, prop.RdfId, m.Name, strings.ToLower(prop.Label))
+		}
+		switch prop.dataType() {
+		case rdf.XsdString:
+		case rdf.XsdInt, rdf.XsdBoolean, rdf.XsdDateTime:
+			if prop.List {
+				return fmt.Errorf("plugin %s: property '%s': only lists of xsd:string are supported", m.Name, prop.Label)
+			}
+		default:
+			return fmt.Errorf("plugin %s: property '%s': unsupported data type '%s'", m.Name, prop.Label, prop.DataType)
+		}
+	}
+	return nil
+}
+
+func (p Property) dataType() string {
+	if p.DataType == "" {
+		return rdf.XsdString
+	}
+	return p.DataType
+}
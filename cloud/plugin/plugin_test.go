@@ -0,0 +1,161 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/rdf"
+	"github.com/wallix/awless/graph"
+)
+
+func TestExecPlugin(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	manifest := &Manifest{
+		Name:          "cmdb",
+		Command:       []string{os.Args[0], "-test.run=TestHelperProcess", "--"},
+		ResourceTypes: []string{"cmdbrecord"},
+		Properties: []Property{
+			{Label: "Name"},
+			{Label: "Team", RdfId: "cmdb:team"},
+			{Label: "Criticality", RdfId: "cmdb:criticality", DataType: "xsd:int"},
+			{Label: "Contacts", RdfId: "cmdb:contacts", List: true},
+		},
+	}
+	os.MkdirAll(filepath.Join(dir, "cmdb"), 0700)
+	b, _ := json.Marshal(manifest)
+	if err = ioutil.WriteFile(filepath.Join(dir, "cmdb", ManifestFilename), b, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = LoadDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rdf.Labels["Team"], "cmdb:team"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	var p Plugin
+	for _, registered := range All() {
+		if registered.Manifest().Name == "cmdb" {
+			p = registered
+		}
+	}
+	if p == nil {
+		t.Fatal("expected cmdb plugin registered")
+	}
+
+	srv, err := p.NewService("default", "eu-west-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("GO_WANT_HELPER_PROCESS", "1")
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+
+	g, err := srv.Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded := graph.NewGraph()
+	if err = reloaded.Unmarshal([]byte(g.(*graph.Graph).MustMarshal())); err != nil {
+		t.Fatal(err)
+	}
+	rec, err := reloaded.GetResource("cmdbrecord", "rec-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rec.Properties()["Team"], "ops"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := rec.Properties()["Criticality"], 2; got != want {
+		t.Fatalf("got %v (%T), want %v", got, got, want)
+	}
+	contacts, _ := rec.Properties()["Contacts"].([]string)
+	sort.Strings(contacts)
+	if got, want := fmt.Sprint(contacts), "[alice bob]"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	// parent synced by another service
+	if err = reloaded.AddResource(graph.InitResource(cloud.Instance, "i-123")); err != nil {
+		t.Fatal(err)
+	}
+	parents, err := reloaded.ResourceRelations(rec, rdf.ParentOf, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parents) != 1 || parents[0].Id() != "i-123" {
+		t.Fatalf("unexpected parents %v", parents)
+	}
+	freshness, err := reloaded.Freshness()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := freshness["cmdbrecord"].Count, 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	t.Run("plugin error", func(t *testing.T) {
+		_, err := srv.FetchByType(context.WithValue(context.Background(), "filters", []string{"fail=true"}), "cmdbrecord")
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if got, want := err.Error(), "plugin cmdb: CMDB unreachable"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
+
+func TestManifestValidation(t *testing.T) {
+	tcases := []struct {
+		manifest *Manifest
+		valid    bool
+	}{
+		{&Manifest{Name: "valid", ResourceTypes: []string{"record"}, Properties: []Property{{Label: "State"}, {Label: "Level", RdfId: "valid:level", DataType: "xsd:int"}}}, true},
+		{&Manifest{ResourceTypes: []string{"record"}}, false},
+		{&Manifest{Name: "notypes"}, false},
+		{&Manifest{Name: "badtype", ResourceTypes: []string{"CMDB Record"}}, false},
+		{&Manifest{Name: "unknownlabel", ResourceTypes: []string{"record"}, Properties: []Property{{Label: "Unknown"}}}, false},
+		{&Manifest{Name: "badid", ResourceTypes: []string{"record"}, Properties: []Property{{Label: "Level", RdfId: "level"}}}, false},
+		{&Manifest{Name: "badlist", ResourceTypes: []string{"record"}, Properties: []Property{{Label: "Levels", RdfId: "badlist:levels", DataType: "xsd:int", List: true}}}, false},
+	}
+	for i, tcase := range tcases {
+		if err := tcase.manifest.validate(); (err == nil) != tcase.valid {
+			t.Fatalf("%d: got error %v, expected valid: %t", i+1, err, tcase.valid)
+		}
+	}
+}
+
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	defer os.Exit(0)
+
+	req := &Request{}
+	if err := json.NewDecoder(os.Stdin).Decode(req); err != nil {
+		fmt.Fprint(os.Stderr, err)
+		os.Exit(1)
+	}
+	resp := &Response{}
+	switch {
+	case len(req.Filters) > 0:
+		resp.Error = "CMDB unreachable"
+	case req.Action == FetchAction && os.Getenv("AWLESS_PLUGIN") == "cmdb":
+		resp.Resources = []*Resource{
+			{Type: "cmdbrecord", Id: "rec-1", Properties: map[string]interface{}{"Name": "redis", "Team": "ops", "Criticality": 2, "Contacts": []string{"alice", "bob"}}, Parents: []Ref{{Type: cloud.Instance, Id: "i-123"}}},
+			{Type: "cmdbrecord", Id: "rec-2", Properties: map[string]interface{}{"Name": "web"}},
+		}
+	}
+	json.NewEncoder(os.Stdout).Encode(resp)
+}
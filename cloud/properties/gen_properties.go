@@ -46,7 +46,9 @@ const (
 	AvailabilityZone                  = "AvailabilityZone"
 	AvailabilityZones                 = "AvailabilityZones"
 	BackupRetentionPeriod             = "BackupRetentionPeriod"
+	BillingMode                       = "BillingMode"
 	Bucket                            = "Bucket"
+	CacheSubnetGroup                  = "CacheSubnetGroup"
 	CallerReference                   = "CallerReference"
 	Capabilities                      = "Capabilities"
 	Certificate                       = "Certificate"
@@ -73,7 +75,14 @@ const (
 	Continent                         = "Continent"
 	Cooldown                          = "Cooldown"
 	CopyTagsToSnapshot                = "CopyTagsToSnapshot"
+	Cost                              = "Cost"
 	Country                           = "Country"
+	CPUAvg1h                          = "CPUAvg1h"
+	CPUAvg24h                         = "CPUAvg24h"
+	CPUAvg7d                          = "CPUAvg7d"
+	CPUMax1h                          = "CPUMax1h"
+	CPUMax24h                         = "CPUMax24h"
+	CPUMax7d                          = "CPUMax7d"
 	Created                           = "Created"
 	DBSecurityGroups                  = "DBSecurityGroups"
 	DBSubnetGroup                     = "DBSubnetGroup"
@@ -90,17 +99,27 @@ const (
 	Document                          = "Document"
 	Enabled                           = "Enabled"
 	Encrypted                         = "Encrypted"
+	EncryptionKey                     = "EncryptionKey"
 	Endpoint                          = "Endpoint"
 	Engine                            = "Engine"
 	EngineVersion                     = "EngineVersion"
 	ExitCode                          = "ExitCode"
 	Failover                          = "Failover"
+	FileSystem                        = "FileSystem"
 	Fingerprint                       = "Fingerprint"
+	FreeStorageAvg1h                  = "FreeStorageAvg1h"
+	FreeStorageAvg24h                 = "FreeStorageAvg24h"
+	FreeStorageAvg7d                  = "FreeStorageAvg7d"
+	FreeStorageMax1h                  = "FreeStorageMax1h"
+	FreeStorageMax24h                 = "FreeStorageMax24h"
+	FreeStorageMax7d                  = "FreeStorageMax7d"
+	Functions                         = "Functions"
 	GlobalID                          = "GlobalID"
 	GranteeType                       = "GranteeType"
 	Grants                            = "Grants"
 	Handler                           = "Handler"
 	Hash                              = "Hash"
+	HashKey                           = "HashKey"
 	HealthCheck                       = "HealthCheck"
 	HealthCheckGracePeriod            = "HealthCheckGracePeriod"
 	HealthCheckType                   = "HealthCheckType"
@@ -120,9 +139,12 @@ const (
 	IPType                            = "IPType"
 	IPv6Addresses                     = "IPv6Addresses"
 	IPv6Enabled                       = "IPv6Enabled"
+	ItemCount                         = "ItemCount"
 	Key                               = "Key"
+	KeyManager                        = "KeyManager"
 	KeyName                           = "KeyName"
 	KeyPair                           = "KeyPair"
+	KeyUsage                          = "KeyUsage"
 	LatestRestorableTime              = "LatestRestorableTime"
 	LaunchConfigurationName           = "LaunchConfigurationName"
 	Launched                          = "Launched"
@@ -133,6 +155,7 @@ const (
 	MACAddress                        = "MACAddress"
 	Main                              = "Main"
 	MaxSize                           = "MaxSize"
+	Members                           = "Members"
 	Memory                            = "Memory"
 	Messages                          = "Messages"
 	MetricName                        = "MetricName"
@@ -140,11 +163,25 @@ const (
 	Modified                          = "Modified"
 	MonitoringInterval                = "MonitoringInterval"
 	MonitoringRole                    = "MonitoringRole"
+	MountTargetCount                  = "MountTargetCount"
 	MultiAZ                           = "MultiAZ"
 	Name                              = "Name"
 	Namespace                         = "Namespace"
+	NetworkInAvg1h                    = "NetworkInAvg1h"
+	NetworkInAvg24h                   = "NetworkInAvg24h"
+	NetworkInAvg7d                    = "NetworkInAvg7d"
+	NetworkInMax1h                    = "NetworkInMax1h"
+	NetworkInMax24h                   = "NetworkInMax24h"
+	NetworkInMax7d                    = "NetworkInMax7d"
 	NetworkInterfaces                 = "NetworkInterfaces"
+	NetworkOutAvg1h                   = "NetworkOutAvg1h"
+	NetworkOutAvg24h                  = "NetworkOutAvg24h"
+	NetworkOutAvg7d                   = "NetworkOutAvg7d"
+	NetworkOutMax1h                   = "NetworkOutMax1h"
+	NetworkOutMax24h                  = "NetworkOutMax24h"
+	NetworkOutMax7d                   = "NetworkOutMax7d"
 	NewInstancesProtected             = "NewInstancesProtected"
+	NodeCount                         = "NodeCount"
 	Notifications                     = "Notifications"
 	OKActions                         = "OKActions"
 	OptionGroups                      = "OptionGroups"
@@ -157,7 +194,12 @@ const (
 	PasswordLastUsed                  = "PasswordLastUsed"
 	Path                              = "Path"
 	PathPrefix                        = "PathPrefix"
+	PeerCIDR                          = "PeerCIDR"
+	PeerOwner                         = "PeerOwner"
+	PeerRegion                        = "PeerRegion"
+	PeerVpc                           = "PeerVpc"
 	PendingTasksCount                 = "PendingTasksCount"
+	PerformanceMode                   = "PerformanceMode"
 	PlacementGroup                    = "PlacementGroup"
 	Port                              = "Port"
 	Ports                             = "Ports"
@@ -174,16 +216,30 @@ const (
 	Public                            = "Public"
 	PublicDNS                         = "PublicDNS"
 	PublicIP                          = "PublicIP"
+	RangeKey                          = "RangeKey"
+	ReadCapacity                      = "ReadCapacity"
 	RecordCount                       = "RecordCount"
 	Records                           = "Records"
 	Region                            = "Region"
+	Regions                           = "Regions"
 	RegisteredContainerInstancesCount = "RegisteredContainerInstancesCount"
 	ReplicaOf                         = "ReplicaOf"
+	ReplicationGroup                  = "ReplicationGroup"
+	RequestsAvg1h                     = "RequestsAvg1h"
+	RequestsAvg24h                    = "RequestsAvg24h"
+	RequestsAvg7d                     = "RequestsAvg7d"
+	RequestsMax1h                     = "RequestsMax1h"
+	RequestsMax24h                    = "RequestsMax24h"
+	RequestsMax7d                     = "RequestsMax7d"
+	RestApi                           = "RestApi"
+	RetentionDays                     = "RetentionDays"
 	Role                              = "Role"
 	Roles                             = "Roles"
 	RootDevice                        = "RootDevice"
 	RootDeviceType                    = "RootDeviceType"
+	RotationEnabled                   = "RotationEnabled"
 	Routes                            = "Routes"
+	RouteTables                       = "RouteTables"
 	RunningTasksCount                 = "RunningTasksCount"
 	Runtime                           = "Runtime"
 	ScalingAdjustment                 = "ScalingAdjustment"
@@ -191,6 +247,7 @@ const (
 	Scheme                            = "Scheme"
 	SecondaryAvailabilityZone         = "SecondaryAvailabilityZone"
 	SecurityGroups                    = "SecurityGroups"
+	Service                           = "Service"
 	Set                               = "Set"
 	Size                              = "Size"
 	Source                            = "Source"
@@ -202,6 +259,7 @@ const (
 	Stopped                           = "Stopped"
 	Storage                           = "Storage"
 	StorageType                       = "StorageType"
+	StreamArn                         = "StreamArn"
 	Subnet                            = "Subnet"
 	Subnets                           = "Subnets"
 	Tags                              = "Tags"
@@ -227,5 +285,6 @@ const (
 	Vpcs                              = "Vpcs"
 	WebACL                            = "WebACL"
 	Weight                            = "Weight"
+	WriteCapacity                     = "WriteCapacity"
 	Zone                              = "Zone"
 )
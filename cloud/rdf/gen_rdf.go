@@ -48,7 +48,9 @@ const (
 	AvailabilityZone                  = "cloud:availabilityZone"
 	AvailabilityZones                 = "cloud:availabilityZones"
 	BackupRetentionPeriod             = "cloud:backupRetentionPeriod"
+	BillingMode                       = "cloud:billingMode"
 	Bucket                            = "cloud:bucketName"
+	CacheSubnetGroup                  = "cloud:cacheSubnetGroup"
 	CallerReference                   = "cloud:callerReference"
 	Capabilities                      = "cloud:capabilities"
 	Certificate                       = "cloud:certificate"
@@ -75,7 +77,14 @@ const (
 	Continent                         = "cloud:continent"
 	Cooldown                          = "cloud:cooldown"
 	CopyTagsToSnapshot                = "cloud:copyTagsToSnapshot"
+	Cost                              = "cloud:cost"
 	Country                           = "cloud:country"
+	CPUAvg1h                          = "cloud:cpuAvg1h"
+	CPUAvg24h                         = "cloud:cpuAvg24h"
+	CPUAvg7d                          = "cloud:cpuAvg7d"
+	CPUMax1h                          = "cloud:cpuMax1h"
+	CPUMax24h                         = "cloud:cpuMax24h"
+	CPUMax7d                          = "cloud:cpuMax7d"
 	Created                           = "cloud:created"
 	DBSecurityGroups                  = "cloud:dbSecurityGroups"
 	DBSubnetGroup                     = "cloud:dbSubnetGroup"
@@ -92,17 +101,27 @@ const (
 	Document                          = "cloud:document"
 	Enabled                           = "cloud:enabled"
 	Encrypted                         = "cloud:encrypted"
+	EncryptionKey                     = "cloud:encryptionKey"
 	Endpoint                          = "cloud:endpoint"
 	Engine                            = "cloud:engine"
 	EngineVersion                     = "cloud:engineVersion"
 	ExitCode                          = "cloud:exitCode"
 	Failover                          = "cloud:failover"
+	FileSystem                        = "cloud:fileSystem"
 	Fingerprint                       = "cloud:fingerprint"
+	FreeStorageAvg1h                  = "cloud:freeStorageAvg1h"
+	FreeStorageAvg24h                 = "cloud:freeStorageAvg24h"
+	FreeStorageAvg7d                  = "cloud:freeStorageAvg7d"
+	FreeStorageMax1h                  = "cloud:freeStorageMax1h"
+	FreeStorageMax24h                 = "cloud:freeStorageMax24h"
+	FreeStorageMax7d                  = "cloud:freeStorageMax7d"
+	Functions                         = "cloud:functions"
 	GlobalID                          = "cloud:globalID"
 	GranteeType                       = "cloud:granteeType"
 	Grants                            = "cloud:grants"
 	Handler                           = "cloud:handler"
 	Hash                              = "cloud:hash"
+	HashKey                           = "cloud:hashKey"
 	HealthCheck                       = "cloud:healthCheck"
 	HealthCheckGracePeriod            = "cloud:healthCheckGracePeriod"
 	HealthCheckType                   = "cloud:healthCheckType"
@@ -122,9 +141,12 @@ const (
 	IPType                            = "net:ipType"
 	IPv6Addresses                     = "cloud:ipv6Addresses"
 	IPv6Enabled                       = "cloud:ipv6Enabled"
+	ItemCount                         = "cloud:itemCount"
 	Key                               = "cloud:key"
+	KeyManager                        = "cloud:keyManager"
 	KeyName                           = "cloud:keyName"
 	KeyPair                           = "cloud:keyPair"
+	KeyUsage                          = "cloud:keyUsage"
 	LatestRestorableTime              = "cloud:latestRestorableTime"
 	LaunchConfigurationName           = "cloud:launchConfigurationName"
 	Launched                          = "cloud:launched"
@@ -135,6 +157,7 @@ const (
 	MACAddress                        = "cloud:macAddress"
 	Main                              = "cloud:main"
 	MaxSize                           = "cloud:maxSize"
+	Members                           = "cloud:members"
 	Memory                            = "cloud:memory"
 	Messages                          = "cloud:messages"
 	MetricName                        = "cloud:metricName"
@@ -142,11 +165,25 @@ const (
 	Modified                          = "cloud:modified"
 	MonitoringInterval                = "cloud:monitoringInterval"
 	MonitoringRole                    = "cloud:monitoringRole"
+	MountTargetCount                  = "cloud:mountTargetCount"
 	MultiAZ                           = "cloud:multiAZ"
 	Name                              = "cloud:name"
 	Namespace                         = "cloud:namemespace"
+	NetworkInAvg1h                    = "cloud:networkInAvg1h"
+	NetworkInAvg24h                   = "cloud:networkInAvg24h"
+	NetworkInAvg7d                    = "cloud:networkInAvg7d"
+	NetworkInMax1h                    = "cloud:networkInMax1h"
+	NetworkInMax24h                   = "cloud:networkInMax24h"
+	NetworkInMax7d                    = "cloud:networkInMax7d"
 	NetworkInterfaces                 = "cloud:networkInterfaces"
+	NetworkOutAvg1h                   = "cloud:networkOutAvg1h"
+	NetworkOutAvg24h                  = "cloud:networkOutAvg24h"
+	NetworkOutAvg7d                   = "cloud:networkOutAvg7d"
+	NetworkOutMax1h                   = "cloud:networkOutMax1h"
+	NetworkOutMax24h                  = "cloud:networkOutMax24h"
+	NetworkOutMax7d                   = "cloud:networkOutMax7d"
 	NewInstancesProtected             = "cloud:newInstancesProtected"
+	NodeCount                         = "cloud:nodeCount"
 	Notifications                     = "cloud:notifications"
 	OKActions                         = "cloud:okActions"
 	OptionGroups                      = "cloud:optionGroups"
@@ -159,7 +196,12 @@ const (
 	PasswordLastUsed                  = "cloud:passwordLastUsed"
 	Path                              = "cloud:path"
 	PathPrefix                        = "cloud:pathPrefix"
+	PeerCIDR                          = "net:peerCidr"
+	PeerOwner                         = "cloud:peerOwner"
+	PeerRegion                        = "cloud:peerRegion"
+	PeerVpc                           = "cloud:peerVpc"
 	PendingTasksCount                 = "cloud:pendingTasksCount"
+	PerformanceMode                   = "cloud:performanceMode"
 	PlacementGroup                    = "cloud:placementGroup"
 	Port                              = "net:port"
 	Ports                             = "net:ports"
@@ -176,16 +218,30 @@ const (
 	Public                            = "cloud:public"
 	PublicDNS                         = "cloud:publicDNS"
 	PublicIP                          = "net:publicIP"
+	RangeKey                          = "cloud:rangeKey"
+	ReadCapacity                      = "cloud:readCapacity"
 	RecordCount                       = "cloud:records"
 	Records                           = "cloud:recordCount"
 	Region                            = "cloud:region"
+	Regions                           = "cloud:regions"
 	RegisteredContainerInstancesCount = "cloud:registeredContainerInstancesCount"
 	ReplicaOf                         = "cloud:replicaOf"
+	ReplicationGroup                  = "cloud:replicationGroup"
+	RequestsAvg1h                     = "cloud:requestsAvg1h"
+	RequestsAvg24h                    = "cloud:requestsAvg24h"
+	RequestsAvg7d                     = "cloud:requestsAvg7d"
+	RequestsMax1h                     = "cloud:requestsMax1h"
+	RequestsMax24h                    = "cloud:requestsMax24h"
+	RequestsMax7d                     = "cloud:requestsMax7d"
+	RestApi                           = "cloud:restApi"
+	RetentionDays                     = "cloud:retentionDays"
 	Role                              = "cloud:role"
 	Roles                             = "cloud:roles"
 	RootDevice                        = "cloud:rootDevice"
 	RootDeviceType                    = "cloud:rootDeviceType"
+	RotationEnabled                   = "cloud:rotationEnabled"
 	Routes                            = "net:routes"
+	RouteTables                       = "cloud:routeTables"
 	RunningTasksCount                 = "cloud:runningTasksCount"
 	Runtime                           = "cloud:runtime"
 	ScalingAdjustment                 = "cloud:scalingAdjustment"
@@ -193,6 +249,7 @@ const (
 	Scheme                            = "net:scheme"
 	SecondaryAvailabilityZone         = "cloud:secondaryAvailabilityZone"
 	SecurityGroups                    = "cloud:securityGroups"
+	Service                           = "cloud:service"
 	Set                               = "cloud:set"
 	Size                              = "cloud:size"
 	Source                            = "cloud:source"
@@ -204,6 +261,7 @@ const (
 	Stopped                           = "cloud:stopped"
 	Storage                           = "cloud:storage"
 	StorageType                       = "cloud:storageType"
+	StreamArn                         = "cloud:streamArn"
 	Subnet                            = "cloud:subnet"
 	Subnets                           = "cloud:subnets"
 	Tags                              = "cloud:tags"
@@ -229,6 +287,7 @@ const (
 	Vpcs                              = "cloud:vpcs"
 	WebACL                            = "cloud:webACL"
 	Weight                            = "cloud:weight"
+	WriteCapacity                     = "cloud:writeCapacity"
 	Zone                              = "cloud:zone"
 )
 
@@ -262,7 +321,9 @@ func init() {
 		properties.AvailabilityZone:                  AvailabilityZone,
 		properties.AvailabilityZones:                 AvailabilityZones,
 		properties.BackupRetentionPeriod:             BackupRetentionPeriod,
+		properties.BillingMode:                       BillingMode,
 		properties.Bucket:                            Bucket,
+		properties.CacheSubnetGroup:                  CacheSubnetGroup,
 		properties.CallerReference:                   CallerReference,
 		properties.Capabilities:                      Capabilities,
 		properties.Certificate:                       Certificate,
@@ -289,7 +350,14 @@ func init() {
 		properties.Continent:                         Continent,
 		properties.Cooldown:                          Cooldown,
 		properties.CopyTagsToSnapshot:                CopyTagsToSnapshot,
+		properties.Cost:                              Cost,
 		properties.Country:                           Country,
+		properties.CPUAvg1h:                          CPUAvg1h,
+		properties.CPUAvg24h:                         CPUAvg24h,
+		properties.CPUAvg7d:                          CPUAvg7d,
+		properties.CPUMax1h:                          CPUMax1h,
+		properties.CPUMax24h:                         CPUMax24h,
+		properties.CPUMax7d:                          CPUMax7d,
 		properties.Created:                           Created,
 		properties.DBSecurityGroups:                  DBSecurityGroups,
 		properties.DBSubnetGroup:                     DBSubnetGroup,
@@ -306,17 +374,27 @@ func init() {
 		properties.Document:                          Document,
 		properties.Enabled:                           Enabled,
 		properties.Encrypted:                         Encrypted,
+		properties.EncryptionKey:                     EncryptionKey,
 		properties.Endpoint:                          Endpoint,
 		properties.Engine:                            Engine,
 		properties.EngineVersion:                     EngineVersion,
 		properties.ExitCode:                          ExitCode,
 		properties.Failover:                          Failover,
+		properties.FileSystem:                        FileSystem,
 		properties.Fingerprint:                       Fingerprint,
+		properties.FreeStorageAvg1h:                  FreeStorageAvg1h,
+		properties.FreeStorageAvg24h:                 FreeStorageAvg24h,
+		properties.FreeStorageAvg7d:                  FreeStorageAvg7d,
+		properties.FreeStorageMax1h:                  FreeStorageMax1h,
+		properties.FreeStorageMax24h:                 FreeStorageMax24h,
+		properties.FreeStorageMax7d:                  FreeStorageMax7d,
+		properties.Functions:                         Functions,
 		properties.GlobalID:                          GlobalID,
 		properties.GranteeType:                       GranteeType,
 		properties.Grants:                            Grants,
 		properties.Handler:                           Handler,
 		properties.Hash:                              Hash,
+		properties.HashKey:                           HashKey,
 		properties.HealthCheck:                       HealthCheck,
 		properties.HealthCheckGracePeriod:            HealthCheckGracePeriod,
 		properties.HealthCheckType:                   HealthCheckType,
@@ -336,9 +414,12 @@ func init() {
 		properties.IPType:                            IPType,
 		properties.IPv6Addresses:                     IPv6Addresses,
 		properties.IPv6Enabled:                       IPv6Enabled,
+		properties.ItemCount:                         ItemCount,
 		properties.Key:                               Key,
+		properties.KeyManager:                        KeyManager,
 		properties.KeyName:                           KeyName,
 		properties.KeyPair:                           KeyPair,
+		properties.KeyUsage:                          KeyUsage,
 		properties.LatestRestorableTime:              LatestRestorableTime,
 		properties.LaunchConfigurationName:           LaunchConfigurationName,
 		properties.Launched:                          Launched,
@@ -349,6 +430,7 @@ func init() {
 		properties.MACAddress:                        MACAddress,
 		properties.Main:                              Main,
 		properties.MaxSize:                           MaxSize,
+		properties.Members:                           Members,
 		properties.Memory:                            Memory,
 		properties.Messages:                          Messages,
 		properties.MetricName:                        MetricName,
@@ -356,11 +438,25 @@ func init() {
 		properties.Modified:                          Modified,
 		properties.MonitoringInterval:                MonitoringInterval,
 		properties.MonitoringRole:                    MonitoringRole,
+		properties.MountTargetCount:                  MountTargetCount,
 		properties.MultiAZ:                           MultiAZ,
 		properties.Name:                              Name,
 		properties.Namespace:                         Namespace,
+		properties.NetworkInAvg1h:                    NetworkInAvg1h,
+		properties.NetworkInAvg24h:                   NetworkInAvg24h,
+		properties.NetworkInAvg7d:                    NetworkInAvg7d,
+		properties.NetworkInMax1h:                    NetworkInMax1h,
+		properties.NetworkInMax24h:                   NetworkInMax24h,
+		properties.NetworkInMax7d:                    NetworkInMax7d,
 		properties.NetworkInterfaces:                 NetworkInterfaces,
+		properties.NetworkOutAvg1h:                   NetworkOutAvg1h,
+		properties.NetworkOutAvg24h:                  NetworkOutAvg24h,
+		properties.NetworkOutAvg7d:                   NetworkOutAvg7d,
+		properties.NetworkOutMax1h:                   NetworkOutMax1h,
+		properties.NetworkOutMax24h:                  NetworkOutMax24h,
+		properties.NetworkOutMax7d:                   NetworkOutMax7d,
 		properties.NewInstancesProtected:             NewInstancesProtected,
+		properties.NodeCount:                         NodeCount,
 		properties.Notifications:                     Notifications,
 		properties.OKActions:                         OKActions,
 		properties.OptionGroups:                      OptionGroups,
@@ -373,7 +469,12 @@ func init() {
 		properties.PasswordLastUsed:                  PasswordLastUsed,
 		properties.Path:                              Path,
 		properties.PathPrefix:                        PathPrefix,
+		properties.PeerCIDR:                          PeerCIDR,
+		properties.PeerOwner:                         PeerOwner,
+		properties.PeerRegion:                        PeerRegion,
+		properties.PeerVpc:                           PeerVpc,
 		properties.PendingTasksCount:                 PendingTasksCount,
+		properties.PerformanceMode:                   PerformanceMode,
 		properties.PlacementGroup:                    PlacementGroup,
 		properties.Port:                              Port,
 		properties.Ports:                             Ports,
@@ -390,16 +491,30 @@ func init() {
 		properties.Public:                            Public,
 		properties.PublicDNS:                         PublicDNS,
 		properties.PublicIP:                          PublicIP,
+		properties.RangeKey:                          RangeKey,
+		properties.ReadCapacity:                      ReadCapacity,
 		properties.RecordCount:                       RecordCount,
 		properties.Records:                           Records,
 		properties.Region:                            Region,
+		properties.Regions:                           Regions,
 		properties.RegisteredContainerInstancesCount: RegisteredContainerInstancesCount,
 		properties.ReplicaOf:                         ReplicaOf,
+		properties.ReplicationGroup:                  ReplicationGroup,
+		properties.RequestsAvg1h:                     RequestsAvg1h,
+		properties.RequestsAvg24h:                    RequestsAvg24h,
+		properties.RequestsAvg7d:                     RequestsAvg7d,
+		properties.RequestsMax1h:                     RequestsMax1h,
+		properties.RequestsMax24h:                    RequestsMax24h,
+		properties.RequestsMax7d:                     RequestsMax7d,
+		properties.RestApi:                           RestApi,
+		properties.RetentionDays:                     RetentionDays,
 		properties.Role:                              Role,
 		properties.Roles:                             Roles,
 		properties.RootDevice:                        RootDevice,
 		properties.RootDeviceType:                    RootDeviceType,
+		properties.RotationEnabled:                   RotationEnabled,
 		properties.Routes:                            Routes,
+		properties.RouteTables:                       RouteTables,
 		properties.RunningTasksCount:                 RunningTasksCount,
 		properties.Runtime:                           Runtime,
 		properties.ScalingAdjustment:                 ScalingAdjustment,
@@ -407,6 +522,7 @@ func init() {
 		properties.Scheme:                            Scheme,
 		properties.SecondaryAvailabilityZone:         SecondaryAvailabilityZone,
 		properties.SecurityGroups:                    SecurityGroups,
+		properties.Service:                           Service,
 		properties.Set:                               Set,
 		properties.Size:                              Size,
 		properties.Source:                            Source,
@@ -418,6 +534,7 @@ func init() {
 		properties.Stopped:                           Stopped,
 		properties.Storage:                           Storage,
 		properties.StorageType:                       StorageType,
+		properties.StreamArn:                         StreamArn,
 		properties.Subnet:                            Subnet,
 		properties.Subnets:                           Subnets,
 		properties.Tags:                              Tags,
@@ -443,6 +560,7 @@ func init() {
 		properties.Vpcs:                              Vpcs,
 		properties.WebACL:                            WebACL,
 		properties.Weight:                            Weight,
+		properties.WriteCapacity:                     WriteCapacity,
 		properties.Zone:                              Zone,
 	}
 }
@@ -476,7 +594,9 @@ var Properties = RDFProperties{
 	AvailabilityZone:        {ID: AvailabilityZone, RdfType: "rdf:Property", RdfsLabel: "AvailabilityZone", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	AvailabilityZones:       {ID: AvailabilityZones, RdfType: "rdf:Property", RdfsLabel: "AvailabilityZones", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	BackupRetentionPeriod:   {ID: BackupRetentionPeriod, RdfType: "rdf:Property", RdfsLabel: "BackupRetentionPeriod", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
+	BillingMode:             {ID: BillingMode, RdfType: "rdf:Property", RdfsLabel: "BillingMode", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Bucket:                  {ID: Bucket, RdfType: "rdf:Property", RdfsLabel: "Bucket", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	CacheSubnetGroup:        {ID: CacheSubnetGroup, RdfType: "rdf:Property", RdfsLabel: "CacheSubnetGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	CallerReference:         {ID: CallerReference, RdfType: "rdf:Property", RdfsLabel: "CallerReference", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Capabilities:            {ID: Capabilities, RdfType: "rdf:Property", RdfsLabel: "Capabilities", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	Certificate:             {ID: Certificate, RdfType: "rdf:Property", RdfsLabel: "Certificate", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
@@ -503,7 +623,14 @@ var Properties = RDFProperties{
 	Continent:               {ID: Continent, RdfType: "rdf:Property", RdfsLabel: "Continent", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Cooldown:                {ID: Cooldown, RdfType: "rdf:Property", RdfsLabel: "Cooldown", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	CopyTagsToSnapshot:      {ID: CopyTagsToSnapshot, RdfType: "rdf:Property", RdfsLabel: "CopyTagsToSnapshot", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Cost:                    {ID: Cost, RdfType: "rdf:Property", RdfsLabel: "Cost", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
 	Country:                 {ID: Country, RdfType: "rdf:Property", RdfsLabel: "Country", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	CPUAvg1h:                {ID: CPUAvg1h, RdfType: "rdf:Property", RdfsLabel: "CPUAvg1h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	CPUAvg24h:               {ID: CPUAvg24h, RdfType: "rdf:Property", RdfsLabel: "CPUAvg24h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	CPUAvg7d:                {ID: CPUAvg7d, RdfType: "rdf:Property", RdfsLabel: "CPUAvg7d", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	CPUMax1h:                {ID: CPUMax1h, RdfType: "rdf:Property", RdfsLabel: "CPUMax1h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	CPUMax24h:               {ID: CPUMax24h, RdfType: "rdf:Property", RdfsLabel: "CPUMax24h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	CPUMax7d:                {ID: CPUMax7d, RdfType: "rdf:Property", RdfsLabel: "CPUMax7d", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
 	Created:                 {ID: Created, RdfType: "rdf:Property", RdfsLabel: "Created", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	DBSecurityGroups:        {ID: DBSecurityGroups, RdfType: "rdf:Property", RdfsLabel: "DBSecurityGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	DBSubnetGroup:           {ID: DBSubnetGroup, RdfType: "rdf:Property", RdfsLabel: "DBSubnetGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
@@ -520,17 +647,27 @@ var Properties = RDFProperties{
 	Document:                {ID: Document, RdfType: "rdf:Property", RdfsLabel: "Document", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Enabled:                 {ID: Enabled, RdfType: "rdf:Property", RdfsLabel: "Enabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	Encrypted:               {ID: Encrypted, RdfType: "rdf:Property", RdfsLabel: "Encrypted", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	EncryptionKey:           {ID: EncryptionKey, RdfType: "rdf:Property", RdfsLabel: "EncryptionKey", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Endpoint:                {ID: Endpoint, RdfType: "rdf:Property", RdfsLabel: "Endpoint", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Engine:                  {ID: Engine, RdfType: "rdf:Property", RdfsLabel: "Engine", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	EngineVersion:           {ID: EngineVersion, RdfType: "rdf:Property", RdfsLabel: "EngineVersion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	ExitCode:                {ID: ExitCode, RdfType: "rdf:Property", RdfsLabel: "ExitCode", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Failover:                {ID: Failover, RdfType: "rdf:Property", RdfsLabel: "Failover", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	FileSystem:              {ID: FileSystem, RdfType: "rdf:Property", RdfsLabel: "FileSystem", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	Fingerprint:             {ID: Fingerprint, RdfType: "rdf:Property", RdfsLabel: "Fingerprint", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	FreeStorageAvg1h:        {ID: FreeStorageAvg1h, RdfType: "rdf:Property", RdfsLabel: "FreeStorageAvg1h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	FreeStorageAvg24h:       {ID: FreeStorageAvg24h, RdfType: "rdf:Property", RdfsLabel: "FreeStorageAvg24h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	FreeStorageAvg7d:        {ID: FreeStorageAvg7d, RdfType: "rdf:Property", RdfsLabel: "FreeStorageAvg7d", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	FreeStorageMax1h:        {ID: FreeStorageMax1h, RdfType: "rdf:Property", RdfsLabel: "FreeStorageMax1h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	FreeStorageMax24h:       {ID: FreeStorageMax24h, RdfType: "rdf:Property", RdfsLabel: "FreeStorageMax24h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	FreeStorageMax7d:        {ID: FreeStorageMax7d, RdfType: "rdf:Property", RdfsLabel: "FreeStorageMax7d", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	Functions:               {ID: Functions, RdfType: "rdf:Property", RdfsLabel: "Functions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	GlobalID:                {ID: GlobalID, RdfType: "rdf:Property", RdfsLabel: "GlobalID", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	GranteeType:             {ID: GranteeType, RdfType: "rdf:Property", RdfsLabel: "GranteeType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Grants:                  {ID: Grants, RdfType: "rdf:Property", RdfsLabel: "Grants", RdfsDefinedBy: "rdfs:list", RdfsDataType: "cloud-owl:Grant"},
 	Handler:                 {ID: Handler, RdfType: "rdf:Property", RdfsLabel: "Handler", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Hash:                    {ID: Hash, RdfType: "rdf:Property", RdfsLabel: "Hash", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	HashKey:                 {ID: HashKey, RdfType: "rdf:Property", RdfsLabel: "HashKey", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	HealthCheck:             {ID: HealthCheck, RdfType: "rdf:Property", RdfsLabel: "HealthCheck", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	HealthCheckGracePeriod:  {ID: HealthCheckGracePeriod, RdfType: "rdf:Property", RdfsLabel: "HealthCheckGracePeriod", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	HealthCheckType:         {ID: HealthCheckType, RdfType: "rdf:Property", RdfsLabel: "HealthCheckType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
@@ -550,9 +687,12 @@ var Properties = RDFProperties{
 	IPType:                   {ID: IPType, RdfType: "rdf:Property", RdfsLabel: "IPType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	IPv6Addresses:            {ID: IPv6Addresses, RdfType: "rdf:Property", RdfsLabel: "IPv6Addresses", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	IPv6Enabled:              {ID: IPv6Enabled, RdfType: "rdf:Property", RdfsLabel: "IPv6Enabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	ItemCount:                {ID: ItemCount, RdfType: "rdf:Property", RdfsLabel: "ItemCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Key:                      {ID: Key, RdfType: "rdf:Property", RdfsLabel: "Key", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	KeyManager:               {ID: KeyManager, RdfType: "rdf:Property", RdfsLabel: "KeyManager", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	KeyName:                  {ID: KeyName, RdfType: "rdf:Property", RdfsLabel: "KeyName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	KeyPair:                  {ID: KeyPair, RdfType: "rdf:Property", RdfsLabel: "KeyPair", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	KeyUsage:                 {ID: KeyUsage, RdfType: "rdf:Property", RdfsLabel: "KeyUsage", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	LatestRestorableTime:     {ID: LatestRestorableTime, RdfType: "rdf:Property", RdfsLabel: "LatestRestorableTime", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	LaunchConfigurationName:  {ID: LaunchConfigurationName, RdfType: "rdf:Property", RdfsLabel: "LaunchConfigurationName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Launched:                 {ID: Launched, RdfType: "rdf:Property", RdfsLabel: "Launched", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
@@ -563,6 +703,7 @@ var Properties = RDFProperties{
 	MACAddress:               {ID: MACAddress, RdfType: "rdf:Property", RdfsLabel: "MACAddress", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Main:                     {ID: Main, RdfType: "rdf:Property", RdfsLabel: "Main", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	MaxSize:                  {ID: MaxSize, RdfType: "rdf:Property", RdfsLabel: "MaxSize", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	Members:                  {ID: Members, RdfType: "rdf:Property", RdfsLabel: "Members", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	Memory:                   {ID: Memory, RdfType: "rdf:Property", RdfsLabel: "Memory", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Messages:                 {ID: Messages, RdfType: "rdf:Property", RdfsLabel: "Messages", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	MetricName:               {ID: MetricName, RdfType: "rdf:Property", RdfsLabel: "MetricName", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
@@ -570,11 +711,25 @@ var Properties = RDFProperties{
 	Modified:                 {ID: Modified, RdfType: "rdf:Property", RdfsLabel: "Modified", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	MonitoringInterval:       {ID: MonitoringInterval, RdfType: "rdf:Property", RdfsLabel: "MonitoringInterval", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	MonitoringRole:           {ID: MonitoringRole, RdfType: "rdf:Property", RdfsLabel: "MonitoringRole", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	MountTargetCount:         {ID: MountTargetCount, RdfType: "rdf:Property", RdfsLabel: "MountTargetCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	MultiAZ:                  {ID: MultiAZ, RdfType: "rdf:Property", RdfsLabel: "MultiAZ", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Name:                     {ID: Name, RdfType: "rdf:Property", RdfsLabel: "Name", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Namespace:                {ID: Namespace, RdfType: "rdf:Property", RdfsLabel: "Namespace", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	NetworkInAvg1h:           {ID: NetworkInAvg1h, RdfType: "rdf:Property", RdfsLabel: "NetworkInAvg1h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	NetworkInAvg24h:          {ID: NetworkInAvg24h, RdfType: "rdf:Property", RdfsLabel: "NetworkInAvg24h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	NetworkInAvg7d:           {ID: NetworkInAvg7d, RdfType: "rdf:Property", RdfsLabel: "NetworkInAvg7d", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	NetworkInMax1h:           {ID: NetworkInMax1h, RdfType: "rdf:Property", RdfsLabel: "NetworkInMax1h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	NetworkInMax24h:          {ID: NetworkInMax24h, RdfType: "rdf:Property", RdfsLabel: "NetworkInMax24h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	NetworkInMax7d:           {ID: NetworkInMax7d, RdfType: "rdf:Property", RdfsLabel: "NetworkInMax7d", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
 	NetworkInterfaces:        {ID: NetworkInterfaces, RdfType: "rdf:Property", RdfsLabel: "NetworkInterfaces", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
+	NetworkOutAvg1h:          {ID: NetworkOutAvg1h, RdfType: "rdf:Property", RdfsLabel: "NetworkOutAvg1h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	NetworkOutAvg24h:         {ID: NetworkOutAvg24h, RdfType: "rdf:Property", RdfsLabel: "NetworkOutAvg24h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	NetworkOutAvg7d:          {ID: NetworkOutAvg7d, RdfType: "rdf:Property", RdfsLabel: "NetworkOutAvg7d", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	NetworkOutMax1h:          {ID: NetworkOutMax1h, RdfType: "rdf:Property", RdfsLabel: "NetworkOutMax1h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	NetworkOutMax24h:         {ID: NetworkOutMax24h, RdfType: "rdf:Property", RdfsLabel: "NetworkOutMax24h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	NetworkOutMax7d:          {ID: NetworkOutMax7d, RdfType: "rdf:Property", RdfsLabel: "NetworkOutMax7d", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
 	NewInstancesProtected:    {ID: NewInstancesProtected, RdfType: "rdf:Property", RdfsLabel: "NewInstancesProtected", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
+	NodeCount:                {ID: NodeCount, RdfType: "rdf:Property", RdfsLabel: "NodeCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Notifications:            {ID: Notifications, RdfType: "rdf:Property", RdfsLabel: "Notifications", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	OKActions:                {ID: OKActions, RdfType: "rdf:Property", RdfsLabel: "OKActions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	OptionGroups:             {ID: OptionGroups, RdfType: "rdf:Property", RdfsLabel: "OptionGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
@@ -587,7 +742,12 @@ var Properties = RDFProperties{
 	PasswordLastUsed:         {ID: PasswordLastUsed, RdfType: "rdf:Property", RdfsLabel: "PasswordLastUsed", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	Path:                     {ID: Path, RdfType: "rdf:Property", RdfsLabel: "Path", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	PathPrefix:               {ID: PathPrefix, RdfType: "rdf:Property", RdfsLabel: "PathPrefix", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PeerCIDR:                 {ID: PeerCIDR, RdfType: "rdf:Property", RdfsLabel: "PeerCIDR", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PeerOwner:                {ID: PeerOwner, RdfType: "rdf:Property", RdfsLabel: "PeerOwner", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PeerRegion:               {ID: PeerRegion, RdfType: "rdf:Property", RdfsLabel: "PeerRegion", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	PeerVpc:                  {ID: PeerVpc, RdfType: "rdf:Property", RdfsLabel: "PeerVpc", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	PendingTasksCount:        {ID: PendingTasksCount, RdfType: "rdf:Property", RdfsLabel: "PendingTasksCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
+	PerformanceMode:          {ID: PerformanceMode, RdfType: "rdf:Property", RdfsLabel: "PerformanceMode", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	PlacementGroup:           {ID: PlacementGroup, RdfType: "rdf:Property", RdfsLabel: "PlacementGroup", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Port:                     {ID: Port, RdfType: "rdf:Property", RdfsLabel: "Port", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Ports:                    {ID: Ports, RdfType: "rdf:Property", RdfsLabel: "Ports", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
@@ -604,16 +764,30 @@ var Properties = RDFProperties{
 	Public:                   {ID: Public, RdfType: "rdf:Property", RdfsLabel: "Public", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	PublicDNS:                {ID: PublicDNS, RdfType: "rdf:Property", RdfsLabel: "PublicDNS", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	PublicIP:                 {ID: PublicIP, RdfType: "rdf:Property", RdfsLabel: "PublicIP", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	RangeKey:                 {ID: RangeKey, RdfType: "rdf:Property", RdfsLabel: "RangeKey", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ReadCapacity:             {ID: ReadCapacity, RdfType: "rdf:Property", RdfsLabel: "ReadCapacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	RecordCount:              {ID: RecordCount, RdfType: "rdf:Property", RdfsLabel: "RecordCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Records:                  {ID: Records, RdfType: "rdf:Property", RdfsLabel: "Records", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	Region:                   {ID: Region, RdfType: "rdf:Property", RdfsLabel: "Region", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	Regions:                           {ID: Regions, RdfType: "rdf:Property", RdfsLabel: "Regions", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
 	RegisteredContainerInstancesCount: {ID: RegisteredContainerInstancesCount, RdfType: "rdf:Property", RdfsLabel: "RegisteredContainerInstancesCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	ReplicaOf:                         {ID: ReplicaOf, RdfType: "rdf:Property", RdfsLabel: "ReplicaOf", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	ReplicationGroup:                  {ID: ReplicationGroup, RdfType: "rdf:Property", RdfsLabel: "ReplicationGroup", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	RequestsAvg1h:                     {ID: RequestsAvg1h, RdfType: "rdf:Property", RdfsLabel: "RequestsAvg1h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	RequestsAvg24h:                    {ID: RequestsAvg24h, RdfType: "rdf:Property", RdfsLabel: "RequestsAvg24h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	RequestsAvg7d:                     {ID: RequestsAvg7d, RdfType: "rdf:Property", RdfsLabel: "RequestsAvg7d", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	RequestsMax1h:                     {ID: RequestsMax1h, RdfType: "rdf:Property", RdfsLabel: "RequestsMax1h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	RequestsMax24h:                    {ID: RequestsMax24h, RdfType: "rdf:Property", RdfsLabel: "RequestsMax24h", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	RequestsMax7d:                     {ID: RequestsMax7d, RdfType: "rdf:Property", RdfsLabel: "RequestsMax7d", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:double"},
+	RestApi:                           {ID: RestApi, RdfType: "rdf:Property", RdfsLabel: "RestApi", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
+	RetentionDays:                     {ID: RetentionDays, RdfType: "rdf:Property", RdfsLabel: "RetentionDays", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Role:                              {ID: Role, RdfType: "rdf:Property", RdfsLabel: "Role", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	Roles:                             {ID: Roles, RdfType: "rdf:Property", RdfsLabel: "Roles", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	RootDevice:                        {ID: RootDevice, RdfType: "rdf:Property", RdfsLabel: "RootDevice", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	RootDeviceType:                    {ID: RootDeviceType, RdfType: "rdf:Property", RdfsLabel: "RootDeviceType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	RotationEnabled:                   {ID: RotationEnabled, RdfType: "rdf:Property", RdfsLabel: "RotationEnabled", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:boolean"},
 	Routes:                            {ID: Routes, RdfType: "rdf:Property", RdfsLabel: "Routes", RdfsDefinedBy: "rdfs:list", RdfsDataType: "net-owl:Route"},
+	RouteTables:                       {ID: RouteTables, RdfType: "rdf:Property", RdfsLabel: "RouteTables", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	RunningTasksCount:                 {ID: RunningTasksCount, RdfType: "rdf:Property", RdfsLabel: "RunningTasksCount", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Runtime:                           {ID: Runtime, RdfType: "rdf:Property", RdfsLabel: "Runtime", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	ScalingAdjustment:                 {ID: ScalingAdjustment, RdfType: "rdf:Property", RdfsLabel: "ScalingAdjustment", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
@@ -621,6 +795,7 @@ var Properties = RDFProperties{
 	Scheme:                            {ID: Scheme, RdfType: "rdf:Property", RdfsLabel: "Scheme", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	SecondaryAvailabilityZone: {ID: SecondaryAvailabilityZone, RdfType: "rdf:Property", RdfsLabel: "SecondaryAvailabilityZone", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	SecurityGroups:            {ID: SecurityGroups, RdfType: "rdf:Property", RdfsLabel: "SecurityGroups", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
+	Service:                   {ID: Service, RdfType: "rdf:Property", RdfsLabel: "Service", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Set:                       {ID: Set, RdfType: "rdf:Property", RdfsLabel: "Set", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Size:                      {ID: Size, RdfType: "rdf:Property", RdfsLabel: "Size", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Source:                    {ID: Source, RdfType: "rdf:Property", RdfsLabel: "Source", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
@@ -632,6 +807,7 @@ var Properties = RDFProperties{
 	Stopped:                   {ID: Stopped, RdfType: "rdf:Property", RdfsLabel: "Stopped", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:dateTime"},
 	Storage:                   {ID: Storage, RdfType: "rdf:Property", RdfsLabel: "Storage", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	StorageType:               {ID: StorageType, RdfType: "rdf:Property", RdfsLabel: "StorageType", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	StreamArn:                 {ID: StreamArn, RdfType: "rdf:Property", RdfsLabel: "StreamArn", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Subnet:                    {ID: Subnet, RdfType: "rdf:Property", RdfsLabel: "Subnet", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 	Subnets:                   {ID: Subnets, RdfType: "rdf:Property", RdfsLabel: "Subnets", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	Tags:                      {ID: Tags, RdfType: "rdf:Property", RdfsLabel: "Tags", RdfsDefinedBy: "rdfs:list", RdfsDataType: "xsd:string"},
@@ -657,5 +833,6 @@ var Properties = RDFProperties{
 	Vpcs:                    {ID: Vpcs, RdfType: "rdf:Property", RdfsLabel: "Vpcs", RdfsDefinedBy: "rdfs:list", RdfsDataType: "rdfs:Class"},
 	WebACL:                  {ID: WebACL, RdfType: "rdf:Property", RdfsLabel: "WebACL", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
 	Weight:                  {ID: Weight, RdfType: "rdf:Property", RdfsLabel: "Weight", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:string"},
+	WriteCapacity:           {ID: WriteCapacity, RdfType: "rdf:Property", RdfsLabel: "WriteCapacity", RdfsDefinedBy: "rdfs:Literal", RdfsDataType: "xsd:int"},
 	Zone:                    {ID: Zone, RdfType: "rdf:Property", RdfsLabel: "Zone", RdfsDefinedBy: "rdfs:Class", RdfsDataType: "xsd:string"},
 }
@@ -32,6 +32,7 @@ var (
 	XsdString   = fmt.Sprintf("%s:string", XsdNS)
 	XsdBoolean  = fmt.Sprintf("%s:boolean", XsdNS)
 	XsdInt      = fmt.Sprintf("%s:int", XsdNS)
+	XsdDouble   = fmt.Sprintf("%s:double", XsdNS)
 	XsdDateTime = fmt.Sprintf("%s:dateTime", XsdNS)
 )
 
@@ -68,6 +69,20 @@ type rdfProp struct {
 
 type RDFProperties map[string]rdfProp
 
+// RegisterProperty adds a property to the schema (ex: declared by a plugin), given its label as displayed
+// and queried (ex: Owner), its id (ex: cmdb:owner), how it is defined (ex: rdfs:Literal, rdfs:list) and its data type
+func RegisterProperty(label, id, definedBy, dataType string) error {
+	if existing, ok := Labels[label]; ok && existing != id {
+		return fmt.Errorf("register property: label '%s' already defined as %s", label, existing)
+	}
+	if existing, ok := Properties[id]; ok && existing.RdfsLabel != label {
+		return fmt.Errorf("register property: %s already defined with label '%s'", id, existing.RdfsLabel)
+	}
+	Labels[label] = id
+	Properties[id] = rdfProp{ID: id, RdfType: RdfProperty, RdfsLabel: label, RdfsDefinedBy: definedBy, RdfsDataType: dataType}
+	return nil
+}
+
 func (r RDFProperties) Get(prop string) (rdfProp, error) {
 	p, ok := r[prop]
 	if !ok {
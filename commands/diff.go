@@ -0,0 +1,143 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/config"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/sync"
+)
+
+var (
+	diffFromFlag       string
+	diffToFlag         string
+	diffMatchFlag      string
+	diffPropertiesFlag bool
+)
+
+// Properties always differing between regions or profiles
+var diffIgnoredProperties = []string{
+	properties.Arn, properties.Created, properties.Launched, properties.Modified, properties.Updated,
+	properties.PublicIP, properties.PrivateIP, properties.PublicDNS, properties.PrivateDNS,
+	properties.AvailabilityZone, properties.Region, properties.Owner,
+}
+
+func init() {
+	RootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().StringVar(&diffFromFlag, "from", "", "Local graph to compare from, as [PROFILE/]REGION or PROFILE (default: current profile and region)")
+	diffCmd.Flags().StringVar(&diffToFlag, "to", "", "Local graph to compare to, as [PROFILE/]REGION or PROFILE")
+	diffCmd.Flags().StringVar(&diffMatchFlag, "match", "name", "How resources are matched between graphs: 'name' or 'tags:KEY1,KEY2'")
+	diffCmd.Flags().BoolVar(&diffPropertiesFlag, "properties", false, "Full diff with resources properties")
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Compare locally synced resources of two regions and/or profiles",
+	Long:  "Compare locally synced resources of two regions and/or profiles. Resources are matched by name or tags rather than by ID.",
+	Example: `  awless diff --from prod/eu-west-1 --to staging/eu-west-1
+  awless diff --to us-east-1 --properties
+  awless diff --from prod --to staging --match tags:App,Component`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if diffToFlag == "" {
+			return errors.New("missing --to flag")
+		}
+		fromProfile, fromRegion, err := parseProfileAndRegion(diffFromFlag)
+		exitOn(err)
+		toProfile, toRegion, err := parseProfileAndRegion(diffToFlag)
+		exitOn(err)
+
+		key, err := parseMatchFlag(diffMatchFlag)
+		exitOn(err)
+
+		from, err := sync.LoadLocalGraphs(fromProfile, fromRegion)
+		exitOn(err)
+		to, err := sync.LoadLocalGraphs(toProfile, toRegion)
+		exitOn(err)
+
+		matchedFrom, matchedTo, err := graph.MatchGraphs(from.(*graph.Graph), fromRegion, to.(*graph.Graph), toRegion, key, diffIgnoredProperties...)
+		exitOn(err)
+
+		root := graph.InitResource(cloud.Region, fromRegion)
+		diff, err := graph.DefaultDiffer.Run(root.Id(), matchedFrom, matchedTo)
+		exitOn(err)
+
+		fmt.Printf("▶ from %s/%s to %s/%s (matched by %s)\n", fromProfile, fromRegion, toProfile, toRegion, diffMatchFlag)
+		if !diff.HasDiff() && !diffPropertiesFlag {
+			fmt.Println("No resource differences.")
+			return nil
+		}
+
+		format := "tree"
+		if diffPropertiesFlag {
+			format = "table"
+		}
+		displayer, err := console.BuildOptions(
+			console.WithFormat(format),
+			console.WithRootNode(root),
+		).SetSource(diff).Build()
+		exitOn(err)
+
+		return displayer.Print(os.Stdout)
+	},
+}
+
+func parseProfileAndRegion(s string) (string, string, error) {
+	profile, region := config.GetAWSProfile(), config.GetAWSRegion()
+	switch splits := strings.Split(s, "/"); len(splits) {
+	case 1:
+		switch {
+		case s == "":
+		case awsconfig.IsValidRegion(s):
+			region = s
+		default:
+			profile = s
+		}
+	case 2:
+		profile, region = splits[0], splits[1]
+	default:
+		return "", "", fmt.Errorf("invalid '%s': expecting [PROFILE/]REGION or PROFILE", s)
+	}
+	if !awsconfig.IsValidRegion(region) {
+		return "", "", fmt.Errorf("invalid region '%s'", region)
+	}
+	return profile, region, nil
+}
+
+func parseMatchFlag(s string) (graph.ResourceKeyFunc, error) {
+	switch {
+	case s == "name":
+		return graph.KeyByName, nil
+	case strings.HasPrefix(s, "tags:") && len(s) > len("tags:"):
+		return graph.KeyByTags(strings.Split(strings.TrimPrefix(s, "tags:"), ",")...), nil
+	default:
+		return nil, fmt.Errorf("invalid match '%s': expecting 'name' or 'tags:KEY1,KEY2'", s)
+	}
+}
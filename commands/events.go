@@ -0,0 +1,124 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/tailers"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+var eventsSinceFlag time.Duration
+
+func init() {
+	RootCmd.AddCommand(eventsCmd)
+
+	eventsCmd.Flags().DurationVar(&eventsSinceFlag, "since", 24*time.Hour, "Look for the events within this duration")
+}
+
+var eventsCmd = &cobra.Command{
+	Use:   "events [REFERENCE]",
+	Short: "Show who did what on your cloud (or on a resource) from CloudTrail, marking the changes made by your awless templates",
+	Example: `  awless events
+  awless events @redis-prod --since 2h
+  awless events i-8d43b21b`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, networkMonitorHook),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var ref string
+		if len(args) > 0 {
+			ref = args[0]
+		}
+		return tailCloudTrailEvents(ref, eventsSinceFlag, 0, false, 0)
+	},
+}
+
+// tailCloudTrailEvents displays the CloudTrail events of the resource referenced (all resources if empty),
+// with resources named from the local graph and events made by templates of the local log marked
+func tailCloudTrailEvents(ref string, since time.Duration, nbEvents int, follow bool, frequency time.Duration) error {
+	g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
+	exitOn(err)
+
+	var lookups []string
+	if ref != "" {
+		_, resources, _ := resolveResourceFromRef(g, ref)
+		for _, res := range resources {
+			lookups = append(lookups, res.Id())
+			if name, ok := res.Property(properties.Name); ok {
+				if n := fmt.Sprint(name); n != "" && n != res.Id() {
+					lookups = append(lookups, n)
+				}
+			}
+		}
+		if len(resources) == 0 {
+			if strings.HasPrefix(ref, "@") {
+				exitOn(fmt.Errorf("resource '%s' not found in local graph", deprefix(ref)))
+			}
+			lookups = append(lookups, ref)
+		}
+		logger.Verbosef("looking up CloudTrail events of %s", strings.Join(lookups, ", "))
+	}
+
+	return awstailers.NewCloudTrailEventsTailer(lookups, since, nbEvents, follow, frequency, graphNameResolver(g), localTemplateChecker()).Tail(os.Stdout)
+}
+
+// graphNameResolver resolves the name of resources given their ID or ARN in the given graph
+func graphNameResolver(g cloud.GraphAPI) func(string) string {
+	return func(idOrArn string) string {
+		for _, prop := range []string{properties.ID, properties.Arn} {
+			resources, err := g.FindWithProperties(map[string]interface{}{prop: idOrArn})
+			if err != nil || len(resources) == 0 {
+				continue
+			}
+			if name, ok := resources[0].Property(properties.Name); ok && fmt.Sprint(name) != idOrArn {
+				return fmt.Sprint(name)
+			}
+			return ""
+		}
+		return ""
+	}
+}
+
+// localTemplateChecker tells whether template execution IDs are in the local log
+func localTemplateChecker() func(string) bool {
+	known := make(map[string]bool)
+	return func(id string) bool {
+		if found, ok := known[id]; ok {
+			return found
+		}
+		err := database.Execute(func(db *database.DB) error {
+			_, err := db.GetTemplate(id)
+			return err
+		})
+		if err != nil {
+			logger.ExtraVerbosef("template %s not in local log: %s", id, err)
+		}
+		known[id] = err == nil
+		return known[id]
+	}
+}
@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestGraphNameResolver(t *testing.T) {
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("i-123").Prop("Name", "redis").Build(),
+		resourcetest.Instance("i-456").Build(),
+		resourcetest.User("AIDA123").Prop("Name", "jdoe").Prop("Arn", "arn:aws:iam::123456789012:user/jdoe").Build(),
+		resourcetest.Bucket("my-bucket").Prop("Name", "my-bucket").Build(),
+	)
+
+	resolve := graphNameResolver(g)
+	tcases := []struct {
+		idOrArn, name string
+	}{
+		{idOrArn: "i-123", name: "redis"},
+		{idOrArn: "arn:aws:iam::123456789012:user/jdoe", name: "jdoe"},
+		{idOrArn: "i-456", name: ""},
+		{idOrArn: "my-bucket", name: ""},
+		{idOrArn: "i-unknown", name: ""},
+	}
+	for i, tcase := range tcases {
+		if got, want := resolve(tcase.idOrArn), tcase.name; got != want {
+			t.Fatalf("%d: %s: got '%s', want '%s'", i+1, tcase.idOrArn, got, want)
+		}
+	}
+}
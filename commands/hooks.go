@@ -31,6 +31,7 @@ import (
 	"github.com/wallix/awless/database"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/sync/repo"
 )
 
 func applyHooks(funcs ...func(*cobra.Command, []string) error) func(*cobra.Command, []string) {
@@ -126,6 +127,9 @@ func initCloudServicesHook(cmd *cobra.Command, args []string) error {
 	if err := awsservices.Init(profile, region, config.GetConfigWithPrefix("aws."), logger.DefaultLogger, config.SetProfileCallback, networkMonitorFlag); err != nil {
 		return err
 	}
+	for _, srv := range pluginServices(profile, region) {
+		cloud.ServiceRegistry[srv.Name()] = srv
+	}
 
 	if config.TriggerSyncOnConfigUpdate && !strings.HasPrefix(cmd.Name(), "sync") {
 		var services []cloud.Service
@@ -159,6 +163,33 @@ func initSyncerHook(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var atRevision *repo.Rev
+
+func initAtRevisionHook(cmd *cobra.Command, args []string) error {
+	if atGlobalFlag == "" {
+		return nil
+	}
+	localGlobalFlag = true
+
+	r, err := repo.New()
+	if err != nil {
+		return err
+	}
+	revs, err := r.List()
+	if err != nil {
+		return err
+	}
+	rev, err := repo.FindRev(revs, atGlobalFlag)
+	if err != nil {
+		return err
+	}
+	if atRevision, err = r.LoadRev(rev.Id); err != nil {
+		return fmt.Errorf("loading revision %s: %s", rev.Id, err)
+	}
+	logger.Infof("working offline on resources synced on %s (revision %s)", atRevision.DateString(), atRevision.Id[:7])
+	return nil
+}
+
 func initLoggerHook(cmd *cobra.Command, args []string) error {
 	var flag int
 	if verboseGlobalFlag {
@@ -25,25 +25,30 @@ import (
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/inspect"
+	"github.com/wallix/awless/inspect/inspectors"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
 )
 
 var (
-	inspectorFlag string
+	inspectorFlag     string
+	inspectPolicyFlag string
+	inspectFixFlag    bool
 )
 
 func init() {
 	RootCmd.AddCommand(inspectCmd)
 
 	inspectCmd.Flags().StringVarP(&inspectorFlag, "inspector", "i", "", "Indicates which inspector to run")
+	inspectCmd.Flags().StringVar(&inspectPolicyFlag, "policy", "", fmt.Sprintf("Policy file used by the tags inspector (default: ~/.awless/%s)", inspectors.DefaultTagsPolicyFilename))
+	inspectCmd.Flags().BoolVar(&inspectFixFlag, "fix", false, "Output an awless template fixing the violations found by the tags inspector")
 }
 
 var inspectCmd = &cobra.Command{
 	Use:               "inspect",
 	Short:             "Analyze your infrastructure through inspectors",
 	Long:              fmt.Sprintf("Basic proof of concept inspectors to analyze your infrastructure: %s", allInspectors()),
-	Example:           "  awless inspect -i bucket_sizer\n  awless inspect -i pricer\n  awless inspect -i port_scanner",
+	Example:           "  awless inspect -i bucket_sizer\n  awless inspect -i pricer\n  awless inspect -i port_scanner\n  awless inspect -i tags --policy ./tags-policy.yml\n  awless inspect -i tags --fix > fixtags.aws",
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
 
@@ -53,6 +58,11 @@ var inspectCmd = &cobra.Command{
 			return fmt.Errorf("command needs a valid inspector: %s", allInspectors())
 		}
 
+		if tags, ok := inspector.(*inspectors.TagsCompliance); ok {
+			tags.PolicyFile = inspectPolicyFlag
+			tags.Fix = inspectFixFlag
+		}
+
 		if !localGlobalFlag {
 			logger.Info("Running full sync before inspection (disable it with --local flag)\n")
 			var services []cloud.Service
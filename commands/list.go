@@ -18,6 +18,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"sort"
@@ -26,8 +27,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
 	"github.com/wallix/awless/config"
 	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
 )
@@ -43,6 +46,7 @@ var (
 	noHeadersFlag              bool
 	sortBy                     []string
 	reverseFlag                bool
+	allRegionsFlag             bool
 )
 
 func init() {
@@ -75,13 +79,14 @@ func init() {
 	listCmd.PersistentFlags().BoolVar(&noHeadersFlag, "no-headers", false, "Do not display headers")
 	listCmd.PersistentFlags().BoolVar(&reverseFlag, "reverse", false, "Use in conjunction with --sort to reverse sort")
 	listCmd.PersistentFlags().StringSliceVar(&sortBy, "sort", []string{"Id"}, "Sort tables by column(s) name(s)")
+	listCmd.PersistentFlags().BoolVar(&allRegionsFlag, "all-regions", false, "List locally synced resources of all regions of the profile")
 }
 
 var listCmd = &cobra.Command{
 	Use:               "list",
 	Aliases:           []string{"ls"},
-	Example:           "  awless list instances --sort uptime\n  awless list users --format csv\n  awless list volumes --filter state=use --filter type=gp2\n  awless list volumes --tag-value Purchased\n  awless list vpcs --tag-key Dept --tag-key Internal\n  awless list instances --tag Env=Production,Dept=Marketing\n  awless list instances --filter state=running,type=micro\n  awless list s3objects --filter bucket=pdf-bucket ",
-	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, firstInstallDoneHook),
+	Example:           "  awless list instances --sort uptime\n  awless list instances --sort cpu-avg-24h --reverse\n  awless list volumes --columns id,type,size,cost --sort cost\n  awless list users --format csv\n  awless list volumes --filter state=use --filter type=gp2\n  awless list volumes --tag-value Purchased\n  awless list vpcs --tag-key Dept --tag-key Internal\n  awless list instances --tag Env=Production,Dept=Marketing\n  awless list instances --filter state=running,type=micro\n  awless list s3objects --filter bucket=pdf-bucket\n  awless list instances --filter state=running --at 2017-01-18\n  awless list instances --all-regions --sort region",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initAtRevisionHook, initCloudServicesHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
 	Short:             "List resources: sorting, filtering via tag/properties, output formatting, etc...",
 }
@@ -109,9 +114,21 @@ var listSpecificResourceCmd = func(resType string) *cobra.Command {
 			}
 			var g cloud.GraphAPI
 
-			if localGlobalFlag {
+			if allRegionsFlag && atRevision != nil {
+				exitOn(errors.New("--all-regions cannot be used with --at"))
+			}
+
+			if atRevision != nil {
+				g = atRevision.Graph(config.GetAWSProfile(), config.GetAWSRegion())
+			} else if allRegionsFlag {
+				var err error
+				g, err = sync.LoadAllRegionsLocalGraphs(config.GetAWSProfile())
+				exitOn(err)
+				warnIfNotFresh(g, resType)
+			} else if localGlobalFlag {
 				if srvName, ok := awsservices.ServicePerResourceType[resType]; ok {
 					g = sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion())
+					warnIfNotFresh(g, resType)
 				} else {
 					exitOn(fmt.Errorf("cannot find service for resource type %s", resType))
 				}
@@ -135,7 +152,12 @@ var listAllResourceInServiceCmd = func(srvName string) *cobra.Command {
 		Hidden: true,
 
 		Run: func(cmd *cobra.Command, args []string) {
-			g := sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion())
+			var g cloud.GraphAPI
+			if atRevision != nil {
+				g = atRevision.Graph(config.GetAWSProfile(), config.GetAWSRegion())
+			} else {
+				g = sync.LoadLocalGraphForService(srvName, config.GetAWSProfile(), config.GetAWSRegion())
+			}
 			displayer, err := console.BuildOptions(
 				console.WithFormat(listingFormat),
 				console.WithMaxWidth(console.GetTerminalWidth()),
@@ -147,10 +169,46 @@ var listAllResourceInServiceCmd = func(srvName string) *cobra.Command {
 	}
 }
 
+// Warn when the locally synced resources of this type failed to sync or are stale.
+// Graphs synced before freshness was recorded and types whose sync is disabled in config are not reported.
+func warnIfNotFresh(g cloud.GraphAPI, resType string) {
+	gph, ok := g.(*graph.Graph)
+	if !ok {
+		return
+	}
+	if key := config.SyncDisabledBy(awsservices.ServicePerResourceType[resType], resType); key != "" {
+		logger.Verbosef("sync of %s is disabled by config (%s=false)", cloud.PluralizeResource(resType), key)
+		return
+	}
+	all, err := gph.Freshness()
+	if err != nil || len(all) == 0 {
+		return
+	}
+	f, ok := all[resType]
+	switch {
+	case !ok:
+		logger.Warningf("%s have never been synced locally (run `awless sync --type %s`)", cloud.PluralizeResource(resType), resType)
+	case f.Failed():
+		logger.Warningf("last sync of %s failed %s ago: %s", cloud.PluralizeResource(resType), console.HumanizeTime(f.FetchedAt), f.Err)
+	case f.IsStale(config.GetSyncStaleAfter()):
+		logger.Warningf("%s are stale: last synced %s ago", cloud.PluralizeResource(resType), console.HumanizeTime(f.FetchedAt))
+	}
+}
+
 func printResources(g cloud.GraphAPI, resType string) {
+	columns := listingColumnsFlag
+	if allRegionsFlag {
+		if len(columns) == 0 {
+			columns = console.ColumnsInListing[resType]
+		}
+		columns = append([]string{properties.Region}, columns...)
+	}
+	if wantsCostColumn(columns, sortBy) {
+		addCostEstimates(g, resType)
+	}
 	displayer, err := console.BuildOptions(
 		console.WithRdfType(resType),
-		console.WithColumns(listingColumnsFlag),
+		console.WithColumns(columns),
 		console.WithFilters(listingFiltersFlag),
 		console.WithTagFilters(listingTagFiltersFlag),
 		console.WithTagKeyFilters(listingTagKeyFiltersFlag),
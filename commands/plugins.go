@@ -0,0 +1,93 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/plugin"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+	// Go plugins are compiled in awless by importing their package here, ex:
+	// _ "github.com/myteam/awless-cmdb"
+)
+
+// Loaded before commands are built (in init functions), so that plugin resource types get their commands
+var plugins = loadPlugins(filepath.Join(config.AwlessHome, "plugins"))
+
+// loadPlugins registers the external plugins of the given directory and declares the services
+// and resource types of all plugins alongside the AWS ones
+func loadPlugins(dir string) (loaded []plugin.Plugin) {
+	if err := plugin.LoadDir(dir); err != nil {
+		logger.Warning(err)
+	}
+	for _, p := range plugin.All() {
+		m := p.Manifest()
+		if err := checkPluginConflicts(m); err != nil {
+			logger.Warningf("plugin %s ignored: %s", m.Name, err)
+			continue
+		}
+		awsservices.ServiceNames = append(awsservices.ServiceNames, m.Name)
+		for _, t := range m.ResourceTypes {
+			awsservices.ResourceTypes = append(awsservices.ResourceTypes, t)
+			awsservices.ServicePerResourceType[t] = m.Name
+			awsservices.APIPerResourceType[t] = m.Name
+			columns := []string{properties.ID}
+			for _, prop := range m.Properties {
+				columns = append(columns, prop.Label)
+			}
+			console.ColumnsInListing[t] = columns
+		}
+		if m.Global {
+			sync.GlobalServices[m.Name] = true
+		}
+		loaded = append(loaded, p)
+	}
+	return
+}
+
+func pluginServices(profile, region string) (services []cloud.Service) {
+	for _, p := range plugins {
+		srv, err := p.NewService(profile, region)
+		if err != nil {
+			logger.Warningf("plugin %s: %s", p.Manifest().Name, err)
+			continue
+		}
+		services = append(services, srv)
+	}
+	return
+}
+
+func checkPluginConflicts(m *plugin.Manifest) error {
+	for _, name := range awsservices.ServiceNames {
+		if name == m.Name {
+			return fmt.Errorf("service %s already exists", name)
+		}
+	}
+	for _, t := range m.ResourceTypes {
+		if srv, exists := awsservices.ServicePerResourceType[t]; exists {
+			return fmt.Errorf("resource type %s already provided by service %s", t, srv)
+		}
+	}
+	return nil
+}
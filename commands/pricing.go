@@ -0,0 +1,108 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/pricing"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+)
+
+var pricingOfferFilesFlag []string
+
+func init() {
+	RootCmd.AddCommand(pricingCmd)
+	pricingCmd.AddCommand(pricingUpdateCmd)
+
+	pricingUpdateCmd.Flags().StringSliceVar(&pricingOfferFilesFlag, "offer-file", []string{}, "Import local AWS offer files (JSON) instead of downloading them")
+}
+
+var pricingCmd = &cobra.Command{
+	Use:               "pricing",
+	Short:             "Manage the local AWS price list used to estimate the monthly cost of resources",
+	Example:           "  awless pricing update\n  awless pricing update --aws-region eu-west-1\n  awless pricing update --offer-file ./ec2-offer.json --offer-file ./rds-offer.json\n  awless list instances --columns id,name,type,cost\n  awless inspect -i pricer",
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
+}
+
+var pricingUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Download the current AWS on-demand prices of the region (EC2 & RDS offers) for offline cost estimates",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		region := config.GetAWSRegion()
+		var list *pricing.PriceList
+		var err error
+		if len(pricingOfferFilesFlag) > 0 {
+			list, err = pricing.ReadOfferFiles(region, pricingOfferFilesFlag...)
+		} else {
+			logger.Infof("downloading %s offers of region %s (this may take a while)", strings.Join(pricing.Offers, ", "), region)
+			list, err = pricing.Download(region)
+		}
+		exitOn(err)
+		exitOn(list.Save(pricing.DefaultDir()))
+		logger.Infof("%d prices of region %s saved in %s", list.Len(), region, pricing.DefaultDir())
+	},
+}
+
+func wantsCostColumn(columns, sorting []string) bool {
+	for _, c := range append(append([]string{}, columns...), sorting...) {
+		if strings.EqualFold(c, properties.Cost) {
+			return true
+		}
+	}
+	return false
+}
+
+// addCostEstimates sets the estimated monthly cost of the resources of a type,
+// using the price list of their region
+func addCostEstimates(g cloud.GraphAPI, resType string) {
+	gph, ok := g.(*graph.Graph)
+	if !ok || !pricing.Priced(resType) {
+		return
+	}
+	resources, err := gph.GetAllResources(resType)
+	exitOn(err)
+
+	lists := make(map[string]*pricing.PriceList)
+	for _, res := range resources {
+		region := config.GetAWSRegion()
+		if r, ok := res.Properties()[properties.Region].(string); ok && r != "" {
+			region = r
+		}
+		list, loaded := lists[region]
+		if !loaded {
+			if list, err = pricing.Load(pricing.DefaultDir(), region); err != nil {
+				logger.Warning(err)
+			}
+			lists[region] = list
+		}
+		if list == nil {
+			continue
+		}
+		if cost, ok := list.MonthlyCost(res); ok {
+			res.Properties()[properties.Cost] = cost
+			exitOn(gph.AddResource(res))
+		}
+	}
+}
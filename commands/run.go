@@ -359,6 +359,24 @@ func runSyncFor(tplExec *template.TemplateExecution) {
 	if tplExec.Stats().AllKO() {
 		return
 	}
+	defer runPeriodicSyncGC()
+
+	if types, ok := touchedResourceTypes(tplExec.Template); ok {
+		servicesTypes, err := servicesForTypes(types...)
+		if err == nil {
+			if !noSyncGlobalFlag {
+				go func() { // allow to only display this verbose line only if taking more than 1 second before exiting CLI
+					time.Sleep(2 * time.Second)
+					logger.Infof("Resyncing %s ... (disable with --no-sync global flag)", joinSentence(pluralizeAll(types)))
+				}()
+			}
+			if _, err := sync.DefaultSyncer.SyncTypes(servicesTypes); err != nil {
+				logger.ExtraVerbose(err)
+			}
+			return
+		}
+		logger.ExtraVerbose(err)
+	}
 
 	apis := tplExec.Template.UniqueDefinitions(awsspec.APIPerTemplateDefName)
 
@@ -375,6 +393,46 @@ func runSyncFor(tplExec *template.TemplateExecution) {
 	}
 }
 
+// Resource types modified as a side effect of commands on a given entity
+var autosyncSideEffectTypes = map[string][]string{
+	cloud.Instance: {cloud.Volume, cloud.NetworkInterface},
+	cloud.Vpc:      {cloud.RouteTable, cloud.SecurityGroup},
+}
+
+// touchedResourceTypes returns the resource types modified by the commands of the template.
+// It returns false when some commands modify other things than their entity (ex: tags, attachments),
+// meaning whole services have to be synced.
+func touchedResourceTypes(tpl *template.Template) ([]string, bool) {
+	unique := make(map[string]bool)
+	var types []string
+	add := func(t string) {
+		if !unique[t] {
+			unique[t] = true
+			types = append(types, t)
+		}
+	}
+	for _, cmd := range tpl.CommandNodesIterator() {
+		if _, isResourceType := awsservices.ServicePerResourceType[cmd.Entity]; !isResourceType {
+			return nil, false
+		}
+		if cmd.Action == "attach" || cmd.Action == "detach" {
+			return nil, false
+		}
+		add(cmd.Entity)
+		for _, t := range autosyncSideEffectTypes[cmd.Entity] {
+			add(t)
+		}
+	}
+	return types, len(types) > 0
+}
+
+func pluralizeAll(types []string) (out []string) {
+	for _, t := range types {
+		out = append(out, cloud.PluralizeResource(t))
+	}
+	return
+}
+
 func resolveAliasFunc(paramPath, alias string) string {
 	splits := strings.Split(paramPath, ".")
 	if len(splits) != 3 {
@@ -16,13 +16,24 @@ import (
 	"github.com/wallix/awless/template/env"
 )
 
-func NewRunnerRequiredParamsOnly(tpl *template.Template, msg, tplPath string, fillers ...map[string]interface{}) *template.Runner {
+// templateRunner runs templates tagging the AWS requests they make with their ID
+type templateRunner struct {
+	*template.Runner
+}
+
+// Run clears the running template ID once done, even when failing before AfterRun
+func (r *templateRunner) Run() error {
+	defer awsservices.SetRunningTemplateID("")
+	return r.Runner.Run()
+}
+
+func NewRunnerRequiredParamsOnly(tpl *template.Template, msg, tplPath string, fillers ...map[string]interface{}) *templateRunner {
 	r := NewRunner(tpl, msg, tplPath, fillers...)
 	r.ParamsSuggested = env.REQUIRED_PARAMS_ONLY
 	return r
 }
 
-func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[string]interface{}) *template.Runner {
+func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[string]interface{}) *templateRunner {
 	runner := &template.Runner{}
 
 	runner.Template = tpl
@@ -34,6 +45,9 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 	runner.Fillers = fillers
 	runner.AliasFunc = resolveAliasFunc
 	runner.MissingHolesFunc = missingHolesStdinFunc()
+	if security, ok := awsservices.SecurityService.(*awsservices.Security); ok {
+		runner.FillSources = []env.FillSource{security.ParameterStore()}
+	}
 	if allSuggestedParamsFlag {
 		runner.ParamsSuggested = env.ALL_PARAMS
 	}
@@ -84,6 +98,7 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 			if isSchedulingMode() {
 				return false, scheduleTemplate(tplExec.Template, scheduleRunInFlag, scheduleRevertInFlag)
 			}
+			awsservices.SetRunningTemplateID(tplExec.Template.ID)
 			return true, nil
 		}
 		os.Exit(1)
@@ -91,9 +106,10 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 	}
 
 	runner.AfterRun = func(tplExec *template.TemplateExecution) error {
+		awsservices.SetRunningTemplateID("")
 		if tplExec.Message == "" {
 			if tplExec.IsOneLiner() {
-				tplExec.SetMessage(fmt.Sprintf("Run %s", tplExec.Template))
+				tplExec.SetMessage(fmt.Sprintf("Run %s", tplExec.Template.MaskedString()))
 			} else if path := tplExec.Path; path != "" {
 				stats := tplExec.Stats()
 				if stats.KOCount > 0 {
@@ -120,5 +136,5 @@ func NewRunner(tpl *template.Template, msg, tplPath string, fillers ...map[strin
 		return nil
 	}
 
-	return runner
+	return &templateRunner{runner}
 }
@@ -47,6 +47,7 @@ func init() {
 	showCmd.Flags().BoolVar(&listAllSiblingsFlag, "siblings", false, "List all the resource's siblings")
 	showCmd.Flags().BoolVar(&noAliasFlag, "no-alias", false, "Disable the resolution of ID to alias")
 	showCmd.Flags().StringSliceVar(&showPropertiesValuesOnlyFlag, "values-for", []string{}, "Output values only for given properties keys")
+	showCmd.Flags().BoolVar(&allRegionsFlag, "all-regions", false, "Look for the resource in all locally synced regions of the profile")
 }
 
 var showCmd = &cobra.Command{
@@ -55,8 +56,10 @@ var showCmd = &cobra.Command{
 	Example: `  awless show i-8d43b21b            # show an instance via its ref
   awless show AIDAJ3Z24GOKHTZO4OIX6 # show a user via its ref
   awless show jsmith                # show a user via its ref,
-  awless show @jsmith               # forcing search by name`,
-	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
+  awless show @jsmith               # forcing search by name
+  awless show @jsmith --at 7d       # show a user as synced 7 days ago
+  awless show @redis --all-regions  # look in all locally synced regions`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initAtRevisionHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -67,7 +70,7 @@ var showCmd = &cobra.Command{
 		ref := args[0]
 		notFound := fmt.Errorf("resource '%s' not found", deprefix(ref))
 
-		if _, err := awsconfig.ParseRegion(ref); err == nil && ref != config.GetAWSRegion() {
+		if _, err := awsconfig.ParseRegion(ref); err == nil && ref != config.GetAWSRegion() && !allRegionsFlag {
 			logger.Errorf("Cannot show region '%s' as you are in region '%s'", ref, config.GetAWSRegion())
 			logger.Infof("Use `awless show %s -r %s`", ref, ref)
 			os.Exit(1)
@@ -78,7 +81,7 @@ var showCmd = &cobra.Command{
 
 		resource, gph = findResourceInLocalGraphs(ref)
 
-		if resource == nil && localGlobalFlag {
+		if resource == nil && (localGlobalFlag || allRegionsFlag) {
 			exitOn(decorateWithSuggestion(notFound, ref))
 		} else if resource == nil {
 			runFullSync()
@@ -88,7 +91,7 @@ var showCmd = &cobra.Command{
 			}
 		}
 
-		if !localGlobalFlag && config.GetAutosync() {
+		if !localGlobalFlag && !allRegionsFlag && config.GetAutosync() {
 			var services []cloud.Service
 			if resource.Type() == cloud.Region {
 				services = append(services, cloud.AllServices()...)
@@ -154,7 +157,7 @@ func showResourceValuesOnlyFor(resource cloud.Resource, propKeys []string) {
 
 func showResource(resource cloud.Resource, gph cloud.GraphAPI) {
 	displayer, err := console.BuildOptions(
-		console.WithColumnDefinitions(console.DefaultsColumnDefinitions[resource.Type()]),
+		console.WithColumnDefinitions(console.ColumnDefinitionsOf(resource.Type())),
 		console.WithFormat(listingFormat),
 		console.WithMaxWidth(console.GetTerminalWidth()),
 	).SetSource(resource).Build()
@@ -236,17 +239,24 @@ func runFullSync() {
 }
 
 func findResourceInLocalGraphs(ref string) (cloud.Resource, cloud.GraphAPI) {
-	g, resources, _ := resolveResourceFromRefInCurrentRegion(ref)
+	resolve, where := resolveResourceFromRefInCurrentRegion, fmt.Sprintf("region '%s'", config.GetAWSRegion())
+	if allRegionsFlag {
+		resolve, where = resolveResourceFromRefInAllLocalRegion, "all regions"
+	}
+	g, resources, _ := resolve(ref)
 	switch len(resources) {
 	case 0:
 		return nil, nil
 	case 1:
 		return resources[0], g
 	default:
-		logger.Infof("%d resources found with name '%s' in region '%s' for profile '%s'. Show a specific resource with:", len(resources), deprefix(ref), config.GetAWSRegion(), config.GetAWSProfile())
+		logger.Infof("%d resources found with name '%s' in %s for profile '%s'. Show a specific resource with:", len(resources), deprefix(ref), where, config.GetAWSProfile())
 		for _, res := range resources {
 			var buf bytes.Buffer
 			buf.WriteString(fmt.Sprintf("\t`awless show %s` to show the %s", res.Id(), res.Type()))
+			if region, ok := res.Properties()[properties.Region].(string); ok && allRegionsFlag {
+				buf.WriteString(fmt.Sprintf(" in region '%s'", region))
+			}
 			if state, ok := res.Properties()[properties.State].(string); ok {
 				buf.WriteString(fmt.Sprintf(" (state: '%s')", state))
 			}
@@ -260,13 +270,16 @@ func findResourceInLocalGraphs(ref string) (cloud.Resource, cloud.GraphAPI) {
 }
 
 func resolveResourceFromRefInCurrentRegion(ref string) (cloud.GraphAPI, []cloud.Resource, string) {
+	if atRevision != nil {
+		return resolveResourceFromRef(atRevision.Graph(config.GetAWSProfile(), config.GetAWSRegion()), ref)
+	}
 	g, err := sync.LoadLocalGraphs(config.GetAWSProfile(), config.GetAWSRegion())
 	exitOn(err)
 	return resolveResourceFromRef(g, ref)
 }
 
 func resolveResourceFromRefInAllLocalRegion(ref string) (cloud.GraphAPI, []cloud.Resource, string) {
-	g, err := sync.LoadAllLocalGraphs(config.GetAWSProfile())
+	g, err := sync.LoadAllRegionsLocalGraphs(config.GetAWSProfile())
 	exitOn(err)
 	return resolveResourceFromRef(g, ref)
 }
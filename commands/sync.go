@@ -17,30 +17,57 @@ limitations under the License.
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"runtime"
 	"runtime/pprof"
+	"sort"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/wallix/awless/aws/config"
 	"github.com/wallix/awless/aws/services"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/console"
+	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/sync/repo"
 )
 
 var (
 	servicesToSyncFlags map[string]*bool
 	profileSyncFlag     bool
+	syncTypesFlag       []string
+	syncRegionsFlag     []string
+	syncProfilesFlag    []string
+	syncConcurrencyFlag int
+	syncStatusFlag      bool
+
+	syncGCKeepDailyFlag  int
+	syncGCKeepWeeklyFlag int
 )
 
 func init() {
 	RootCmd.AddCommand(syncCmd)
+	syncCmd.AddCommand(syncPushCmd)
+	syncCmd.AddCommand(syncPullCmd)
+	syncCmd.AddCommand(syncGCCmd)
+	syncGCCmd.Flags().IntVar(&syncGCKeepDailyFlag, "keep-daily", 0, "Keep the last revision of each of the given latest days (default: config key sync.gc.keepdaily)")
+	syncGCCmd.Flags().IntVar(&syncGCKeepWeeklyFlag, "keep-weekly", 0, "Keep the last revision of each of the given latest weeks (default: config key sync.gc.keepweekly)")
 	syncCmd.Flags().BoolVar(&profileSyncFlag, "profile-sync", false, "Will dump a cpu and mem profiling file")
+	syncCmd.Flags().StringSliceVar(&syncTypesFlag, "type", nil, "Sync only the given resource types (ex: --type instance,subnet), merged into the local store")
+	syncCmd.Flags().StringSliceVar(&syncRegionsFlag, "regions", nil, "Sync the given regions: 'all', names or patterns (ex: --regions eu-*,us-east-1)")
+	syncCmd.Flags().StringSliceVar(&syncProfilesFlag, "profiles", nil, "Sync the given profiles (ex: --profiles prod,staging)")
+	syncCmd.Flags().BoolVar(&syncStatusFlag, "status", false, "Print when each resource type was last synced locally per region and service, and whether it failed or is stale")
+	syncCmd.Flags().IntVar(&syncConcurrencyFlag, "concurrency", 4, "Maximum number of region/profile pairs synced at the same time (with --regions or --profiles)")
 
 	servicesToSyncFlags = make(map[string]*bool)
 	for _, service := range awsservices.ServiceNames {
@@ -50,12 +77,24 @@ func init() {
 }
 
 var syncCmd = &cobra.Command{
-	Use:               "sync",
-	Short:             "Manual sync of remote resources to the local store (ex: when autosync is unset)",
+	Use:   "sync",
+	Short: "Manual sync of remote resources to the local store (ex: when autosync is unset)",
+	Example: `  awless sync
+  awless sync --infra --access
+  awless sync --type instance,subnet
+  awless sync --regions all
+  awless sync --status
+  awless sync --daemon --interval 5m
+  awless sync --daemon --infra --webhook https://hooks.example.com/awless
+  awless sync --regions eu-*,us-east-1 --profiles prod,staging --infra`,
 	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initCloudServicesHook, initSyncerHook, firstInstallDoneHook),
 	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade, networkMonitorHook),
 
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if syncStatusFlag {
+			printSyncStatus()
+			return nil
+		}
 		var services []cloud.Service
 		displayAllServices := true
 		for _, srv := range cloud.ServiceRegistry {
@@ -63,8 +102,37 @@ var syncCmd = &cobra.Command{
 				displayAllServices = false
 			}
 		}
+		isSelected := func(name string) bool {
+			return displayAllServices || *servicesToSyncFlags[name]
+		}
+
+		if syncDaemonFlag {
+			if len(syncRegionsFlag) > 0 || len(syncProfilesFlag) > 0 || len(syncTypesFlag) > 0 {
+				return errors.New("--daemon cannot be used with --regions, --profiles or --type")
+			}
+			for _, srv := range cloud.ServiceRegistry {
+				if isSelected(srv.Name()) {
+					services = append(services, srv)
+				}
+			}
+			emit := jsonLinesEmitter(os.Stdout)
+			if syncDaemonWebhookFlag != "" {
+				emit = webhookEmitter(syncDaemonWebhookFlag)
+			}
+			runSyncDaemon(services, syncDaemonIntervalFlag, emit)
+			return nil
+		}
+
+		if len(syncRegionsFlag) > 0 || len(syncProfilesFlag) > 0 {
+			if len(syncTypesFlag) > 0 {
+				return errors.New("--type cannot be used with --regions or --profiles")
+			}
+			runPairsSync(isSelected)
+			return nil
+		}
+
 		for _, srv := range cloud.ServiceRegistry {
-			if displayAllServices || *servicesToSyncFlags[srv.Name()] {
+			if isSelected(srv.Name()) {
 				services = append(services, srv)
 			}
 		}
@@ -72,7 +140,6 @@ var syncCmd = &cobra.Command{
 		for _, service := range services {
 			localGraphs[service.Name()] = sync.LoadLocalGraphForService(service.Name(), config.GetAWSProfile(), config.GetAWSRegion())
 		}
-		logger.Infof("running sync for region '%s'", config.GetAWSRegion())
 
 		var syncErr error
 		var graphs map[string]cloud.GraphAPI
@@ -80,6 +147,17 @@ var syncCmd = &cobra.Command{
 			graphs, syncErr = sync.DefaultSyncer.Sync(services...)
 		}
 
+		if len(syncTypesFlag) > 0 {
+			types, err := servicesForTypes(syncTypesFlag...)
+			exitOn(err)
+			syncFn = func() {
+				graphs, syncErr = sync.DefaultSyncer.SyncTypes(types)
+			}
+			logger.Infof("running sync of %s for region '%s'", strings.Join(syncTypesFlag, ", "), config.GetAWSRegion())
+		} else {
+			logger.Infof("running sync for region '%s'", config.GetAWSRegion())
+		}
+
 		start := time.Now()
 		if profileSyncFlag {
 			withProfiling(syncFn)
@@ -99,6 +177,162 @@ var syncCmd = &cobra.Command{
 	},
 }
 
+func printSyncStatus() {
+	all, err := sync.LoadFreshness(config.GetAWSProfile())
+	exitOn(err)
+	if len(all) == 0 {
+		logger.Infof("no freshness recorded for profile '%s' (run `awless sync`)", config.GetAWSProfile())
+		return
+	}
+
+	staleAfter := config.GetSyncStaleAfter()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "REGION\tSERVICE\tTYPE\tSYNCED\tSTATUS")
+	for _, f := range all {
+		status := renderGreenFn("ok")
+		switch {
+		case f.Failed():
+			status = renderRedFn("failed: " + f.Err)
+		case f.IsStale(staleAfter):
+			status = renderYellowFn("stale")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s ago\t%s\n", f.Region, f.Service, f.ResourceType, console.HumanizeTime(f.FetchedAt), status)
+	}
+	w.Flush()
+}
+
+var syncPushCmd = &cobra.Command{
+	Use:   "push [REMOTE]",
+	Short: "Push your local sync history to the shared sync store (config key sync.remote), merging its revisions first",
+	Example: `  awless sync push
+  awless sync push git@github.com:myteam/awless-sync.git
+  awless sync push s3://mybucket/awless?endpoint=http://localhost:9000`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		remote, err := syncRemote(args)
+		exitOn(err)
+		exitOn(sync.DefaultSyncer.Push(remote))
+		logger.Infof("sync history pushed to %s", remote)
+		return nil
+	},
+}
+
+var syncPullCmd = &cobra.Command{
+	Use:   "pull [REMOTE]",
+	Short: "Pull the shared sync store history (config key sync.remote). On conflicts, the latest revision wins",
+	Example: `  awless sync pull
+  awless sync pull fs:///mnt/team/awless-sync`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		remote, err := syncRemote(args)
+		exitOn(err)
+		exitOn(sync.DefaultSyncer.Pull(remote))
+		logger.Infof("sync history pulled from %s", remote)
+		return nil
+	},
+}
+
+var syncGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Compact your local sync history according to a retention policy (the latest revision is always kept)",
+	Long:  "Compact your local sync history according to a retention policy (the latest revision is always kept). Set `sync.gc.frequency` in config to let autosync run it periodically. Histories shared through `sync.remote` are never compacted.",
+	Example: `  awless sync gc
+  awless sync gc --keep-daily 30 --keep-weekly 12`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		policy := syncGCRetentionPolicy()
+		if cmd.Flags().Changed("keep-daily") {
+			policy.KeepDaily = syncGCKeepDailyFlag
+		}
+		if cmd.Flags().Changed("keep-weekly") {
+			policy.KeepWeekly = syncGCKeepWeeklyFlag
+		}
+		if policy.KeepDaily < 0 || policy.KeepWeekly < 0 {
+			return errors.New("invalid negative retention")
+		}
+		if remote := config.GetSyncRemote(); remote != "" {
+			return fmt.Errorf("sync history is shared with remote %s (config key sync.remote): gc only compacts local histories", remote)
+		}
+
+		logger.Verbosef("compacting sync history %s", policy)
+		stats, err := sync.DefaultSyncer.GC(policy)
+		exitOn(err)
+		logger.Infof("kept %d of %d revisions, reclaimed %s (%s now)", stats.RevisionsAfter, stats.RevisionsBefore,
+			console.HumanizeStorage(uint64(stats.Reclaimed()), 0), console.HumanizeStorage(uint64(stats.SizeAfter), 0))
+		return nil
+	},
+}
+
+func syncGCRetentionPolicy() repo.RetentionPolicy {
+	daily, weekly := config.GetSyncGCRetention()
+	return repo.RetentionPolicy{KeepDaily: daily, KeepWeekly: weekly}
+}
+
+const lastSyncGCDbKey = "sync.gc.lastrun"
+
+// Compact sync history when config frequency is set and elapsed since last run
+func runPeriodicSyncGC() {
+	freq := config.GetSyncGCFrequency()
+	if freq < 0 || config.GetSyncRemote() != "" {
+		return
+	}
+	err := database.Execute(func(db *database.DB) error {
+		last, err := db.GetTimeValue(lastSyncGCDbKey)
+		if err != nil {
+			return err
+		}
+		if time.Since(last) < freq {
+			return nil
+		}
+		stats, err := sync.DefaultSyncer.GC(syncGCRetentionPolicy())
+		if err != nil {
+			return err
+		}
+		logger.ExtraVerbosef("sync history compacted: kept %d of %d revisions, reclaimed %s", stats.RevisionsAfter, stats.RevisionsBefore, console.HumanizeStorage(uint64(stats.Reclaimed()), 0))
+		return db.SetTimeValue(lastSyncGCDbKey, time.Now())
+	})
+	if err != nil {
+		logger.ExtraVerbose(err)
+	}
+}
+
+func syncRemote(args []string) (repo.Remote, error) {
+	remoteURL := config.GetSyncRemote()
+	if len(args) > 0 {
+		remoteURL = args[0]
+	}
+	if remoteURL == "" {
+		return nil, errors.New("no shared sync store: give it as argument or set it with `awless config set sync.remote URL`")
+	}
+
+	switch {
+	case strings.HasPrefix(remoteURL, "fs://"):
+		return repo.NewObjectStoreRemote(repo.NewFSObjectStore(strings.TrimPrefix(remoteURL, "fs://"))), nil
+	case strings.HasPrefix(remoteURL, "s3://"):
+		u, err := url.Parse(remoteURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sync remote '%s': %s", remoteURL, err)
+		}
+		region := u.Query().Get("region")
+		if region == "" {
+			region = config.GetAWSRegion()
+		}
+		store, err := awsservices.NewS3SyncStore(config.GetAWSProfile(), region, u.Host, u.Path, u.Query().Get("endpoint"), logger.DefaultLogger)
+		if err != nil {
+			return nil, err
+		}
+		return repo.NewObjectStoreRemote(store), nil
+	default:
+		return repo.NewGitRemote(remoteURL), nil
+	}
+}
+
 func withProfiling(fn func()) {
 	logger.Infof("sync profiling on")
 	mem, err := os.Create("mem-sync.prof")
@@ -126,8 +360,102 @@ func withProfiling(fn func()) {
 	logger.Infof("Generated profiling files %s and %s", cpu.Name(), mem.Name())
 }
 
+func runPairsSync(isSelected func(string) bool) {
+	regions := []string{config.GetAWSRegion()}
+	if len(syncRegionsFlag) > 0 {
+		var err error
+		regions, err = awsconfig.MatchRegions(syncRegionsFlag...)
+		exitOn(err)
+	}
+	profiles := []string{config.GetAWSProfile()}
+	if len(syncProfilesFlag) > 0 {
+		profiles = syncProfilesFlag
+		for _, p := range profiles {
+			if p != config.GetAWSProfile() && !awsconfig.IsValidProfile(p) {
+				exitOn(fmt.Errorf("unknown profile '%s'", p))
+			}
+		}
+	}
+
+	pairErrors := make(map[string]error)
+	pairs := make(map[string][]cloud.Service)
+	for _, profile := range profiles {
+		for i, region := range regions {
+			pair := fmt.Sprintf("%s/%s", profile, region)
+			services, err := awsservices.NewServicesFor(profile, region, config.GetConfigWithPrefix("aws."), logger.DefaultLogger, networkMonitorFlag)
+			if err != nil {
+				pairErrors[pair] = err
+				continue
+			}
+			services = append(services, pluginServices(profile, region)...)
+			for _, srv := range services {
+				if !isSelected(srv.Name()) {
+					continue
+				}
+				if srv.Region() == "global" && i > 0 { // global services synced once per profile
+					continue
+				}
+				pairs[pair] = append(pairs[pair], srv)
+			}
+		}
+	}
+
+	logger.Infof("running sync for %d region(s) and %d profile(s), %d at a time", len(regions), len(profiles), syncConcurrencyFlag)
+	start := time.Now()
+	graphs, errs, err := sync.DefaultSyncer.SyncPairs(pairs, syncConcurrencyFlag)
+	for pair, e := range errs {
+		pairErrors[pair] = e
+	}
+
+	var sorted []string
+	for pair := range pairs {
+		sorted = append(sorted, pair)
+	}
+	for pair := range pairErrors {
+		if _, ok := pairs[pair]; !ok {
+			sorted = append(sorted, pair)
+		}
+	}
+	sort.Strings(sorted)
+
+	for _, pair := range sorted {
+		if e, ok := pairErrors[pair]; ok {
+			logger.Errorf("%s: %s", pair, e)
+		} else {
+			logger.Infof("%s: %s", pair, renderGreenFn("ok"))
+		}
+		for k, g := range graphs[pair] {
+			displaySyncStats(k, g)
+		}
+	}
+	if err != nil {
+		logger.Error(err)
+	}
+	logger.Infof("sync took %s", time.Since(start))
+}
+
+func servicesForTypes(types ...string) (map[cloud.Service][]string, error) {
+	servicesTypes := make(map[cloud.Service][]string)
+	for _, t := range types {
+		if _, ok := awsservices.ServicePerResourceType[t]; !ok {
+			t = cloud.SingularizeResource(t)
+		}
+		srv, err := cloud.GetServiceForType(t)
+		if err != nil {
+			return servicesTypes, err
+		}
+		servicesTypes[srv] = append(servicesTypes[srv], t)
+	}
+	return servicesTypes, nil
+}
+
 func displaySyncStats(serviceName string, g cloud.GraphAPI) {
 	var strs []string
+	// streamed resources are not held in the synced graphs, only counted in their freshness
+	freshness := make(map[string]*graph.Freshness)
+	if gph, ok := g.(*graph.Graph); ok {
+		freshness, _ = gph.Freshness()
+	}
 	for rt, service := range awsservices.ServicePerResourceType {
 		if service == serviceName {
 			res, err := g.Find(cloud.NewQuery(rt))
@@ -135,6 +463,9 @@ func displaySyncStats(serviceName string, g cloud.GraphAPI) {
 				continue
 			}
 			nbRes := len(res)
+			if f, ok := freshness[rt]; ok && f.Count > nbRes {
+				nbRes = f.Count
+			}
 			if nbRes > 1 {
 				strs = append(strs, fmt.Sprintf("%d %s", nbRes, cloud.PluralizeResource(rt)))
 			} else {
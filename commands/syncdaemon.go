@@ -0,0 +1,141 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/wallix/awless/aws/services"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+)
+
+var (
+	syncDaemonFlag         bool
+	syncDaemonIntervalFlag time.Duration
+	syncDaemonWebhookFlag  string
+)
+
+func init() {
+	syncCmd.Flags().BoolVar(&syncDaemonFlag, "daemon", false, "Keep syncing in the foreground at each interval, emitting resource changes as JSON lines on stdout (or to --webhook)")
+	syncCmd.Flags().DurationVar(&syncDaemonIntervalFlag, "interval", 5*time.Minute, "Interval between syncs with --daemon")
+	syncCmd.Flags().StringVar(&syncDaemonWebhookFlag, "webhook", "", "URL to which resource changes are POSTed as JSON with --daemon, instead of stdout")
+}
+
+// runSyncDaemon syncs the services at each interval until interrupted. Each sync takes the sync lock
+// (waited for by interactive commands) and the database is never opened, so that both can run along.
+// After each sync, the changes since the previous revision are emitted.
+func runSyncDaemon(services []cloud.Service, interval time.Duration, emit func(*sync.ChangeEvent) error) {
+	if interval < time.Minute {
+		exitOn(fmt.Errorf("sync daemon interval %s is too short (minimum: 1m)", interval))
+	}
+
+	var previous string
+	if revs, err := sync.DefaultSyncer.List(); err == nil && len(revs) > 0 {
+		previous = revs[len(revs)-1].Id
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt)
+
+	logger.Infof("sync daemon started: syncing %s every %s", joinSentence(cloud.Services(services).Names()), interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		previous = runSyncDaemonCycle(services, previous, emit)
+		select {
+		case <-stop:
+			logger.Info("sync daemon stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func runSyncDaemonCycle(services []cloud.Service, previous string, emit func(*sync.ChangeEvent) error) string {
+	start := time.Now()
+	if _, err := sync.DefaultSyncer.Sync(services...); err != nil {
+		logger.Warning(err)
+	}
+	logger.Verbosef("sync daemon: sync took %s", time.Since(start))
+
+	revs, err := sync.DefaultSyncer.List()
+	if err != nil || len(revs) == 0 {
+		logger.Warningf("sync daemon: cannot list revisions: %v", err)
+		return previous
+	}
+	last := revs[len(revs)-1].Id
+	if previous == "" || previous == last {
+		return last
+	}
+
+	from, err := sync.DefaultSyncer.LoadRev(previous)
+	if err != nil {
+		logger.Warningf("sync daemon: loading revision %s: %s", previous, err)
+		return last
+	}
+	to, err := sync.DefaultSyncer.LoadRev(last)
+	if err != nil {
+		logger.Warningf("sync daemon: loading revision %s: %s", last, err)
+		return previous
+	}
+	events, err := sync.DiffRevisions(from, to, awsservices.ResourceTypes, sync.TimelineProperties...)
+	if err != nil {
+		logger.Warningf("sync daemon: %s", err)
+	}
+	for _, e := range events {
+		if err := emit(e); err != nil {
+			logger.Warningf("sync daemon: emitting '%s': %s", e, err)
+		}
+	}
+	logger.Verbosef("sync daemon: %d changes since revision %s", len(events), previous[:7])
+	return last
+}
+
+func jsonLinesEmitter(w io.Writer) func(*sync.ChangeEvent) error {
+	enc := json.NewEncoder(w)
+	return func(e *sync.ChangeEvent) error {
+		return enc.Encode(e)
+	}
+}
+
+func webhookEmitter(url string) func(*sync.ChangeEvent) error {
+	client := &http.Client{Timeout: 10 * time.Second}
+	return func(e *sync.ChangeEvent) error {
+		body, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			return fmt.Errorf("webhook responded %s", resp.Status)
+		}
+		return nil
+	}
+}
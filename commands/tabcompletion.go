@@ -200,6 +200,8 @@ func guessEntityTypeFromHoleQuestion(hole string) (resolved []string, prop strin
 		return
 	}
 
+	tokens = mergeCompoundTypeTokens(tokens)
+
 	var types []string
 	for _, t := range tokens {
 		for _, r := range resourcesTypesWithPlural {
@@ -236,6 +238,29 @@ func guessEntityTypeFromHoleQuestion(hole string) (resolved []string, prop strin
 	return
 }
 
+// Join consecutive tokens naming a resource type (ex: route.table is a routetable, not a table)
+func mergeCompoundTypeTokens(tokens []string) []string {
+	var merged []string
+	for i := 0; i < len(tokens); i++ {
+		if i+1 < len(tokens) && isResourceTypeWithPlural(tokens[i]+tokens[i+1]) {
+			merged = append(merged, tokens[i]+tokens[i+1])
+			i++
+			continue
+		}
+		merged = append(merged, tokens[i])
+	}
+	return merged
+}
+
+func isResourceTypeWithPlural(s string) bool {
+	for _, r := range resourcesTypesWithPlural {
+		if s == r {
+			return true
+		}
+	}
+	return false
+}
+
 func keyCorrespondsToProperty(holekey, prop string) bool {
 	holekey = strings.ToLower(holekey)
 	prop = strings.ToLower(prop)
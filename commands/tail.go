@@ -19,10 +19,13 @@ package commands
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/wallix/awless/aws/tailers"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/rdf"
 )
 
 var tailFollowFrequencyFlag time.Duration
@@ -31,6 +34,10 @@ var tailNumberEventsFlag int
 var stackEventsFilters []string
 var stackEventsTailTimeout time.Duration
 var cancelStackUpdateAfterTimeout bool
+var logsStreamPrefixFlag string
+var logsFilterPatternFlag string
+var logsSinceFlag time.Duration
+var cloudtrailSinceFlag time.Duration
 
 func init() {
 	RootCmd.AddCommand(tailCmd)
@@ -54,6 +61,16 @@ func init() {
 	stackEventsCmd.PersistentFlags().DurationVar(&stackEventsTailTimeout, "timeout", time.Duration(1*time.Hour), "Time to wait for stack update to complete, use with 'follow' flag")
 
 	tailCmd.AddCommand(stackEventsCmd)
+
+	logsCmd.Flags().StringVar(&logsStreamPrefixFlag, "stream", "", "Only display events of log streams starting with this prefix")
+	logsCmd.Flags().StringVar(&logsFilterPatternFlag, "filter", "", "CloudWatch Logs filter pattern events must match")
+	logsCmd.Flags().DurationVar(&logsSinceFlag, "since", 1*time.Hour, "Look for the last events within this duration")
+
+	tailCmd.AddCommand(logsCmd)
+
+	cloudtrailCmd.Flags().DurationVar(&cloudtrailSinceFlag, "since", 1*time.Hour, "Look for the last events within this duration")
+
+	tailCmd.AddCommand(cloudtrailCmd)
 }
 
 var tailCmd = &cobra.Command{
@@ -85,3 +102,68 @@ var stackEventsCmd = &cobra.Command{
 		exitOn(awstailers.NewCloudformationEventsTailer(args[0], tailNumberEventsFlag, tailEnableFollowFlag, tailFollowFrequencyFlag, stackEventsFilters, stackEventsTailTimeout, cancelStackUpdateAfterTimeout).Tail(os.Stdout))
 	},
 }
+
+var logsCmd = &cobra.Command{
+	Use:   "logs LOGGROUP-NAME|@REFERENCE",
+	Short: "Watch events of a log group, given by name or through a resource logging into it (ex: @myfunction)",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 {
+			exitOn(fmt.Errorf("expecting log group name or resource reference"))
+		}
+
+		exitOn(awstailers.NewLogEventsTailer(resolveLogGroupName(args[0]), logsStreamPrefixFlag, logsFilterPatternFlag, logsSinceFlag, tailNumberEventsFlag, tailEnableFollowFlag, tailFollowFrequencyFlag).Tail(os.Stdout))
+	},
+}
+
+var cloudtrailCmd = &cobra.Command{
+	Use:   "cloudtrail [REFERENCE]",
+	Short: "Watch CloudTrail events of your cloud or of a given resource, marking those made by your awless templates",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var ref string
+		if len(args) > 0 {
+			ref = args[0]
+		}
+
+		exitOn(tailCloudTrailEvents(ref, cloudtrailSinceFlag, tailNumberEventsFlag, tailEnableFollowFlag, tailFollowFrequencyFlag))
+	},
+}
+
+// resolveLogGroupName returns the log group referenced directly or through the
+// resources (functions, container tasks) logging into it in the local graph
+func resolveLogGroupName(ref string) string {
+	g, resources, _ := resolveResourceFromRefInCurrentRegion(ref)
+
+	groups := make(map[string]bool)
+	for _, res := range resources {
+		if res.Type() == cloud.LogGroup {
+			groups[res.Id()] = true
+			continue
+		}
+		related, err := g.ResourceRelations(res, rdf.ApplyOn, false)
+		exitOn(err)
+		for _, r := range related {
+			if r.Type() == cloud.LogGroup {
+				groups[r.Id()] = true
+			}
+		}
+	}
+
+	var names []string
+	for name := range groups {
+		names = append(names, name)
+	}
+	switch len(names) {
+	case 0:
+		if strings.HasPrefix(ref, "@") {
+			exitOn(fmt.Errorf("no log group found for '%s' in local graph", deprefix(ref)))
+		}
+		return ref
+	case 1:
+		return names[0]
+	default:
+		exitOn(fmt.Errorf("%d log groups found for '%s' (%s): tail one of them by name", len(names), deprefix(ref), strings.Join(names, ", ")))
+	}
+	return ""
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/wallix/awless/config"
+	"github.com/wallix/awless/database"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/logger"
+	"github.com/wallix/awless/sync"
+	"github.com/wallix/awless/sync/repo"
+	"github.com/wallix/awless/template"
+)
+
+var (
+	timelineAllPropertiesFlag bool
+)
+
+func init() {
+	RootCmd.AddCommand(timelineCmd)
+
+	timelineCmd.Flags().BoolVar(&timelineAllPropertiesFlag, "all-properties", false, fmt.Sprintf("Track changes of all properties (default: %s)", strings.Join(sync.TimelineProperties, ", ")))
+}
+
+var timelineCmd = &cobra.Command{
+	Use:   "timeline REFERENCE",
+	Short: "Show when a resource appeared, changed and disappeared using your locally synced history",
+	Example: `  awless timeline i-8d43b21b
+  awless timeline @redis-prod --all-properties`,
+	PersistentPreRun:  applyHooks(initLoggerHook, initAwlessEnvHook, initSyncerHook, firstInstallDoneHook),
+	PersistentPostRun: applyHooks(verifyNewVersionHook, onVersionUpgrade),
+
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("REFERENCE required. See examples.")
+		}
+		ref := args[0]
+		profile, region := config.GetAWSProfile(), config.GetAWSRegion()
+
+		all, err := sync.DefaultSyncer.List()
+		exitOn(err)
+		if len(all) == 0 {
+			exitOn(errors.New("no synced revisions found"))
+		}
+
+		loadFn := func(r *repo.Rev) (*graph.Graph, error) {
+			loaded, err := sync.DefaultSyncer.LoadRev(r.Id)
+			if err != nil {
+				return nil, err
+			}
+			return loaded.Graph(profile, region), nil
+		}
+
+		var props []string
+		if !timelineAllPropertiesFlag {
+			props = sync.TimelineProperties
+		}
+
+		logger.Verbosef("walking %d revisions for resource %s", len(all), deprefix(ref))
+		id, events, err := sync.BuildTimeline(all, loadFn, timelineResourceIdResolver(ref), props...)
+		exitOn(err)
+		if id == "" {
+			exitOn(fmt.Errorf("resource '%s' not found in synced history of region '%s' for profile '%s'", deprefix(ref), region, profile))
+		}
+
+		var templates []*template.TemplateExecution
+		exitOn(database.Execute(func(db *database.DB) error {
+			loaded, err := db.ListTemplates()
+			for _, l := range loaded {
+				if l.Err == nil {
+					templates = append(templates, l.TplExec)
+				}
+			}
+			return err
+		}))
+
+		for _, event := range events {
+			printTimelineEvent(event, templates, id)
+		}
+
+		return nil
+	},
+}
+
+// Resolve in current local graph first, then in history (from the latest revision) for deleted resources
+func timelineResourceIdResolver(ref string) func(*graph.Graph) string {
+	var localId string
+	if _, resources, _ := resolveResourceFromRefInCurrentRegion(ref); len(resources) == 1 {
+		localId = resources[0].Id()
+	}
+	return func(g *graph.Graph) string {
+		if localId != "" {
+			return localId
+		}
+		if _, resources, _ := resolveResourceFromRef(g, ref); len(resources) == 1 {
+			return resources[0].Id()
+		}
+		return ""
+	}
+}
+
+func printTimelineEvent(event *sync.TimelineEvent, templates []*template.TemplateExecution, id string) {
+	kind := event.Kind
+	switch {
+	case event.From == nil:
+		kind = "first synced"
+	case event.Kind == sync.Appeared:
+		kind = renderGreenFn(kind)
+	case event.Kind == sync.Disappeared:
+		kind = renderRedFn(kind)
+	default:
+		kind = renderYellowFn(kind)
+	}
+
+	fmt.Printf("%s  %s %s (revision %s)\n", event.To.DateString(), printResourceRef(event.Resource), kind, event.To.Id[:7])
+	for _, change := range event.Changes {
+		fmt.Printf("\t%s\n", change)
+	}
+
+	if event.From == nil {
+		return
+	}
+	for _, tpl := range templates {
+		if date := tpl.Date(); date.After(event.From.Date) && !date.After(event.To.Date) {
+			var actions []string
+			var mention string
+			for _, cmd := range tpl.CommandNodesIterator() {
+				actions = append(actions, fmt.Sprintf("%s %s", cmd.Action, cmd.Entity))
+				if strings.Contains(cmd.String(), id) || fmt.Sprint(cmd.CmdResult) == id {
+					mention = " referencing resource"
+				}
+			}
+			fmt.Printf("\t↳ template %s run on %s%s: %s\n", renderYellowFn(tpl.ID), date.Format("Mon Jan 2 15:04:05"), mention, strings.Join(actions, ", "))
+		}
+	}
+}
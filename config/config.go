@@ -26,6 +26,11 @@ const (
 	autosyncConfigKey              = "autosync"
 	checkUpgradeFrequencyConfigKey = "upgrade.checkfrequency"
 	schedulerURL                   = "scheduler.url"
+	syncRemoteConfigKey            = "sync.remote"
+	syncGCKeepDailyConfigKey       = "sync.gc.keepdaily"
+	syncGCKeepWeeklyConfigKey      = "sync.gc.keepweekly"
+	syncGCFrequencyConfigKey       = "sync.gc.frequency"
+	syncStaleAfterConfigKey        = "sync.staleafter"
 	RegionConfigKey                = "aws.region"
 	ProfileConfigKey               = "aws.profile"
 
@@ -34,23 +39,35 @@ const (
 )
 
 var configDefinitions = map[string]*Definition{
-	autosyncConfigKey:              {help: "Automatically synchronize your cloud locally", defaultValue: "true", parseParamFn: parseBool},
-	RegionConfigKey:                {help: "AWS region", parseParamFn: awsconfig.ParseRegion, stdinParamProviderFn: awsconfig.StdinRegionSelector, onUpdateFns: []onUpdateFunc{runSyncWithUpdatedRegion}},
-	ProfileConfigKey:               {help: "AWS profile", defaultValue: "default"},
-	"aws.infra.sync":               {help: "Enable/disable sync of infra services (EC2, RDS, etc.) (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.access.sync":              {help: "Enable/disable sync of IAM service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.storage.sync":             {help: "Enable/disable sync of S3 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.storage.s3object.sync":    {help: "Enable/disable sync of S3/s3object (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
-	"aws.dns.sync":                 {help: "Enable/disable sync of DNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.dns.record.sync":          {help: "Enable/disable sync of DNS/record (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
-	"aws.notification.sync":        {help: "Enable/disable sync of SNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.monitoring.sync":          {help: "Enable/disable sync of CloudWatch service (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
-	"aws.lambda.sync":              {help: "Enable/disable sync of Lambda service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.messaging.sync":           {help: "Enable/disable sync of SQS/SNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.cdn.sync":                 {help: "Enable/disable sync of CloudFront service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	"aws.cloudformation.sync":      {help: "Enable/disable sync of CloudFormation service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
-	checkUpgradeFrequencyConfigKey: {help: "Upgrade check frequency (hours); a negative value disables check", defaultValue: "8", parseParamFn: parseInt},
-	schedulerURL:                   {help: "URL used by awless CLI to interact with pre-installed https://github.com/wallix/awless-scheduler", defaultValue: "http://localhost:8082"},
+	autosyncConfigKey:                {help: "Automatically synchronize your cloud locally", defaultValue: "true", parseParamFn: parseBool},
+	RegionConfigKey:                  {help: "AWS region", parseParamFn: awsconfig.ParseRegion, stdinParamProviderFn: awsconfig.StdinRegionSelector, onUpdateFns: []onUpdateFunc{runSyncWithUpdatedRegion}},
+	ProfileConfigKey:                 {help: "AWS profile", defaultValue: "default"},
+	"aws.infra.sync":                 {help: "Enable/disable sync of infra services (EC2, RDS, etc.) (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.access.sync":                {help: "Enable/disable sync of IAM service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.storage.sync":               {help: "Enable/disable sync of S3 service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.storage.s3object.sync":      {help: "Enable/disable sync of S3/s3object (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
+	"aws.dns.sync":                   {help: "Enable/disable sync of DNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.dns.record.sync":            {help: "Enable/disable sync of DNS/record (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
+	"aws.dns.healthcheck.sync":       {help: "Enable/disable sync of DNS/healthcheck (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.notification.sync":          {help: "Enable/disable sync of SNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.monitoring.sync":            {help: "Enable/disable sync of CloudWatch service (when empty: true)", defaultValue: "false", parseParamFn: parseBool},
+	"aws.monitoring.datapoints.sync": {help: "Enable/disable sync of CloudWatch datapoints (avg/max over 1h/24h/7d) as properties of instances, databases and loadbalancers (when empty: false)", defaultValue: "false", parseParamFn: parseBool},
+	"aws.lambda.sync":                {help: "Enable/disable sync of Lambda service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.messaging.sync":             {help: "Enable/disable sync of SQS/SNS service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.logs.sync":                  {help: "Enable/disable sync of CloudWatch Logs service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.cdn.sync":                   {help: "Enable/disable sync of CloudFront service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.cloudformation.sync":        {help: "Enable/disable sync of CloudFormation service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.dynamodb.sync":              {help: "Enable/disable sync of DynamoDB service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.security.sync":              {help: "Enable/disable sync of KMS and SSM parameters service (when empty: true)", defaultValue: "true", parseParamFn: parseBool},
+	"aws.fetch.concurrency":          {help: "Maximum number of resource types fetched at the same time per service when syncing", defaultValue: "8", parseParamFn: parseInt},
+	"aws.fetch.ratelimit":            {help: "Maximum number of API requests per second per service when syncing; 0 disables the limit", defaultValue: "20", parseParamFn: parseInt},
+	checkUpgradeFrequencyConfigKey:   {help: "Upgrade check frequency (hours); a negative value disables check", defaultValue: "8", parseParamFn: parseInt},
+	syncRemoteConfigKey:              {help: "Shared sync store for `awless sync push|pull`: a git remote URL, fs:///path or s3://bucket[/prefix][?endpoint=URL]"},
+	syncGCKeepDailyConfigKey:         {help: "Number of daily revisions kept when compacting the sync history (awless sync gc)", defaultValue: "30", parseParamFn: parseInt},
+	syncGCKeepWeeklyConfigKey:        {help: "Number of weekly revisions kept when compacting the sync history (awless sync gc)", defaultValue: "12", parseParamFn: parseInt},
+	syncGCFrequencyConfigKey:         {help: "Autosync compaction frequency of the sync history (days); a negative value disables it", defaultValue: "-1", parseParamFn: parseInt},
+	syncStaleAfterConfigKey:          {help: "Age (hours) after which locally synced resources are reported as stale", defaultValue: "24", parseParamFn: parseInt},
+	schedulerURL:                     {help: "URL used by awless CLI to interact with pre-installed https://github.com/wallix/awless-scheduler", defaultValue: "http://localhost:8082"},
 }
 
 var defaultsDefinitions = map[string]*Definition{
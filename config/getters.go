@@ -45,6 +45,50 @@ func GetSchedulerURL() string {
 	return ""
 }
 
+func GetSyncRemote() string {
+	if u, ok := Config[syncRemoteConfigKey].(string); ok {
+		return u
+	}
+	return ""
+}
+
+// GetSyncGCRetention returns the number of daily and weekly revisions kept when compacting the sync history
+func GetSyncGCRetention() (int, int) {
+	daily, weekly := 30, 12
+	if d, ok := Config[syncGCKeepDailyConfigKey].(int); ok {
+		daily = d
+	}
+	if w, ok := Config[syncGCKeepWeeklyConfigKey].(int); ok {
+		weekly = w
+	}
+	return daily, weekly
+}
+
+func GetSyncGCFrequency() time.Duration {
+	if frequency, ok := Config[syncGCFrequencyConfigKey].(int); ok {
+		return time.Duration(frequency) * 24 * time.Hour
+	}
+	return -1
+}
+
+func GetSyncStaleAfter() time.Duration {
+	if hours, ok := Config[syncStaleAfterConfigKey].(int); ok {
+		return time.Duration(hours) * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// SyncDisabledBy returns the config key disabling the sync of the resource type of the AWS service
+// (ex: aws.storage.sync or aws.storage.s3object.sync), or an empty string when its sync is enabled
+func SyncDisabledBy(service, resourceType string) string {
+	for _, key := range []string{fmt.Sprintf("aws.%s.sync", service), fmt.Sprintf("aws.%s.%s.sync", service, resourceType)} {
+		if enabled, ok := Config[key].(bool); ok && !enabled {
+			return key
+		}
+	}
+	return ""
+}
+
 func GetConfigWithPrefix(prefix string) map[string]interface{} {
 	conf := make(map[string]interface{})
 	for k, v := range Config {
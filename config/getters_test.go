@@ -45,4 +45,21 @@ func TestGetSyncEnabled(t *testing.T) {
 			t.Fatalf("got %+v, want %+v", got, want)
 		}
 	})
+
+	t.Run("Sync disabled by", func(t *testing.T) {
+		Set("aws.s3.sync", "false")
+		for _, tcase := range []struct {
+			service, resourceType, expect string
+		}{
+			{"ec2", "subnet", ""},
+			{"ec2", "instance", "aws.ec2.instance.sync"},
+			{"iam", "role", ""},
+			{"s3", "bucket", "aws.s3.sync"},
+			{"other", "any", ""},
+		} {
+			if got, want := SyncDisabledBy(tcase.service, tcase.resourceType), tcase.expect; got != want {
+				t.Fatalf("%s.%s: got %q, want %q", tcase.service, tcase.resourceType, got, want)
+			}
+		}
+	})
 }
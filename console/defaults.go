@@ -17,7 +17,11 @@ limitations under the License.
 package console
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/fatih/color"
+	"github.com/wallix/awless/aws/pricing"
 	"github.com/wallix/awless/cloud"
 	"github.com/wallix/awless/cloud/properties"
 )
@@ -38,12 +42,16 @@ var ColumnsInListing = map[string][]string{
 	cloud.ElasticIP:           {properties.ID, properties.PublicIP, properties.PrivateIP, properties.Association},
 	cloud.Snapshot:            {properties.ID, properties.Volume, properties.Encrypted, properties.Owner, properties.State, properties.Progress, properties.Created, properties.Size},
 	cloud.NetworkInterface:    {properties.ID, properties.Vpc, properties.Subnet, properties.State, properties.Instance, properties.PrivateIP, properties.PublicIP, properties.Description},
+	cloud.VpcPeering:          {properties.ID, properties.Name, properties.State, properties.Vpc, properties.CIDR, properties.PeerVpc, properties.PeerCIDR, properties.PeerOwner, properties.PeerRegion},
+	cloud.VpcEndpoint:         {properties.ID, properties.Vpc, properties.Type, properties.Service, properties.State, properties.Created},
 	cloud.LoadBalancer:        {properties.Name, properties.Vpc, properties.State, properties.PublicDNS, properties.Created, properties.Scheme},
 	cloud.ClassicLoadBalancer: {properties.Name, properties.Vpc, properties.PublicDNS, properties.Instances, properties.Ports, properties.Created, properties.Scheme},
 	cloud.TargetGroup:         {properties.Name, properties.Vpc, properties.CheckHTTPCode, properties.Port, properties.Protocol, properties.CheckInterval, properties.CheckPath, properties.CheckPort, properties.CheckProtocol},
 	cloud.Listener:            {properties.ID, properties.Protocol, properties.Port, properties.LoadBalancer, properties.TargetGroups, properties.AlarmActions},
 	cloud.Database:            {properties.ID, properties.Name, properties.AvailabilityZone, properties.Class, properties.State, properties.Storage, properties.Port, properties.Username, properties.Public, properties.ReplicaOf, properties.Engine, properties.EngineVersion, properties.Created},
 	cloud.DbSubnetGroup:       {properties.ID, properties.State, properties.Vpc, properties.Subnets, properties.Description},
+	cloud.CacheCluster:        {properties.ID, properties.AvailabilityZone, properties.Class, properties.State, properties.Engine, properties.EngineVersion, properties.NodeCount, properties.ReplicationGroup, properties.Vpc, properties.Created},
+	cloud.ReplicationGroup:    {properties.ID, properties.Description, properties.State, properties.Class, properties.Members, properties.Failover},
 	cloud.LaunchConfiguration: {properties.Name, properties.Type, properties.Created, properties.KeyPair},
 	cloud.ScalingGroup:        {properties.Name, properties.LaunchConfigurationName, properties.DesiredCapacity, properties.State, properties.Created, properties.NewInstancesProtected},
 	cloud.ScalingPolicy:       {properties.Name, properties.Type, properties.ScalingGroupName, properties.AlarmNames, properties.AdjustmentType, properties.ScalingAdjustment},
@@ -62,16 +70,27 @@ var ColumnsInListing = map[string][]string{
 	cloud.MFADevice:           {properties.ID, properties.AttachedAt},
 	cloud.Bucket:              {properties.ID, properties.Grants, properties.Created},
 	cloud.S3Object:            {properties.ID, properties.Bucket, properties.Modified, properties.Owner, properties.Size, properties.Class},
+	cloud.FileSystem:          {properties.ID, properties.Name, properties.State, properties.PerformanceMode, properties.Size, properties.MountTargetCount, properties.Encrypted, properties.Created},
+	cloud.MountTarget:         {properties.ID, properties.FileSystem, properties.Subnet, properties.PrivateIP, properties.State, properties.SecurityGroups},
 	cloud.Subscription:        {properties.Arn, properties.Topic, properties.Endpoint, properties.Protocol, properties.Owner},
 	cloud.Topic:               {properties.ID},
 	cloud.Queue:               {properties.ID, properties.ApproximateMessageCount, properties.Created, properties.Modified, properties.Delay},
 	cloud.Zone:                {properties.ID, properties.Name, properties.Comment, properties.Private, properties.RecordCount, properties.CallerReference},
-	cloud.Record:              {properties.ID, properties.Type, properties.Name, properties.Records, properties.Zone, properties.Alias, properties.TTL},
+	cloud.Record:              {properties.ID, properties.Type, properties.Name, properties.Records, properties.Zone, properties.Alias, properties.TTL, properties.Set, properties.Weight},
+	cloud.HealthCheck:         {properties.ID, properties.Name, properties.Type, properties.PublicIP, properties.Host, properties.Port, properties.CheckPath},
 	cloud.Function:            {properties.Name, properties.Size, properties.Memory, properties.Runtime, properties.Version, properties.Modified, properties.Description},
+	cloud.RestApi:             {properties.ID, properties.Name, properties.Description, properties.Version, properties.Created},
+	cloud.Stage:               {properties.Name, properties.RestApi, properties.Description, properties.Created, properties.Modified},
+	cloud.StateMachine:        {properties.Name, properties.State, properties.Role, properties.Created},
 	cloud.Metric:              {properties.ID, properties.Name, properties.Namespace, properties.Dimensions},
 	cloud.Alarm:               {properties.Name, properties.Namespace, properties.MetricName, properties.Description, properties.State, properties.Updated, properties.Dimensions},
+	cloud.LogGroup:            {properties.Name, properties.RetentionDays, properties.Size, properties.Created},
 	cloud.Distribution:        {properties.ID, properties.PublicDNS, properties.Enabled, properties.State, properties.Modified, properties.Aliases, properties.SSLSupportMethod, properties.Origins},
 	cloud.Stack:               {properties.ID, properties.Name, properties.State, properties.Created, properties.Modified},
+	cloud.Table:               {properties.Name, properties.State, properties.HashKey, properties.RangeKey, properties.BillingMode, properties.ItemCount, properties.Size, properties.Created},
+	cloud.GlobalTable:         {properties.Name, properties.State, properties.Regions, properties.Created},
+	cloud.Key:                 {properties.ID, properties.Aliases, properties.Description, properties.State, properties.KeyManager, properties.RotationEnabled, properties.Created},
+	cloud.Parameter:           {properties.Name, properties.Type, properties.Description, properties.Version, properties.Modified},
 }
 
 var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
@@ -182,6 +201,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
 		StringColumnDefinition{Prop: properties.Instances},
+		StringColumnDefinition{Prop: properties.EncryptionKey},
 	},
 	cloud.AvailabilityZone: {
 		StringColumnDefinition{Prop: properties.Name},
@@ -215,6 +235,29 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.PublicIP},
 		StringColumnDefinition{Prop: properties.Description},
 	},
+	cloud.VpcPeering: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"active": color.FgGreen, "pending-acceptance": color.FgYellow, "failed": color.FgRed, "rejected": color.FgRed}},
+		StringColumnDefinition{Prop: properties.Vpc},
+		StringColumnDefinition{Prop: properties.CIDR},
+		StringColumnDefinition{Prop: properties.PeerVpc, Friendly: "Peer Vpc"},
+		StringColumnDefinition{Prop: properties.PeerCIDR, Friendly: "Peer CIDR"},
+		StringColumnDefinition{Prop: properties.PeerOwner, Friendly: "Peer Owner"},
+		StringColumnDefinition{Prop: properties.PeerRegion, Friendly: "Peer Region"},
+	},
+	cloud.VpcEndpoint: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Vpc},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.Service},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen, "pending": color.FgYellow, "failed": color.FgRed}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
+	},
 	// Loadbalancer
 	cloud.LoadBalancer: {
 		StringColumnDefinition{Prop: properties.Name},
@@ -272,6 +315,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Engine},
 		StringColumnDefinition{Prop: properties.EngineVersion, Friendly: "Version"},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created, Friendly: "Created"}},
+		StringColumnDefinition{Prop: properties.EncryptionKey},
 	},
 	cloud.DbSubnetGroup: {
 		StringColumnDefinition{Prop: properties.ID},
@@ -280,6 +324,38 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Subnets},
 		StringColumnDefinition{Prop: properties.Description},
 	},
+	// Cache
+	cloud.CacheCluster: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.AvailabilityZone, Friendly: "Zone"},
+		StringColumnDefinition{Prop: properties.Class},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen}},
+		StringColumnDefinition{Prop: properties.Engine},
+		StringColumnDefinition{Prop: properties.EngineVersion, Friendly: "Version"},
+		StringColumnDefinition{Prop: properties.NodeCount, Friendly: "Nodes"},
+		StringColumnDefinition{Prop: properties.ReplicationGroup},
+		StringColumnDefinition{Prop: properties.Vpc},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+		StringColumnDefinition{Prop: properties.PublicDNS},
+		StringColumnDefinition{Prop: properties.Port},
+		StringColumnDefinition{Prop: properties.CacheSubnetGroup},
+		StringColumnDefinition{Prop: properties.Subnets},
+		StringColumnDefinition{Prop: properties.SecurityGroups},
+	},
+	cloud.ReplicationGroup: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Description},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen}},
+		StringColumnDefinition{Prop: properties.Class},
+		StringColumnDefinition{Prop: properties.Members},
+		StringColumnDefinition{Prop: properties.Failover},
+		StringColumnDefinition{Prop: properties.PublicDNS},
+		StringColumnDefinition{Prop: properties.Port},
+	},
 	//Autoscaling
 	cloud.LaunchConfiguration: {
 		StringColumnDefinition{Prop: properties.Name},
@@ -400,6 +476,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.ID},
 		GrantsColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Grants}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+		StringColumnDefinition{Prop: properties.EncryptionKey},
 	},
 	cloud.S3Object: {
 		StringColumnDefinition{Prop: properties.ID, Friendly: "Name"},
@@ -409,6 +486,31 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StorageColumnDefinition{Unit: b, StringColumnDefinition: StringColumnDefinition{Prop: properties.Size}},
 		StringColumnDefinition{Prop: properties.Class},
 	},
+	// EFS
+	cloud.FileSystem: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen}},
+		StringColumnDefinition{Prop: properties.PerformanceMode, Friendly: "Performance"},
+		StorageColumnDefinition{Unit: b, StringColumnDefinition: StringColumnDefinition{Prop: properties.Size}},
+		StringColumnDefinition{Prop: properties.MountTargetCount, Friendly: "MountTargets"},
+		StringColumnDefinition{Prop: properties.Encrypted},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+		StringColumnDefinition{Prop: properties.EncryptionKey},
+		StringColumnDefinition{Prop: properties.Owner},
+	},
+	cloud.MountTarget: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.FileSystem},
+		StringColumnDefinition{Prop: properties.Subnet},
+		StringColumnDefinition{Prop: properties.PrivateIP},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"available": color.FgGreen}},
+		StringColumnDefinition{Prop: properties.SecurityGroups},
+	},
 	//Notification
 	cloud.Subscription: {
 		StringColumnDefinition{Prop: properties.Arn},
@@ -427,6 +529,7 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified, Friendly: "LastModif"}},
 		StringColumnDefinition{Prop: properties.Delay, Friendly: "Delay(s)"},
+		StringColumnDefinition{Prop: properties.EncryptionKey},
 	},
 	// DNS
 	cloud.Zone: {
@@ -445,6 +548,22 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		StringColumnDefinition{Prop: properties.Zone},
 		StringColumnDefinition{Prop: properties.Alias},
 		StringColumnDefinition{Prop: properties.TTL},
+		StringColumnDefinition{Prop: properties.Set},
+		StringColumnDefinition{Prop: properties.Weight},
+		StringColumnDefinition{Prop: properties.Region},
+		StringColumnDefinition{Prop: properties.Failover},
+		StringColumnDefinition{Prop: properties.HealthCheck},
+	},
+	cloud.HealthCheck: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Type},
+		StringColumnDefinition{Prop: properties.PublicIP},
+		StringColumnDefinition{Prop: properties.Host},
+		StringColumnDefinition{Prop: properties.Port},
+		StringColumnDefinition{Prop: properties.CheckPath},
+		StringColumnDefinition{Prop: properties.CheckInterval, Friendly: "Interval"},
+		StringColumnDefinition{Prop: properties.UnhealthyThresholdCount, Friendly: "Threshold"},
 	},
 	// Lamba
 	cloud.Function: {
@@ -456,6 +575,31 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
 		StringColumnDefinition{Prop: properties.Description},
 	},
+	cloud.RestApi: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.Description},
+		StringColumnDefinition{Prop: properties.Version},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+		StringColumnDefinition{Prop: properties.Functions},
+	},
+	cloud.Stage: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.RestApi},
+		StringColumnDefinition{Prop: properties.Description},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
+	},
+	cloud.StateMachine: {
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"ACTIVE": color.FgGreen}},
+		StringColumnDefinition{Prop: properties.Role},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+		StringColumnDefinition{Prop: properties.Arn},
+		StringColumnDefinition{Prop: properties.Functions},
+	},
 	//Monitoring
 	cloud.Metric: {
 		StringColumnDefinition{Prop: properties.ID},
@@ -472,6 +616,14 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Updated}},
 		KeyValuesColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Dimensions}},
 	},
+	//Logs
+	cloud.LogGroup: {
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.RetentionDays, Friendly: "Retention(days)"},
+		StorageColumnDefinition{Unit: b, StringColumnDefinition: StringColumnDefinition{Prop: properties.Size}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+		StringColumnDefinition{Prop: properties.EncryptionKey},
+	},
 	//CDN
 	cloud.Distribution: {
 		StringColumnDefinition{Prop: properties.ID},
@@ -491,4 +643,104 @@ var DefaultsColumnDefinitions = map[string][]ColumnDefinition{
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
 		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
 	},
+	//DynamoDB
+	cloud.Table: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"ACTIVE": color.FgGreen, "DELETING": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.HashKey},
+		StringColumnDefinition{Prop: properties.RangeKey},
+		StringColumnDefinition{Prop: properties.BillingMode},
+		StringColumnDefinition{Prop: properties.ReadCapacity},
+		StringColumnDefinition{Prop: properties.WriteCapacity},
+		StringColumnDefinition{Prop: properties.ItemCount},
+		StorageColumnDefinition{Unit: b, StringColumnDefinition: StringColumnDefinition{Prop: properties.Size}},
+		StringColumnDefinition{Prop: properties.StreamArn},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.GlobalTable: {
+		StringColumnDefinition{Prop: properties.ID},
+		StringColumnDefinition{Prop: properties.Name},
+		StringColumnDefinition{Prop: properties.State},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Regions}},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	//Security
+	cloud.Key: {
+		StringColumnDefinition{Prop: properties.ID},
+		SliceColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Aliases}},
+		StringColumnDefinition{Prop: properties.Description},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.State},
+			ColoredValues:          map[string]color.Attribute{"Enabled": color.FgGreen, "PendingDeletion": color.FgRed},
+		},
+		StringColumnDefinition{Prop: properties.KeyManager, Friendly: "Manager"},
+		StringColumnDefinition{Prop: properties.KeyUsage, Friendly: "Usage"},
+		StringColumnDefinition{Prop: properties.RotationEnabled, Friendly: "Rotation"},
+		StringColumnDefinition{Prop: properties.Arn},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Created}},
+	},
+	cloud.Parameter: {
+		StringColumnDefinition{Prop: properties.Name},
+		ColoredValueColumnDefinition{
+			StringColumnDefinition: StringColumnDefinition{Prop: properties.Type},
+			ColoredValues:          map[string]color.Attribute{"SecureString": color.FgYellow},
+		},
+		StringColumnDefinition{Prop: properties.Description},
+		StringColumnDefinition{Prop: properties.EncryptionKey},
+		StringColumnDefinition{Prop: properties.Version},
+		TimeColumnDefinition{StringColumnDefinition: StringColumnDefinition{Prop: properties.Modified}},
+	},
+}
+
+// DatapointsColumnDefinitions are the columns of the CloudWatch datapoints synced as properties (see aws.monitoring.datapoints.sync).
+// They are not listed by default but can be selected or sorted on (ex: --sort cpu-avg-24h).
+var DatapointsColumnDefinitions = map[string][]ColumnDefinition{
+	cloud.Instance: append(append(
+		datapointsColumnDefinitions("CPU", "cpu", percentDatapoints),
+		datapointsColumnDefinitions("NetworkIn", "netin", bytesDatapoints)...),
+		datapointsColumnDefinitions("NetworkOut", "netout", bytesDatapoints)...),
+	cloud.Database: append(
+		datapointsColumnDefinitions("CPU", "cpu", percentDatapoints),
+		datapointsColumnDefinitions("FreeStorage", "freestorage", bytesDatapoints)...),
+	cloud.LoadBalancer: datapointsColumnDefinitions("Requests", "requests", countDatapoints),
+}
+
+// datapointsColumnDefinitions returns the columns of the avg/max aggregates of a metric (ex: CPUAvg24h displayed as cpu-avg-24h)
+func datapointsColumnDefinitions(prefix, friendly string, unit datapointsUnit) (columns []ColumnDefinition) {
+	for _, window := range []string{"1h", "24h", "7d"} {
+		for _, stat := range []string{"Avg", "Max"} {
+			columns = append(columns, DatapointsColumnDefinition{
+				StringColumnDefinition: StringColumnDefinition{Prop: prefix + stat + window, Friendly: fmt.Sprintf("%s-%s-%s", friendly, strings.ToLower(stat), window)},
+				Unit:                   unit,
+			})
+		}
+	}
+	return
+}
+
+var costColumnDefinition = CostColumnDefinition{StringColumnDefinition{Prop: properties.Cost}}
+
+// CostColumnDefinitions are the columns of the estimated monthly cost of priced types (see aws/pricing), computed when selected or sorted on (ex: --columns cost).
+var CostColumnDefinitions = costColumnDefinitions()
+
+func costColumnDefinitions() map[string][]ColumnDefinition {
+	defs := make(map[string][]ColumnDefinition)
+	for _, typ := range pricing.PricedTypes {
+		defs[typ] = []ColumnDefinition{costColumnDefinition}
+	}
+	return defs
+}
+
+// ColumnDefinitionsOf returns all the known columns of a resource type, the default ones first
+func ColumnDefinitionsOf(resourceType string) []ColumnDefinition {
+	return append(append([]ColumnDefinition{}, DefaultsColumnDefinitions[resourceType]...), optionalColumnDefinitions(resourceType)...)
+}
+
+// optionalColumnDefinitions returns the columns of a resource type not listed by default
+func optionalColumnDefinitions(resourceType string) []ColumnDefinition {
+	return append(append([]ColumnDefinition{}, DatapointsColumnDefinitions[resourceType]...), CostColumnDefinitions[resourceType]...)
 }
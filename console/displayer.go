@@ -237,7 +237,7 @@ func WithColumns(properties []string) optsFn {
 		var columns []ColumnDefinition
 		for _, p := range properties {
 			var found bool
-			for _, definition := range DefaultsColumnDefinitions[b.rdfType] {
+			for _, definition := range ColumnDefinitionsOf(b.rdfType) {
 				if strings.ToLower(p) == strings.ToLower(definition.propKey()) || strings.ToLower(p) == strings.ToLower(definition.title()) {
 					found = true
 					columns = append(columns, definition)
@@ -304,6 +304,7 @@ func WithIDsOnly(only bool) optsFn {
 
 func WithSortBy(sortingBy ...string) optsFn {
 	return func(b *Builder) *Builder {
+		b.columnDefinitions = appendSortingColumns(b.columnDefinitions, b.rdfType, sortingBy...)
 		indexes, err := resolveSortIndexes(b.columnDefinitions, sortingBy...)
 		if err != nil {
 			fmt.Fprint(os.Stderr, err, "\n")
@@ -978,6 +979,22 @@ func valueLowerOrEqual(a, b interface{}) bool {
 	}
 }
 
+// appendSortingColumns adds the known columns sorted on but not displayed (ex: datapoints columns)
+func appendSortingColumns(columns []ColumnDefinition, rdfType string, sortingBy ...string) []ColumnDefinition {
+	for _, name := range sortingBy {
+		if ColumnDefinitions(columns).resolveKey(name) != "" {
+			continue
+		}
+		for _, definition := range optionalColumnDefinitions(rdfType) {
+			if key := (ColumnDefinitions{definition}).resolveKey(name); key != "" {
+				columns = append(columns, definition)
+				break
+			}
+		}
+	}
+	return columns
+}
+
 func resolveSortIndexes(headers []ColumnDefinition, sortingBy ...string) ([]int, error) {
 	sortBy := []string{"id"}
 	if len(sortingBy) > 0 {
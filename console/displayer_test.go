@@ -80,6 +80,61 @@ func TestSorting(t *testing.T) {
 			t.Fatalf("got \n%q\n\nwant\n\n%q\n", got, want)
 		}
 	})
+
+	t.Run("datapoints column not displayed", func(t *testing.T) {
+		w.Reset()
+		g := graph.NewGraph()
+		g.AddResource(
+			resourcetest.Instance("inst_1").Prop(p.Name, "redis").Prop(p.CPUAvg24h, 12.5).Build(),
+			resourcetest.Instance("inst_2").Prop(p.Name, "django").Prop(p.CPUAvg24h, 80.25).Build(),
+			resourcetest.Instance("inst_3").Prop(p.Name, "apache").Build(),
+		)
+		displayer, _ := BuildOptions(
+			WithRdfType("instance"),
+			WithColumns([]string{"ID", "Name"}),
+			WithFormat("csv"),
+			WithSortBy("cpu-avg-24h"),
+			WithReverseSort(true),
+		).SetSource(g).Build()
+
+		expected := "ID,Name,cpu-avg-24h\n" +
+			"inst_2,django,80.2%\n" +
+			"inst_1,redis,12.5%\n" +
+			"inst_3,apache,\n"
+
+		if err := displayer.Print(&w); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := w.String(), expected; got != want {
+			t.Fatalf("got \n%q\n\nwant\n\n%q\n", got, want)
+		}
+	})
+
+	t.Run("cost column", func(t *testing.T) {
+		w.Reset()
+		g := graph.NewGraph()
+		g.AddResource(
+			resourcetest.Instance("inst_1").Prop(p.Name, "redis").Prop(p.Cost, 9.198).Build(),
+			resourcetest.Instance("inst_2").Prop(p.Name, "django").Prop(p.Cost, 73.0).Build(),
+		)
+		displayer, _ := BuildOptions(
+			WithRdfType("instance"),
+			WithColumns([]string{"ID", "Name", "cost"}),
+			WithFormat("csv"),
+			WithSortBy("cost"),
+		).SetSource(g).Build()
+
+		expected := "ID,Name,Cost\n" +
+			"inst_1,redis,$9.20\n" +
+			"inst_2,django,$73.00\n"
+
+		if err := displayer.Print(&w); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := w.String(), expected; got != want {
+			t.Fatalf("got \n%q\n\nwant\n\n%q\n", got, want)
+		}
+	})
 }
 
 func TestJSONDisplays(t *testing.T) {
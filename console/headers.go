@@ -203,6 +203,52 @@ func (h StorageColumnDefinition) format(i interface{}) string {
 	return "invalid size"
 }
 
+type datapointsUnit int
+
+const (
+	percentDatapoints datapointsUnit = iota
+	bytesDatapoints
+	countDatapoints
+)
+
+type DatapointsColumnDefinition struct {
+	StringColumnDefinition
+	Unit datapointsUnit
+}
+
+func (h DatapointsColumnDefinition) format(i interface{}) string {
+	if i == nil {
+		return ""
+	}
+	f, ok := i.(float64)
+	if !ok {
+		return "invalid datapoint"
+	}
+	switch h.Unit {
+	case percentDatapoints:
+		return fmt.Sprintf("%.1f%%", f)
+	case bytesDatapoints:
+		return HumanizeStorage(uint64(f), b)
+	default:
+		return fmt.Sprintf("%.1f", f)
+	}
+}
+
+type CostColumnDefinition struct {
+	StringColumnDefinition
+}
+
+func (h CostColumnDefinition) format(i interface{}) string {
+	if i == nil {
+		return ""
+	}
+	f, ok := i.(float64)
+	if !ok {
+		return "invalid cost"
+	}
+	return fmt.Sprintf("$%.2f", f)
+}
+
 type FirewallRulesColumnDefinition struct {
 	StringColumnDefinition
 }
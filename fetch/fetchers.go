@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/wallix/awless/graph"
 )
@@ -25,6 +27,21 @@ type FetchResult struct {
 	Err          error
 	Resources    []*graph.Resource
 	Objects      interface{}
+	// Streamed is the number of resources of the type sent to the context stream (see WithStream)
+	Streamed int
+}
+
+func (r FetchResult) freshness() *graph.Freshness {
+	f := &graph.Freshness{ResourceType: r.ResourceType, FetchedAt: time.Now().UTC(), Count: r.Streamed}
+	for _, res := range r.Resources {
+		if res.Type() == r.ResourceType {
+			f.Count++
+		}
+	}
+	if r.Err != nil {
+		f.Err = r.Err.Error()
+	}
+	return f
 }
 
 type Func func(context.Context, Cache) ([]*graph.Resource, interface{}, error)
@@ -35,6 +52,7 @@ type fetcher struct {
 	*cache
 	fetchFuncs    map[string]Func
 	resourceTypes []string
+	limits        Limits
 }
 
 func NewFetcher(funcs Funcs) *fetcher {
@@ -49,13 +67,28 @@ func NewFetcher(funcs Funcs) *fetcher {
 	return ftr
 }
 
+// WithLimits bounds the concurrency of fetches and retries them on retryable errors
+func (f *fetcher) WithLimits(l Limits) *fetcher {
+	f.limits = l
+	return f
+}
+
 func (f *fetcher) Fetch(ctx context.Context) (*graph.Graph, error) {
 	results := make(chan FetchResult, len(f.resourceTypes))
 	var wg sync.WaitGroup
 
+	var sem chan struct{}
+	if f.limits.MaxConcurrent > 0 {
+		sem = make(chan struct{}, f.limits.MaxConcurrent)
+	}
+
 	for _, resType := range f.resourceTypes {
 		wg.Add(1)
 		go func(t string, co context.Context) {
+			if sem != nil {
+				sem <- struct{}{}
+				defer func() { <-sem }()
+			}
 			f.fetchResource(co, t, results)
 			wg.Done()
 		}(resType, ctx)
@@ -74,6 +107,7 @@ func (f *fetcher) Fetch(ctx context.Context) (*graph.Graph, error) {
 			ferr.Add(err)
 		}
 		gph.AddResource(res.Resources...)
+		gph.SetFreshness(res.freshness())
 	}
 
 	if ferr.Any() {
@@ -102,6 +136,7 @@ func (f *fetcher) FetchByType(ctx context.Context, resourceType string) (*graph.
 	gph := graph.NewGraph()
 	select {
 	case res := <-results:
+		gph.SetFreshness(res.freshness())
 		if err := res.Err; err != nil {
 			return gph, err
 		}
@@ -117,9 +152,10 @@ func (f *fetcher) fetchResource(ctx context.Context, resourceType string, result
 	var objects interface{}
 	resources := make([]*graph.Resource, 0)
 
+	var streamed int64
 	fn, ok := f.fetchFuncs[resourceType]
 	if ok {
-		resources, objects, err = fn(ctx, f.cache)
+		resources, objects, err = f.callWithRetries(countingStream(ctx, resourceType, &streamed), resourceType, fn)
 	} else {
 		err = fmt.Errorf("no fetch func defined for resource type '%s'", resourceType)
 	}
@@ -131,6 +167,24 @@ func (f *fetcher) fetchResource(ctx context.Context, resourceType string, result
 		Err:          err,
 		Resources:    resources,
 		Objects:      objects,
+		Streamed:     int(atomic.LoadInt64(&streamed)),
+	}
+}
+
+func (f *fetcher) callWithRetries(ctx context.Context, resourceType string, fn Func) ([]*graph.Resource, interface{}, error) {
+	for attempt := 0; ; attempt++ {
+		resources, objects, err := fn(ctx, f.cache)
+		if err == nil || attempt >= f.limits.MaxRetries || f.limits.IsRetryable == nil || !f.limits.IsRetryable(err) {
+			return resources, objects, err
+		}
+		if f.limits.OnRetry != nil {
+			f.limits.OnRetry(resourceType, attempt+1, err)
+		}
+		select {
+		case <-ctx.Done():
+			return resources, objects, err
+		case <-time.After(f.limits.backoff(attempt)):
+		}
 	}
 }
 
@@ -163,6 +217,13 @@ func (c *cache) Get(key string, funcs ...func() (interface{}, error)) (interface
 	if len(funcs) > 0 {
 		cache.once.Do(func() {
 			cache.result, cache.err = funcs[0]()
+			if cache.err != nil { // errors are not cached, so that a retried fetch calls the func again
+				c.mu.Lock()
+				if c.cached[key] == cache {
+					delete(c.cached, key)
+				}
+				c.mu.Unlock()
+			}
 		})
 	}
 
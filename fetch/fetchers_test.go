@@ -3,7 +3,9 @@ package fetch_test
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/wallix/awless/fetch"
 	"github.com/wallix/awless/graph"
@@ -50,6 +52,16 @@ func TestFetcher(t *testing.T) {
 		if res, _ := gph.GetResource("subnet", "sub_2"); res == nil {
 			t.Fatalf("got unexpected resource: %v", res)
 		}
+		freshness, err := gph.Freshness()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(freshness), 2; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if freshness["instance"].Failed() || freshness["instance"].FetchedAt.IsZero() {
+			t.Fatalf("unexpected freshness %#v", freshness["instance"])
+		}
 	})
 
 	t.Run("fetch by type", func(t *testing.T) {
@@ -114,3 +126,189 @@ func TestFetcher(t *testing.T) {
 		}
 	})
 }
+
+func TestFetcherWithLimits(t *testing.T) {
+	var mu sync.Mutex
+	var running, maxRunning int
+	attempts := make(map[string]int)
+	throttled := errors.New("throttled")
+
+	funcs := make(map[string]fetch.Func)
+	for _, typ := range []string{"instance", "subnet", "vpc", "volume", "keypair"} {
+		resType := typ
+		funcs[resType] = func(context.Context, fetch.Cache) ([]*graph.Resource, interface{}, error) {
+			mu.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			attempts[resType]++
+			attempt := attempts[resType]
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+			if resType == "instance" && attempt < 3 {
+				return nil, nil, throttled
+			}
+			return []*graph.Resource{graph.InitResource(resType, resType+"_1")}, nil, nil
+		}
+	}
+
+	var retries int
+	limits := fetch.Limits{
+		MaxConcurrent: 2,
+		MaxRetries:    3,
+		BaseBackoff:   time.Millisecond,
+		IsRetryable:   func(err error) bool { return err == throttled },
+		OnRetry:       func(string, int, error) { retries++ },
+	}
+	gph, err := fetch.NewFetcher(funcs).WithLimits(limits).Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, _ := gph.GetResource("instance", "instance_1"); res == nil {
+		t.Fatal("expected instance fetched after retries")
+	}
+	if got, want := maxRunning, 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := retries, 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	limits.MaxRetries = 1
+	attempts = make(map[string]int)
+	if _, err = fetch.NewFetcher(funcs).WithLimits(limits).Fetch(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestFetcherRetriesThroughCache(t *testing.T) {
+	var calls int
+	throttled := errors.New("throttled")
+	getSubnets := func(cache fetch.Cache) (interface{}, error) {
+		return cache.Get("getSubnets", func() (interface{}, error) {
+			calls++
+			if calls < 2 {
+				return nil, throttled
+			}
+			return []string{"sub_1", "sub_2"}, nil
+		})
+	}
+
+	funcs := map[string]fetch.Func{
+		"subnet": func(ctx context.Context, cache fetch.Cache) ([]*graph.Resource, interface{}, error) {
+			ids, err := getSubnets(cache)
+			if err != nil {
+				return nil, nil, err
+			}
+			var resources []*graph.Resource
+			for _, id := range ids.([]string) {
+				resources = append(resources, graph.InitResource("subnet", id))
+			}
+			return resources, nil, nil
+		},
+	}
+	limits := fetch.Limits{MaxRetries: 2, BaseBackoff: time.Millisecond, IsRetryable: func(err error) bool { return err == throttled }}
+
+	gph, err := fetch.NewFetcher(funcs).WithLimits(limits).Fetch(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := calls, 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if res, _ := gph.GetResource("subnet", "sub_2"); res == nil {
+		t.Fatal("expected subnet fetched after retry")
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := fetch.NewTokenBucket(100, 2)
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		if err := bucket.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Fatalf("expected rate limiting, took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	empty := fetch.NewTokenBucket(0.01, 1)
+	empty.Wait(ctx)
+	if err := empty.Wait(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestFetcherWithStream(t *testing.T) {
+	funcs := map[string]fetch.Func{
+		"instance": func(ctx context.Context, _ fetch.Cache) ([]*graph.Resource, interface{}, error) {
+			var resources []*graph.Resource
+			for _, id := range []string{"inst_1", "inst_2", "inst_3"} {
+				res := graph.InitResource("instance", id)
+				streamed, err := fetch.Stream(ctx, res)
+				if err != nil {
+					return nil, nil, err
+				}
+				if !streamed {
+					resources = append(resources, res)
+				}
+			}
+			return resources, nil, nil
+		},
+		"subnet": func(context.Context, fetch.Cache) ([]*graph.Resource, interface{}, error) {
+			return []*graph.Resource{graph.InitResource("subnet", "sub_1")}, nil, nil
+		},
+	}
+
+	var mu sync.Mutex
+	var streamed []*graph.Resource
+	ctx := fetch.WithStream(context.Background(), func(resources ...*graph.Resource) error {
+		mu.Lock()
+		defer mu.Unlock()
+		streamed = append(streamed, resources...)
+		return nil
+	})
+
+	gph, err := fetch.NewFetcher(funcs).Fetch(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(streamed), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if all, _ := gph.GetAllResources("instance"); len(all) != 0 {
+		t.Fatalf("expected no instance in graph, got %d", len(all))
+	}
+	if all, _ := gph.GetAllResources("subnet"); len(all) != 1 {
+		t.Fatalf("expected 1 subnet in graph, got %d", len(all))
+	}
+	freshness, err := gph.Freshness()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := freshness["instance"].Count, 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := freshness["subnet"].Count, 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	t.Run("without stream", func(t *testing.T) {
+		gph, err := fetch.NewFetcher(funcs).Fetch(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if all, _ := gph.GetAllResources("instance"); len(all) != 3 {
+			t.Fatalf("expected 3 instances in graph, got %d", len(all))
+		}
+	})
+}
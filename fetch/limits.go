@@ -0,0 +1,98 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fetch
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Limits bounds the load a fetcher puts on its cloud APIs
+type Limits struct {
+	// MaxConcurrent is the maximum number of resource types fetched at the same time (unbounded when <= 0)
+	MaxConcurrent int
+	// MaxRetries is the maximum number of retries of a resource type fetch failing with a retryable error
+	MaxRetries int
+	// BaseBackoff is the wait before the first retry, doubled (with jitter) on each following retry
+	BaseBackoff time.Duration
+	// IsRetryable tells if a fetch error is worth a retry (ex: throttling). No retry when nil
+	IsRetryable func(error) bool
+	// OnRetry is called before each retry, if not nil
+	OnRetry func(resourceType string, attempt int, err error)
+}
+
+func (l Limits) backoff(attempt int) time.Duration {
+	d := l.BaseBackoff << uint(attempt)
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// TokenBucket limits a rate of events (ex: API requests) while allowing bursts
+type TokenBucket struct {
+	mu       sync.Mutex
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+// NewTokenBucket returns a full bucket refilled at the given rate per second,
+// holding at most burst tokens
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{rate: rate, capacity: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// Wait blocks until a token is available or the context is done
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		wait := b.take()
+		if wait <= 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// take consumes a token and returns 0, or returns how long to wait for the next token
+func (b *TokenBucket) take() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
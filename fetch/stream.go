@@ -0,0 +1,69 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fetch
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/wallix/awless/graph"
+)
+
+const streamKey = "streamsink"
+
+// StreamFunc receives resources as they are fetched, for them not to be held in memory
+type StreamFunc func(...*graph.Resource) error
+
+// WithStream returns a context in which fetch funcs supporting it send their resources
+// to the sink instead of returning them. Streamed resources are not in the fetched graphs.
+func WithStream(ctx context.Context, sink StreamFunc) context.Context {
+	return context.WithValue(ctx, streamKey, sink)
+}
+
+// IsStreaming reports whether the context has a sink, i.e. whether fetch funcs have to stream their resources
+func IsStreaming(ctx context.Context) bool {
+	sink, ok := ctx.Value(streamKey).(StreamFunc)
+	return ok && sink != nil
+}
+
+// Stream sends the resources to the context sink, if any. It returns false when
+// there is no sink, in which case the caller has to return the resources itself.
+func Stream(ctx context.Context, resources ...*graph.Resource) (bool, error) {
+	sink, ok := ctx.Value(streamKey).(StreamFunc)
+	if !ok || sink == nil {
+		return false, nil
+	}
+	return true, sink(resources...)
+}
+
+// countingStream wraps the context sink (if any) to count the resources of the given type streamed by a fetch func
+func countingStream(ctx context.Context, resourceType string, count *int64) context.Context {
+	sink, ok := ctx.Value(streamKey).(StreamFunc)
+	if !ok || sink == nil {
+		return ctx
+	}
+	return WithStream(ctx, func(resources ...*graph.Resource) error {
+		var n int64
+		for _, res := range resources {
+			if res.Type() == resourceType {
+				n++
+			}
+		}
+		atomic.AddInt64(count, n)
+		return sink(resources...)
+	})
+}
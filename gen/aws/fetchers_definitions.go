@@ -24,16 +24,26 @@ import (
 
 func ApiToInterface(api string) string {
 	switch api {
+	case "apigateway":
+		return "APIGatewayAPI"
 	case "autoscaling":
 		return "AutoScalingAPI"
 	case "cloudwatch":
 		return "CloudWatchAPI"
+	case "cloudwatchlogs":
+		return "CloudWatchLogsAPI"
+	case "cloudtrail":
+		return "CloudTrailAPI"
 	case "cloudfront":
 		return "CloudFrontAPI"
 	case "applicationautoscaling":
 		return "ApplicationAutoScalingAPI"
 	case "cloudformation":
 		return "CloudFormationAPI"
+	case "dynamodb":
+		return "DynamoDBAPI"
+	case "elasticache":
+		return "ElastiCacheAPI"
 	case "route53", "lambda":
 		return strings.Title(api) + "API"
 	default:
@@ -42,9 +52,11 @@ func ApiToInterface(api string) string {
 }
 
 type fetchersDef struct {
-	Name     string
-	Global   bool
-	Api      []string
+	Name   string
+	Global bool
+	Api    []string
+	// FetchApi are APIs of other services only used when fetching (ex: datapoints of resources)
+	FetchApi []string
 	Fetchers []fetcher
 }
 
@@ -61,8 +73,9 @@ type fetcher struct {
 
 var FetchersDefs = []fetchersDef{
 	{
-		Name: "infra",
-		Api:  []string{"ec2", "elbv2", "elb", "rds", "autoscaling", "ecr", "ecs", "applicationautoscaling", "acm"},
+		Name:     "infra",
+		Api:      []string{"ec2", "elbv2", "elb", "rds", "elasticache", "autoscaling", "ecr", "ecs", "applicationautoscaling", "acm"},
+		FetchApi: []string{"cloudwatch"},
 		Fetchers: []fetcher{
 			{Api: "ec2", ResourceType: cloud.Instance, AWSType: "ec2.Instance", ApiMethod: "DescribeInstancesPages", Input: "ec2.DescribeInstancesInput{}", Output: "ec2.DescribeInstancesOutput", OutputsExtractor: "Instances", OutputsContainers: "Reservations", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ec2", ResourceType: cloud.Subnet, AWSType: "ec2.Subnet", ApiMethod: "DescribeSubnets", Input: "ec2.DescribeSubnetsInput{}", Output: "ec2.DescribeSubnetsOutput", OutputsExtractor: "Subnets"},
@@ -79,12 +92,16 @@ var FetchersDefs = []fetchersDef{
 			{Api: "ec2", ResourceType: cloud.ElasticIP, AWSType: "ec2.Address", ApiMethod: "DescribeAddresses", Input: "ec2.DescribeAddressesInput{}", Output: "ec2.DescribeAddressesOutput", OutputsExtractor: "Addresses"},
 			{Api: "ec2", ResourceType: cloud.Snapshot, AWSType: "ec2.Snapshot", ApiMethod: "DescribeSnapshotsPages", Input: "ec2.DescribeSnapshotsInput{OwnerIds:[]*string{awssdk.String(\"self\")}}", Output: "ec2.DescribeSnapshotsOutput", OutputsExtractor: "Snapshots", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "ec2", ResourceType: cloud.NetworkInterface, AWSType: "ec2.NetworkInterface", ApiMethod: "DescribeNetworkInterfaces", Input: "ec2.DescribeNetworkInterfacesInput{}", Output: "ec2.DescribeNetworkInterfacesOutput", OutputsExtractor: "NetworkInterfaces"},
+			{Api: "ec2", ResourceType: cloud.VpcPeering, AWSType: "ec2.VpcPeeringConnection", ApiMethod: "DescribeVpcPeeringConnections", Input: "ec2.DescribeVpcPeeringConnectionsInput{}", Output: "ec2.DescribeVpcPeeringConnectionsOutput", OutputsExtractor: "VpcPeeringConnections"},
+			{Api: "ec2", ResourceType: cloud.VpcEndpoint, AWSType: "ec2.VpcEndpoint", ApiMethod: "DescribeVpcEndpoints", Input: "ec2.DescribeVpcEndpointsInput{}", Output: "ec2.DescribeVpcEndpointsOutput", OutputsExtractor: "VpcEndpoints"},
 			{Api: "elb", ResourceType: cloud.ClassicLoadBalancer, AWSType: "elb.LoadBalancerDescription", ApiMethod: "DescribeLoadBalancersPages", Input: "elb.DescribeLoadBalancersInput{}", Output: "elb.DescribeLoadBalancersOutput", OutputsExtractor: "LoadBalancerDescriptions", Multipage: true, NextPageMarker: "NextMarker"},
 			{Api: "elbv2", ResourceType: cloud.LoadBalancer, AWSType: "elbv2.LoadBalancer", ApiMethod: "DescribeLoadBalancersPages", Input: "elbv2.DescribeLoadBalancersInput{}", Output: "elbv2.DescribeLoadBalancersOutput", OutputsExtractor: "LoadBalancers", Multipage: true, NextPageMarker: "NextMarker"},
 			{Api: "elbv2", ResourceType: cloud.TargetGroup, AWSType: "elbv2.TargetGroup", ApiMethod: "DescribeTargetGroups", Input: "elbv2.DescribeTargetGroupsInput{}", Output: "elbv2.DescribeTargetGroupsOutput", OutputsExtractor: "TargetGroups"},
 			{Api: "elbv2", ResourceType: cloud.Listener, AWSType: "elbv2.Listener", ManualFetcher: true},
 			{Api: "rds", ResourceType: cloud.Database, AWSType: "rds.DBInstance", ApiMethod: "DescribeDBInstancesPages", Input: "rds.DescribeDBInstancesInput{}", Output: "rds.DescribeDBInstancesOutput", OutputsExtractor: "DBInstances", Multipage: true, NextPageMarker: "Marker"},
 			{Api: "rds", ResourceType: cloud.DbSubnetGroup, AWSType: "rds.DBSubnetGroup", ApiMethod: "DescribeDBSubnetGroupsPages", Input: "rds.DescribeDBSubnetGroupsInput{}", Output: "rds.DescribeDBSubnetGroupsOutput", OutputsExtractor: "DBSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
+			{Api: "elasticache", ResourceType: cloud.CacheCluster, AWSType: "elasticache.CacheCluster", ManualFetcher: true},
+			{Api: "elasticache", ResourceType: cloud.ReplicationGroup, AWSType: "elasticache.ReplicationGroup", ApiMethod: "DescribeReplicationGroupsPages", Input: "elasticache.DescribeReplicationGroupsInput{}", Output: "elasticache.DescribeReplicationGroupsOutput", OutputsExtractor: "ReplicationGroups", Multipage: true, NextPageMarker: "Marker"},
 			{Api: "autoscaling", ResourceType: cloud.LaunchConfiguration, AWSType: "autoscaling.LaunchConfiguration", ApiMethod: "DescribeLaunchConfigurationsPages", Input: "autoscaling.DescribeLaunchConfigurationsInput{}", Output: "autoscaling.DescribeLaunchConfigurationsOutput", OutputsExtractor: "LaunchConfigurations", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "autoscaling", ResourceType: cloud.ScalingGroup, AWSType: "autoscaling.Group", ApiMethod: "DescribeAutoScalingGroupsPages", Input: "autoscaling.DescribeAutoScalingGroupsInput{}", Output: "autoscaling.DescribeAutoScalingGroupsOutput", OutputsExtractor: "AutoScalingGroups", Multipage: true, NextPageMarker: "NextToken"},
 			{Api: "autoscaling", ResourceType: cloud.ScalingPolicy, AWSType: "autoscaling.ScalingPolicy", ApiMethod: "DescribePoliciesPages", Input: "autoscaling.DescribePoliciesInput{}", Output: "autoscaling.DescribePoliciesOutput", OutputsExtractor: "ScalingPolicies", Multipage: true, NextPageMarker: "NextToken"},
@@ -112,10 +129,12 @@ var FetchersDefs = []fetchersDef{
 	},
 	{
 		Name: "storage",
-		Api:  []string{"s3"},
+		Api:  []string{"s3", "efs"},
 		Fetchers: []fetcher{
 			{Api: "s3", ResourceType: cloud.Bucket, AWSType: "s3.Bucket", ManualFetcher: true},
 			{Api: "s3", ResourceType: cloud.S3Object, AWSType: "s3.Object", ManualFetcher: true},
+			{Api: "efs", ResourceType: cloud.FileSystem, AWSType: "efs.FileSystemDescription", ManualFetcher: true},
+			{Api: "efs", ResourceType: cloud.MountTarget, AWSType: "efs.MountTargetDescription", ManualFetcher: true},
 		},
 	},
 	{
@@ -134,14 +153,18 @@ var FetchersDefs = []fetchersDef{
 		Fetchers: []fetcher{
 			{Api: "route53", ResourceType: cloud.Zone, AWSType: "route53.HostedZone", ApiMethod: "ListHostedZonesPages", Input: "route53.ListHostedZonesInput{}", Output: "route53.ListHostedZonesOutput", OutputsExtractor: "HostedZones", Multipage: true, NextPageMarker: "NextMarker"},
 			{Api: "route53", ResourceType: cloud.Record, AWSType: "route53.ResourceRecordSet", ManualFetcher: true},
+			{Api: "route53", ResourceType: cloud.HealthCheck, AWSType: "route53.HealthCheck", ManualFetcher: true},
 		},
 	},
 
 	{
 		Name: "lambda",
-		Api:  []string{"lambda"},
+		Api:  []string{"lambda", "apigateway", "sfn"},
 		Fetchers: []fetcher{
 			{Api: "lambda", ResourceType: cloud.Function, AWSType: "lambda.FunctionConfiguration", ApiMethod: "ListFunctionsPages", Input: "lambda.ListFunctionsInput{}", Output: "lambda.ListFunctionsOutput", OutputsExtractor: "Functions", Multipage: true, NextPageMarker: "NextMarker"},
+			{Api: "apigateway", ResourceType: cloud.RestApi, AWSType: "apigateway.RestApi", ManualFetcher: true},
+			{Api: "apigateway", ResourceType: cloud.Stage, AWSType: "apigateway.Stage", ManualFetcher: true},
+			{Api: "sfn", ResourceType: cloud.StateMachine, AWSType: "sfn.DescribeStateMachineOutput", ManualFetcher: true},
 		},
 	},
 	{
@@ -152,6 +175,13 @@ var FetchersDefs = []fetchersDef{
 			{Api: "cloudwatch", ResourceType: cloud.Alarm, AWSType: "cloudwatch.MetricAlarm", ApiMethod: "DescribeAlarmsPages", Input: "cloudwatch.DescribeAlarmsInput{}", Output: "cloudwatch.DescribeAlarmsOutput", OutputsExtractor: "MetricAlarms", Multipage: true, NextPageMarker: "NextToken"},
 		},
 	},
+	{
+		Name: "logs",
+		Api:  []string{"cloudwatchlogs", "cloudtrail"},
+		Fetchers: []fetcher{
+			{Api: "cloudwatchlogs", ResourceType: cloud.LogGroup, AWSType: "cloudwatchlogs.LogGroup", ApiMethod: "DescribeLogGroupsPages", Input: "cloudwatchlogs.DescribeLogGroupsInput{}", Output: "cloudwatchlogs.DescribeLogGroupsOutput", OutputsExtractor: "LogGroups", Multipage: true, NextPageMarker: "NextToken"},
+		},
+	},
 	{
 		Name:   "cdn",
 		Global: true,
@@ -167,4 +197,20 @@ var FetchersDefs = []fetchersDef{
 			{Api: "cloudformation", ResourceType: cloud.Stack, AWSType: "cloudformation.Stack", ApiMethod: "DescribeStacksPages", Input: "cloudformation.DescribeStacksInput{}", Output: "cloudformation.DescribeStacksOutput", OutputsExtractor: "Stacks", Multipage: true, NextPageMarker: "NextToken"},
 		},
 	},
+	{
+		Name: "dynamodb",
+		Api:  []string{"dynamodb"},
+		Fetchers: []fetcher{
+			{Api: "dynamodb", ResourceType: cloud.Table, AWSType: "dynamodb.TableDescription", ManualFetcher: true},
+			{Api: "dynamodb", ResourceType: cloud.GlobalTable, AWSType: "dynamodb.GlobalTableDescription", ManualFetcher: true},
+		},
+	},
+	{
+		Name: "security",
+		Api:  []string{"kms", "ssm"},
+		Fetchers: []fetcher{
+			{Api: "kms", ResourceType: cloud.Key, AWSType: "kms.KeyMetadata", ManualFetcher: true},
+			{Api: "ssm", ResourceType: cloud.Parameter, AWSType: "ssm.ParameterMetadata", ApiMethod: "DescribeParametersPages", Input: "ssm.DescribeParametersInput{}", Output: "ssm.DescribeParametersOutput", OutputsExtractor: "Parameters", Multipage: true, NextPageMarker: "NextToken"},
+		},
+	},
 }
@@ -143,6 +143,7 @@ func Build{{ Title $service.Name }}FetchFuncs(conf *Config) fetch.Funcs {
 	}
 {{- end }}
 {{- end }}
+	addDatapointsFetchFuncs(conf, funcs)
 	return funcs
 }
 {{- end }}`
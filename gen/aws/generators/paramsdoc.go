@@ -140,10 +140,17 @@ func loadAllRefs() map[string]string {
 		filepath.Join("elasticloadbalancing", "2012-06-01", "docs-2.json"),
 		filepath.Join("sts", "2011-06-15", "docs-2.json"),
 		filepath.Join("cloudformation", "2010-05-15", "docs-2.json"),
+		filepath.Join("dynamodb", "2012-08-10", "docs-2.json"),
+		filepath.Join("elasticache", "2015-02-02", "docs-2.json"),
+		filepath.Join("elasticfilesystem", "2015-02-01", "docs-2.json"),
+		filepath.Join("kms", "2014-11-01", "docs-2.json"),
+		filepath.Join("ssm", "2014-11-06", "docs-2.json"),
 		filepath.Join("ecr", "2015-09-21", "docs-2.json"),
 		filepath.Join("ecs", "2014-11-13", "docs-2.json"),
 		filepath.Join("application-autoscaling", "2016-02-06", "docs-2.json"),
 		filepath.Join("acm", "2015-12-08", "docs-2.json"),
+		filepath.Join("apigateway", "2015-07-09", "docs-2.json"),
+		filepath.Join("states", "2016-11-23", "docs-2.json"),
 	}
 
 	apisPath := filepath.Join(ROOT_DIR, "vendor", "github.com", "aws", "aws-sdk-go", "models", "apis")
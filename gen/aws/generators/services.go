@@ -147,6 +147,9 @@ func New{{ Title $service.Name }}(sess *session.Session, profile string, extraCo
 		{{- range $, $api := $service.Api }}
 			{{$api }}API,
 		{{- end }}
+		{{- range $, $api := $service.FetchApi }}
+			{{$api }}.New(sess),
+		{{- end }}
 	)
 	fetchConfig.Extra = extraConf
 	fetchConfig.Log = log
@@ -155,7 +158,7 @@ func New{{ Title $service.Name }}(sess *session.Session, profile string, extraCo
 	{{- range $, $api := $service.Api }}
 		{{ApiToInterface $api }}: {{ $api }}API,
 	{{- end }}
-		fetcher: fetch.NewFetcher(awsfetch.Build{{ Title $service.Name }}FetchFuncs(fetchConfig)),
+		fetcher: fetch.NewFetcher(awsfetch.Build{{ Title $service.Name }}FetchFuncs(fetchConfig)).WithLimits(fetchLimits(extraConf)),
 		config: extraConf,
 		region: region,
 		profile: profile,
@@ -263,7 +266,11 @@ func (s *{{ Title $service.Name }}) Fetch(ctx context.Context) (cloud.GraphAPI,
 
 func (s *{{ Title $service.Name }}) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
 	defer s.fetcher.Reset()
-  return s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	gph, err := s.fetcher.FetchByType(context.WithValue(ctx, "region", s.region), t)
+	if err != nil {
+		return gph, err
+	}
+	return gph, addParentsRelationsByType(gph, s.fetcher, s.region, t)
 }
 
 func (s *{{ Title $service.Name }}) IsSyncDisabled() bool {
@@ -49,6 +49,8 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "ec2.Address", ApiMethod: "DescribeAddresses", Input: "ec2.DescribeAddressesInput", Output: "ec2.DescribeAddressesOutput", OutputsExtractor: "Addresses"},
 			{FuncType: "list", AWSType: "ec2.Snapshot", ApiMethod: "DescribeSnapshotsPages", Input: "ec2.DescribeSnapshotsInput", Output: "ec2.DescribeSnapshotsOutput", OutputsExtractor: "Snapshots", Multipage: true, NextPageMarker: "NextToken"},
 			{FuncType: "list", AWSType: "ec2.NetworkInterface", ApiMethod: "DescribeNetworkInterfaces", Input: "ec2.DescribeNetworkInterfacesInput", Output: "ec2.DescribeNetworkInterfacesOutput", OutputsExtractor: "NetworkInterfaces"},
+			{FuncType: "list", AWSType: "ec2.VpcPeeringConnection", ApiMethod: "DescribeVpcPeeringConnections", Input: "ec2.DescribeVpcPeeringConnectionsInput", Output: "ec2.DescribeVpcPeeringConnectionsOutput", OutputsExtractor: "VpcPeeringConnections"},
+			{FuncType: "list", AWSType: "ec2.VpcEndpoint", ApiMethod: "DescribeVpcEndpoints", Input: "ec2.DescribeVpcEndpointsInput", Output: "ec2.DescribeVpcEndpointsOutput", OutputsExtractor: "VpcEndpoints"},
 		},
 	},
 	{
@@ -73,6 +75,14 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "rds.DBSubnetGroup", ApiMethod: "DescribeDBSubnetGroupsPages", Input: "rds.DescribeDBSubnetGroupsInput", Output: "rds.DescribeDBSubnetGroupsOutput", OutputsExtractor: "DBSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
 		},
 	},
+	{
+		Api: "elasticache",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "elasticache.CacheCluster", ApiMethod: "DescribeCacheClustersPages", Input: "elasticache.DescribeCacheClustersInput", Output: "elasticache.DescribeCacheClustersOutput", OutputsExtractor: "CacheClusters", Multipage: true, NextPageMarker: "Marker"},
+			{FuncType: "list", AWSType: "elasticache.ReplicationGroup", ApiMethod: "DescribeReplicationGroupsPages", Input: "elasticache.DescribeReplicationGroupsInput", Output: "elasticache.DescribeReplicationGroupsOutput", OutputsExtractor: "ReplicationGroups", Multipage: true, NextPageMarker: "Marker"},
+			{FuncType: "list", AWSType: "elasticache.CacheSubnetGroup", ApiMethod: "DescribeCacheSubnetGroupsPages", Input: "elasticache.DescribeCacheSubnetGroupsInput", Output: "elasticache.DescribeCacheSubnetGroupsOutput", OutputsExtractor: "CacheSubnetGroups", Multipage: true, NextPageMarker: "Marker"},
+		},
+	},
 	{
 		Api: "autoscaling",
 		Funcs: []*mockFuncDef{
@@ -107,6 +117,15 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "s3.Bucket", Manual: true, MockFieldType: "mapslice"},
 			{FuncType: "list", AWSType: "s3.Object", Manual: true, MockFieldType: "mapslice"},
 			{FuncType: "list", AWSType: "s3.Grant", Manual: true, MockFieldType: "mapslice"},
+			{FuncType: "list", AWSType: "s3.ServerSideEncryptionRule", Manual: true, MockFieldType: "mapslice", MockField: "encryptionrules"},
+		},
+	},
+	{
+		Api: "efs",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "efs.FileSystemDescription", Manual: true},
+			{FuncType: "list", AWSType: "efs.MountTargetDescription", Manual: true},
+			{FuncType: "list", MockFieldType: "mapslice", MockField: "mounttargetsecuritygroups", AWSType: "string", Manual: true},
 		},
 	},
 	{
@@ -128,6 +147,8 @@ var mocksDefs = []*mockDef{
 		Funcs: []*mockFuncDef{
 			{FuncType: "list", AWSType: "route53.HostedZone", ApiMethod: "ListHostedZonesPages", Input: "route53.ListHostedZonesInput", Output: "route53.ListHostedZonesOutput", OutputsExtractor: "HostedZones", Multipage: true, NextPageMarker: "NextMarker"},
 			{FuncType: "list", AWSType: "route53.ResourceRecordSet", Manual: true, MockFieldType: "mapslice"},
+			{FuncType: "list", AWSType: "route53.HealthCheck", ApiMethod: "ListHealthChecksPages", Input: "route53.ListHealthChecksInput", Output: "route53.ListHealthChecksOutput", OutputsExtractor: "HealthChecks", Multipage: true, NextPageMarker: "NextMarker"},
+			{FuncType: "list", AWSType: "route53.ResourceTagSet", Manual: true},
 		},
 	},
 	{
@@ -136,6 +157,21 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "lambda.FunctionConfiguration", ApiMethod: "ListFunctionsPages", Input: "lambda.ListFunctionsInput", Output: "lambda.ListFunctionsOutput", OutputsExtractor: "Functions", Multipage: true, NextPageMarker: "NextMarker"},
 		},
 	},
+	{
+		Api: "apigateway",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "apigateway.RestApi", ApiMethod: "GetRestApisPages", Input: "apigateway.GetRestApisInput", Output: "apigateway.GetRestApisOutput", OutputsExtractor: "Items", Multipage: true, NextPageMarker: "Position"},
+			{FuncType: "list", AWSType: "apigateway.Resource", Manual: true, MockFieldType: "mapslice"},
+			{FuncType: "list", AWSType: "apigateway.Stage", Manual: true, MockFieldType: "mapslice"},
+		},
+	},
+	{
+		Api: "sfn",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "sfn.StateMachineListItem", ApiMethod: "ListStateMachinesPages", Input: "sfn.ListStateMachinesInput", Output: "sfn.ListStateMachinesOutput", OutputsExtractor: "StateMachines", Multipage: true, NextPageMarker: "NextToken"},
+			{FuncType: "list", MockFieldType: "map", MockField: "statemachines", AWSType: "*sfn.DescribeStateMachineOutput", Manual: true},
+		},
+	},
 	{
 		Api: "cloudwatch",
 		Funcs: []*mockFuncDef{
@@ -143,6 +179,12 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "cloudwatch.MetricAlarm", ApiMethod: "DescribeAlarmsPages", Input: "cloudwatch.DescribeAlarmsInput", Output: "cloudwatch.DescribeAlarmsOutput", OutputsExtractor: "MetricAlarms", Multipage: true, NextPageMarker: "NextToken"},
 		},
 	},
+	{
+		Api: "cloudwatchlogs",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "cloudwatchlogs.LogGroup", ApiMethod: "DescribeLogGroupsPages", Input: "cloudwatchlogs.DescribeLogGroupsInput", Output: "cloudwatchlogs.DescribeLogGroupsOutput", OutputsExtractor: "LogGroups", Multipage: true, NextPageMarker: "NextToken"},
+		},
+	},
 	{
 		Api: "cloudfront",
 		Funcs: []*mockFuncDef{
@@ -155,6 +197,29 @@ var mocksDefs = []*mockDef{
 			{FuncType: "list", AWSType: "cloudformation.Stack", ApiMethod: "DescribeStacksPages", Input: "cloudformation.DescribeStacksInput", Output: "cloudformation.DescribeStacksOutput", OutputsExtractor: "Stacks", Multipage: true, NextPageMarker: "NextToken"},
 		},
 	},
+	{
+		Api: "dynamodb",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "dynamodb.TableDescription", Manual: true},
+			{FuncType: "list", AWSType: "dynamodb.GlobalTableDescription", Manual: true},
+		},
+	},
+	{
+		Api: "kms",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "kms.KeyMetadata", Manual: true},
+			{FuncType: "list", AWSType: "kms.AliasListEntry", ApiMethod: "ListAliasesPages", Input: "kms.ListAliasesInput", Output: "kms.ListAliasesOutput", OutputsExtractor: "Aliases", Multipage: true, NextPageMarker: "NextMarker"},
+			{FuncType: "list", MockFieldType: "map", MockField: "rotations", AWSType: "bool", Manual: true},
+			{FuncType: "list", MockFieldType: "map", MockField: "policies", AWSType: "string", Manual: true},
+		},
+	},
+	{
+		Api: "ssm",
+		Funcs: []*mockFuncDef{
+			{FuncType: "list", AWSType: "ssm.ParameterMetadata", ApiMethod: "DescribeParametersPages", Input: "ssm.DescribeParametersInput", Output: "ssm.DescribeParametersOutput", OutputsExtractor: "Parameters", Multipage: true, NextPageMarker: "NextToken"},
+			{FuncType: "list", MockFieldType: "map", MockField: "values", AWSType: "*ssm.Parameter", Manual: true},
+		},
+	},
 	{
 		Api: "ecr",
 		Funcs: []*mockFuncDef{
@@ -17,6 +17,9 @@ limitations under the License.
 package graph
 
 import (
+	"reflect"
+	"sort"
+
 	"github.com/wallix/awless/cloud/rdf"
 	tstore "github.com/wallix/triplestore"
 )
@@ -184,3 +187,38 @@ func max(a, b uint32) uint32 {
 
 	return a
 }
+
+// DiffProperties returns the given properties (all properties when none given) whose values differ
+// between two versions of a resource. Lists are compared regardless of their order, as RDF does not keep it.
+func DiffProperties(from, to *Resource, keys ...string) (diffs []string) {
+	if len(keys) == 0 {
+		all := make(map[string]bool)
+		for k := range Subtract(from.Properties(), to.Properties()) {
+			all[k] = true
+		}
+		for k := range Subtract(to.Properties(), from.Properties()) {
+			all[k] = true
+		}
+		for k := range all {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+	}
+
+	for _, k := range keys {
+		if !reflect.DeepEqual(sortedIfList(from.Properties()[k]), sortedIfList(to.Properties()[k])) {
+			diffs = append(diffs, k)
+		}
+	}
+	return
+}
+
+func sortedIfList(i interface{}) interface{} {
+	if list, ok := i.([]string); ok {
+		sorted := make([]string, len(list))
+		copy(sorted, list)
+		sort.Strings(sorted)
+		return sorted
+	}
+	return i
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	tstore "github.com/wallix/triplestore"
+)
+
+const (
+	freshnessSubjectPrefix = "awless:freshness:"
+
+	FetchedAtPredicate     = "fetchedAt"
+	FetchErrorPredicate    = "fetchError"
+	ResourceCountPredicate = "resourceCount"
+)
+
+// Freshness tells when a resource type has been fetched in a graph, and whether it failed
+type Freshness struct {
+	ResourceType string
+	FetchedAt    time.Time
+	Err          string
+	// Count is the number of resources fetched (including streamed ones, not held in the graph).
+	// It only reports fetch stats and is not kept in synced files (see MarshalSyncedTo).
+	Count int
+}
+
+func (f *Freshness) Failed() bool {
+	return f.Err != ""
+}
+
+func (f *Freshness) IsStale(maxAge time.Duration) bool {
+	return time.Since(f.FetchedAt) > maxAge
+}
+
+// SetFreshness stores the freshness of resource types in the graph, replacing any previous one
+func (g *Graph) SetFreshness(all ...*Freshness) {
+	snap := g.store.Snapshot()
+	for _, f := range all {
+		subject := freshnessSubjectPrefix + f.ResourceType
+		g.store.Remove(snap.WithSubject(subject)...)
+		g.store.Add(tstore.SubjPred(subject, FetchedAtPredicate).DateTimeLiteral(f.FetchedAt))
+		g.store.Add(tstore.SubjPred(subject, ResourceCountPredicate).IntegerLiteral(f.Count))
+		if f.Failed() {
+			g.store.Add(tstore.SubjPred(subject, FetchErrorPredicate).StringLiteral(f.Err))
+		}
+	}
+}
+
+// Freshness returns the freshness of resource types stored in the graph.
+// For graphs merged from several fetches (ex: regions), the oldest fetch and any error are kept, and counts are summed.
+func (g *Graph) Freshness() (map[string]*Freshness, error) {
+	all := make(map[string]*Freshness)
+	snap := g.store.Snapshot()
+	for _, t := range snap.WithPredicate(FetchedAtPredicate) {
+		subject := t.Subject()
+		resType := strings.TrimPrefix(subject, freshnessSubjectPrefix)
+		if _, done := all[resType]; done || !strings.HasPrefix(subject, freshnessSubjectPrefix) {
+			continue
+		}
+		f := &Freshness{ResourceType: resType}
+		for _, fetchedT := range snap.WithSubjPred(subject, FetchedAtPredicate) {
+			fetchedAt, err := tstore.ParseDateTime(fetchedT.Object())
+			if err != nil {
+				return all, err
+			}
+			if f.FetchedAt.IsZero() || fetchedAt.Before(f.FetchedAt) {
+				f.FetchedAt = fetchedAt
+			}
+		}
+		for _, countT := range snap.WithSubjPred(subject, ResourceCountPredicate) {
+			count, err := tstore.ParseInteger(countT.Object())
+			if err != nil {
+				return all, err
+			}
+			f.Count += count
+		}
+		for _, errT := range snap.WithSubjPred(subject, FetchErrorPredicate) {
+			msg, err := tstore.ParseString(errT.Object())
+			if err != nil {
+				return all, err
+			}
+			f.Err = msg
+		}
+		all[resType] = f
+	}
+	return all, nil
+}
+
+// MarshalSyncedTo marshals the graph as MarshalTo does, leaving out the resource counts of its freshness
+// for them not to change every synced file
+func (g *Graph) MarshalSyncedTo(w io.Writer) error {
+	var triples []tstore.Triple
+	for _, t := range g.store.CopyTriples() {
+		if t.Predicate() != ResourceCountPredicate {
+			triples = append(triples, t)
+		}
+	}
+	return tstore.NewLenientNTEncoder(w).Encode(triples...)
+}
+
+func (g *Graph) removeFreshness(resourceTypes ...string) {
+	snap := g.store.Snapshot()
+	for _, t := range resourceTypes {
+		g.store.Remove(snap.WithSubject(freshnessSubjectPrefix + t)...)
+	}
+}
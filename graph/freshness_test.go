@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFreshness(t *testing.T) {
+	g := NewGraph()
+	g.AddResource(instResource("inst_1").build())
+	old := time.Now().Add(-48 * time.Hour).UTC().Truncate(time.Second)
+	g.SetFreshness(&Freshness{ResourceType: "instance", FetchedAt: old}, &Freshness{ResourceType: "subnet", FetchedAt: old, Err: "throttled"})
+
+	now := time.Now().UTC().Truncate(time.Second)
+	g.SetFreshness(&Freshness{ResourceType: "subnet", FetchedAt: now})
+
+	reloaded := NewGraph()
+	if err := reloaded.Unmarshal([]byte(g.MustMarshal())); err != nil {
+		t.Fatal(err)
+	}
+	all, err := reloaded.Freshness()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(all), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := all["instance"].FetchedAt, old; !got.Equal(want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if !all["instance"].IsStale(24 * time.Hour) {
+		t.Fatal("expected stale")
+	}
+	if all["subnet"].Failed() || all["subnet"].IsStale(24*time.Hour) {
+		t.Fatalf("expected fresh and successful, got %#v", all["subnet"])
+	}
+
+	if res, _ := reloaded.GetAllResources("instance"); len(res) != 1 {
+		t.Fatalf("got %d instances, want 1", len(res))
+	}
+
+	fresh := NewGraph()
+	fresh.SetFreshness(&Freshness{ResourceType: "instance", FetchedAt: now, Err: "access denied"})
+	reloaded.ReplaceTypes(fresh, "instance")
+	if all, err = reloaded.Freshness(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := all["instance"].Err, "access denied"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := all["instance"].FetchedAt, now; !got.Equal(want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestMergedFreshness(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	earlier := now.Add(-time.Hour)
+
+	region1, region2 := NewGraph(), NewGraph()
+	region1.SetFreshness(&Freshness{ResourceType: "s3object", FetchedAt: now, Count: 1200})
+	region2.SetFreshness(&Freshness{ResourceType: "s3object", FetchedAt: earlier, Count: 300})
+
+	merged := NewGraph()
+	merged.AddGraph(region1)
+	merged.AddGraph(region2)
+
+	all, err := merged.Freshness()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := all["s3object"].Count, 1500; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := all["s3object"].FetchedAt, earlier; !got.Equal(want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestMarshalSyncedLeavesOutCounts(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	g := NewGraph()
+	g.SetFreshness(&Freshness{ResourceType: "s3object", FetchedAt: now, Count: 1200})
+
+	var buf bytes.Buffer
+	if err := g.MarshalSyncedTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), ResourceCountPredicate) {
+		t.Fatalf("expected no resource count in %s", buf.String())
+	}
+	reloaded := NewGraph()
+	if err := reloaded.Unmarshal(buf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	all, err := reloaded.Freshness()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := all["s3object"].FetchedAt, now; !got.Equal(want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
@@ -57,31 +57,35 @@ func (g *Graph) AsRDFGraphSnaphot() tstore.RDFGraph {
 
 func (g *Graph) AddResource(resources ...*Resource) error {
 	for _, res := range resources {
-		triples, err := res.marshalFullRDF()
+		triples, err := resourceTriples(res)
 		if err != nil {
 			return err
 		}
+		g.store.Add(triples...)
+	}
+	return nil
+}
 
-		for relType, attachedRes := range res.relations {
-			switch relType {
-			case rdf.ChildrenOfRel:
-				for _, attached := range attachedRes {
-					if err := g.AddParentRelation(attached, res); err != nil {
-						return err
-					}
-				}
-			case rdf.DependingOnRel:
-				for _, attached := range attachedRes {
-					if err := g.AddAppliesOnRelation(attached, res); err != nil {
-						return err
-					}
-				}
+// Triples of a resource with its relations
+func resourceTriples(res *Resource) ([]tstore.Triple, error) {
+	triples, err := res.marshalFullRDF()
+	if err != nil {
+		return triples, err
+	}
+
+	for relType, attachedRes := range res.relations {
+		switch relType {
+		case rdf.ChildrenOfRel:
+			for _, attached := range attachedRes {
+				triples = append(triples, tstore.SubjPred(attached.Id(), rdf.ParentOf).Resource(res.Id()))
+			}
+		case rdf.DependingOnRel:
+			for _, attached := range attachedRes {
+				triples = append(triples, tstore.SubjPred(attached.Id(), rdf.ApplyOn).Resource(res.Id()))
 			}
 		}
-
-		g.store.Add(triples...)
 	}
-	return nil
+	return triples, nil
 }
 
 func (g *Graph) AddGraph(other *Graph) {
@@ -252,6 +256,40 @@ func (g *Graph) Merge(mg cloud.GraphAPI) error {
 	return nil
 }
 
+// FillProperty sets the property on all resources of the graph not having it yet
+func (g *Graph) FillProperty(key string, value interface{}) error {
+	propId, err := rdf.Properties.GetRDFId(key)
+	if err != nil {
+		return err
+	}
+	definedBy, err := rdf.Properties.GetDefinedBy(propId)
+	if err != nil {
+		return err
+	}
+	dataType, err := rdf.Properties.GetDataType(propId)
+	if err != nil {
+		return err
+	}
+	obj, err := marshalToRdfObject(value, definedBy, dataType)
+	if err != nil {
+		return err
+	}
+
+	snap := g.store.Snapshot()
+	nested := nestedNodes(snap)
+	var triples []tstore.Triple
+	for _, t := range snap.WithPredicate(rdf.RdfType) {
+		if nested[t.Subject()] {
+			continue
+		}
+		if len(snap.WithSubjPred(t.Subject(), propId)) == 0 {
+			triples = append(triples, tstore.SubjPred(t.Subject(), propId).Object(obj))
+		}
+	}
+	g.store.Add(triples...)
+	return nil
+}
+
 func (g *Graph) ResourceRelations(from cloud.Resource, relation string, recursive bool) (collect []cloud.Resource, err error) {
 	collectFunc := func(r *Resource, depth int) error {
 		if depth == 1 || recursive {
@@ -371,3 +371,45 @@ func TestResourceChildrenAndSiblings(t *testing.T) {
 		}
 	})
 }
+
+func TestDiffProperties(t *testing.T) {
+	from := InitResource(cloud.Instance, "inst_1")
+	from.SetProperty(properties.State, "running")
+	from.SetProperty(properties.SecurityGroups, []string{"sg_1", "sg_2"})
+	from.SetProperty(properties.Name, "redis")
+
+	to := InitResource(cloud.Instance, "inst_1")
+	to.SetProperty(properties.State, "stopped")
+	to.SetProperty(properties.SecurityGroups, []string{"sg_2", "sg_1"})
+	to.SetProperty(properties.PublicIP, "1.2.3.4")
+
+	if got, want := DiffProperties(from, to), []string{properties.Name, properties.PublicIP, properties.State}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := DiffProperties(from, to, properties.State, properties.SecurityGroups), []string{properties.State}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestFillPropertySkipsNestedNodes(t *testing.T) {
+	sg := sGrpResource("sg_1").prop("InboundRules", []*FirewallRule{{PortRange: PortRange{FromPort: 80, ToPort: 80}, Protocol: "tcp"}}).build()
+	inst := instResource("inst_1").prop(properties.Region, "us-east-1").build()
+	g := NewGraph()
+	g.AddResource(sg, inst)
+
+	if err := g.FillProperty(properties.Region, "eu-west-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	snap := g.AsRDFGraphSnaphot()
+	if got, want := len(snap.WithPredicate(rdf.Region)), 2; got != want {
+		t.Fatalf("got %d region triples, want %d", got, want)
+	}
+	for _, tcase := range []struct {
+		id, region string
+	}{{"sg_1", "eu-west-1"}, {"inst_1", "us-east-1"}} {
+		if !snap.Contains(tstore.SubjPred(tcase.id, rdf.Region).StringLiteral(tcase.region)) {
+			t.Fatalf("expected %s to be in region %s", tcase.id, tcase.region)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/cloud/rdf"
+	tstore "github.com/wallix/triplestore"
+)
+
+// ResourceKeyFunc returns the key used to match a resource with its
+// counterpart in another graph (ex: other region or profile).
+// An empty key means the resource cannot be matched.
+type ResourceKeyFunc func(*Resource) string
+
+func KeyByName(r *Resource) string {
+	if name, ok := r.Properties()[properties.Name].(string); ok {
+		return name
+	}
+	return ""
+}
+
+func KeyByTags(keys ...string) ResourceKeyFunc {
+	return func(r *Resource) string {
+		tags, _ := r.Properties()[properties.Tags].([]string)
+		var values []string
+		for _, k := range keys {
+			var found bool
+			for _, t := range tags {
+				if strings.HasPrefix(t, k+"=") {
+					values = append(values, t)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return ""
+			}
+		}
+		return strings.Join(values, ",")
+	}
+}
+
+// MatchGraphs returns copies of the given graphs in which resources are identified
+// by their matching key rather than by their cloud ID, so that they can be diffed
+// with the DefaultDiffer. References to other resources in properties are rewritten accordingly,
+// the root of the 'to' graph is renamed to the root of the 'from' graph
+// and the ignored properties (ex: Arn, Created) are dropped.
+func MatchGraphs(from *Graph, fromRoot string, to *Graph, toRoot string, key ResourceKeyFunc, ignored ...string) (*Graph, *Graph, error) {
+	fromKeys, err := resourceKeys(from, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	toKeys, err := resourceKeys(to, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	typesByKey := make(map[string]map[string]bool)
+	for _, keys := range []map[*Resource]string{fromKeys, toKeys} {
+		for res, k := range keys {
+			if typesByKey[k] == nil {
+				typesByKey[k] = make(map[string]bool)
+			}
+			typesByKey[k][res.Type()] = true
+		}
+	}
+
+	ignoredPreds := make(map[string]bool)
+	for _, prop := range ignored {
+		if pred, err := rdf.Properties.GetRDFId(prop); err == nil {
+			ignoredPreds[pred] = true
+		}
+	}
+
+	fromIds := matchedIds(fromKeys, typesByKey)
+	fromIds[fromRoot] = fromRoot
+	toIds := matchedIds(toKeys, typesByKey)
+	toIds[toRoot] = fromRoot
+
+	return from.rewriteIds(fromIds, ignoredPreds), to.rewriteIds(toIds, ignoredPreds), nil
+}
+
+func resourceKeys(g *Graph, key ResourceKeyFunc) (map[*Resource]string, error) {
+	idPred, err := rdf.Properties.GetRDFId(properties.ID)
+	if err != nil {
+		return nil, err
+	}
+	snap := g.store.Snapshot()
+	keys := make(map[*Resource]string)
+	for _, t := range snap.WithPredicate(idPred) {
+		typ, err := resolveResourceType(snap, t.Subject())
+		if err != nil {
+			return nil, fmt.Errorf("matching resources: %s", err)
+		}
+		if typ == cloud.Region {
+			continue
+		}
+		res, err := g.GetResource(typ, t.Subject())
+		if err != nil {
+			return nil, fmt.Errorf("matching resources: %s", err)
+		}
+		if k := key(res); k != "" {
+			keys[res] = k
+		}
+	}
+	return keys, nil
+}
+
+// Resources sharing a key with resources of another type get a key qualified with their type.
+// Resources of the same type sharing a key are ambiguous and keep their original id.
+func matchedIds(keys map[*Resource]string, typesByKey map[string]map[string]bool) map[string]string {
+	count := make(map[string]int)
+	for res, k := range keys {
+		count[res.Type()+"/"+k]++
+	}
+	ids := make(map[string]string)
+	for res, k := range keys {
+		if count[res.Type()+"/"+k] > 1 {
+			continue
+		}
+		if len(typesByKey[k]) > 1 {
+			k = fmt.Sprintf("%s[%s]", k, res.Type())
+		}
+		ids[res.Id()] = k
+	}
+	return ids
+}
+
+func (g *Graph) rewriteIds(ids map[string]string, ignoredPreds map[string]bool) *Graph {
+	rewrite := func(s string) string {
+		if id, ok := ids[s]; ok {
+			return id
+		}
+		return s
+	}
+
+	rewritten := NewGraph()
+	for _, t := range g.store.Snapshot().Triples() {
+		if ignoredPreds[t.Predicate()] {
+			continue
+		}
+		builder := tstore.SubjPred(rewrite(t.Subject()), t.Predicate())
+		obj := t.Object()
+		if res, ok := obj.Resource(); ok {
+			rewritten.store.Add(builder.Resource(rewrite(res)))
+			continue
+		}
+		if lit, ok := obj.Literal(); ok && lit.Type() == tstore.XsdString {
+			if id, ok := ids[lit.Value()]; ok {
+				rewritten.store.Add(builder.StringLiteral(id))
+				continue
+			}
+		}
+		rewritten.store.Add(builder.Object(obj))
+	}
+	return rewritten
+}
@@ -0,0 +1,86 @@
+package graph_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestMatchGraphs(t *testing.T) {
+	from := graph.NewGraph()
+	from.AddResource(
+		resourcetest.Region("eu-west-1").Build(),
+		resourcetest.VPC("vpc_1").Prop("Name", "prod").Prop("Arn", "arn:vpc_1").Build(),
+		resourcetest.Subnet("sub_1").Prop("Name", "prod").Prop("Vpc", "vpc_1").Build(),
+		resourcetest.Instance("inst_1").Prop("Name", "web").Prop("State", "running").Prop("Subnet", "sub_1").Build(),
+		resourcetest.Instance("inst_2").Prop("Name", "db").Prop("Subnet", "sub_1").Build(),
+		resourcetest.Instance("inst_3").Build(),
+	)
+	resourcetest.AddParents(from, "eu-west-1 -> vpc_1", "vpc_1 -> sub_1", "sub_1 -> inst_1", "sub_1 -> inst_2", "sub_1 -> inst_3")
+
+	to := graph.NewGraph()
+	to.AddResource(
+		resourcetest.Region("us-east-1").Build(),
+		resourcetest.VPC("vpc_9").Prop("Name", "prod").Prop("Arn", "arn:vpc_9").Build(),
+		resourcetest.Subnet("sub_9").Prop("Name", "prod").Prop("Vpc", "vpc_9").Build(),
+		resourcetest.Instance("inst_9").Prop("Name", "web").Prop("State", "stopped").Prop("Subnet", "sub_9").Build(),
+		resourcetest.Instance("inst_8").Prop("Name", "cache").Prop("Subnet", "sub_9").Build(),
+	)
+	resourcetest.AddParents(to, "us-east-1 -> vpc_9", "vpc_9 -> sub_9", "sub_9 -> inst_9", "sub_9 -> inst_8")
+
+	matchedFrom, matchedTo, err := graph.MatchGraphs(from, "eu-west-1", to, "us-east-1", graph.KeyByName, "Arn")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vpc, err := matchedTo.GetResource("vpc", "prod[vpc]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := vpc.Property("Arn"); ok {
+		t.Fatal("expected ignored property to be dropped")
+	}
+	web, err := matchedTo.GetResource("instance", "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := web.Properties()["Subnet"], "prod[subnet]"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	diff, err := graph.DefaultDiffer.Run("eu-west-1", matchedFrom, matchedTo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.HasDiff() {
+		t.Fatal("expected diff")
+	}
+
+	diffOf := func(g *graph.Graph) (ids []string) {
+		all, err := g.GetAllResources(cloud.Instance)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range all {
+			res, err := g.GetResource(cloud.Instance, r.Id())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if meta, _ := res.Meta("diff"); meta == "extra" {
+				ids = append(ids, res.Id())
+			}
+		}
+		sort.Strings(ids)
+		return
+	}
+	if got, want := diffOf(diff.FromGraph()), []string{"db", "inst_3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := diffOf(diff.ToGraph()), []string{"cache"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
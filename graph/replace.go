@@ -0,0 +1,128 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"github.com/wallix/awless/cloud/rdf"
+	tstore "github.com/wallix/triplestore"
+)
+
+// ReplaceTypes replaces all the resources of the given types (with their properties and nested nodes)
+// by the ones of the fresh graph, typically obtained by fetching only those types.
+//
+// Relations of replaced resources are dropped when the resource disappeared, or when the fresh
+// graph provides the same kind of relation (same predicate, direction and related resource type).
+// Other relations (ex: computed when fetching other types) are kept untouched.
+func (g *Graph) ReplaceTypes(fresh *Graph, types ...string) {
+	snap := g.store.Snapshot()
+	freshSnap := fresh.store.Snapshot()
+
+	typeOf := func(id string) string {
+		if t, err := resolveResourceType(freshSnap, id); err == nil {
+			return t
+		}
+		t, _ := resolveResourceType(snap, id)
+		return t
+	}
+
+	freshIds := make(map[string]bool)
+	freshRelations := make(map[relationKind]bool)
+	for _, t := range types {
+		for _, tri := range freshSnap.WithPredObj(rdf.RdfType, tstore.Resource(namespacedResourceType(t))) {
+			id := tri.Subject()
+			freshIds[id] = true
+			for _, rel := range relationTriplesOf(freshSnap, id) {
+				freshRelations[newRelationKind(rel, id, typeOf)] = true
+			}
+		}
+	}
+
+	var toRemove []tstore.Triple
+	for _, t := range types {
+		for _, tri := range snap.WithPredObj(rdf.RdfType, tstore.Resource(namespacedResourceType(t))) {
+			id := tri.Subject()
+			for _, prop := range snap.WithSubject(id) {
+				if isRelationPredicate(prop.Predicate()) {
+					continue
+				}
+				toRemove = append(toRemove, prop)
+				if nested, ok := prop.Object().Resource(); ok && isNestedNodeProperty(prop.Predicate()) {
+					toRemove = append(toRemove, snap.WithSubject(nested)...)
+				}
+			}
+			for _, rel := range relationTriplesOf(snap, id) {
+				if !freshIds[id] || freshRelations[newRelationKind(rel, id, typeOf)] {
+					toRemove = append(toRemove, rel)
+				}
+			}
+		}
+	}
+
+	g.store.Remove(toRemove...)
+	g.removeFreshness(types...)
+	g.AddGraph(fresh)
+}
+
+type relationKind struct {
+	predicate   string
+	outgoing    bool
+	relatedType string
+}
+
+func newRelationKind(rel tstore.Triple, id string, typeOf func(string) string) relationKind {
+	if rel.Subject() == id {
+		related, _ := rel.Object().Resource()
+		return relationKind{predicate: rel.Predicate(), outgoing: true, relatedType: typeOf(related)}
+	}
+	return relationKind{predicate: rel.Predicate(), relatedType: typeOf(rel.Subject())}
+}
+
+func relationTriplesOf(snap tstore.RDFGraph, id string) (triples []tstore.Triple) {
+	for _, pred := range []string{rdf.ParentOf, rdf.ApplyOn} {
+		triples = append(triples, snap.WithSubjPred(id, pred)...)
+		triples = append(triples, snap.WithPredObj(pred, tstore.Resource(id))...)
+	}
+	return
+}
+
+func isRelationPredicate(pred string) bool {
+	return pred == rdf.ParentOf || pred == rdf.ApplyOn
+}
+
+// nestedNodes returns the ids of the nested nodes referenced by resources of the graph
+func nestedNodes(snap tstore.RDFGraph) map[string]bool {
+	nested := make(map[string]bool)
+	for _, t := range snap.Triples() {
+		if id, ok := t.Object().Resource(); ok && isNestedNodeProperty(t.Predicate()) {
+			nested[id] = true
+		}
+	}
+	return nested
+}
+
+// Nested nodes (firewall rules, routes, grants, ...) are only referenced by list properties of their resource
+func isNestedNodeProperty(pred string) bool {
+	definedBy, err := rdf.Properties.GetDefinedBy(pred)
+	if err != nil || definedBy != rdf.RdfsList {
+		return false
+	}
+	dataType, err := rdf.Properties.GetDataType(pred)
+	if err != nil {
+		return false
+	}
+	return dataType != rdf.XsdString && dataType != rdf.RdfsClass
+}
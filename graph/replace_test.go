@@ -0,0 +1,102 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/cloud/rdf"
+	tstore "github.com/wallix/triplestore"
+)
+
+func TestReplaceTypes(t *testing.T) {
+	region := InitResource("region", "eu-west-1")
+	vpc := vpcResource("vpc_1").build()
+	sub1, sub2 := subResource("sub_1").build(), subResource("sub_2").build()
+	sg := sGrpResource("sg_1").prop("InboundRules", []*FirewallRule{{PortRange: PortRange{FromPort: 80, ToPort: 80}, Protocol: "tcp"}}).build()
+	inst1 := instResource("inst_1").prop("State", "running").build()
+	inst2 := instResource("inst_2").prop("State", "running").build()
+
+	g := NewGraph()
+	g.AddResource(region, vpc, sub1, sub2, sg, inst1, inst2)
+	g.AddParentRelation(region, vpc)
+	g.AddParentRelation(vpc, sub1)
+	g.AddParentRelation(vpc, sub2)
+	g.AddParentRelation(sub1, inst1)
+	g.AddParentRelation(sub1, inst2)
+	g.AddAppliesOnRelation(sg, inst1)
+
+	fresh := NewGraph()
+	freshInst1 := instResource("inst_1").prop("State", "stopped").build()
+	inst3 := instResource("inst_3").prop("State", "pending").build()
+	fresh.AddResource(freshInst1, inst3)
+	fresh.AddParentRelation(sub2, freshInst1)
+	fresh.AddParentRelation(sub1, inst3)
+
+	g.ReplaceTypes(fresh, "instance")
+
+	res, err := g.GetResource("instance", "inst_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res.Properties()["State"], "stopped"; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if found, _ := g.FindResource("inst_2"); found != nil {
+		t.Fatalf("expected inst_2 to be removed, got %v", found)
+	}
+	if _, err := g.GetResource("instance", "inst_3"); err != nil {
+		t.Fatal(err)
+	}
+
+	snap := g.AsRDFGraphSnaphot()
+	for _, tcase := range []struct {
+		triple   tstore.Triple
+		expected bool
+	}{
+		{tstore.SubjPred("sub_2", rdf.ParentOf).Resource("inst_1"), true},
+		{tstore.SubjPred("sub_1", rdf.ParentOf).Resource("inst_3"), true},
+		{tstore.SubjPred("sub_1", rdf.ParentOf).Resource("inst_1"), false},
+		{tstore.SubjPred("sub_1", rdf.ParentOf).Resource("inst_2"), false},
+		{tstore.SubjPred("vpc_1", rdf.ParentOf).Resource("sub_1"), true},
+		{tstore.SubjPred("sg_1", rdf.ApplyOn).Resource("inst_1"), true},
+	} {
+		if got, want := snap.Contains(tcase.triple), tcase.expected; got != want {
+			t.Fatalf("%s: got %t, want %t", tcase.triple, got, want)
+		}
+	}
+
+	freshSg := sGrpResource("sg_1").prop("InboundRules", []*FirewallRule{{PortRange: PortRange{FromPort: 443, ToPort: 443}, Protocol: "tcp"}}).build()
+	fresh = NewGraph()
+	fresh.AddResource(freshSg)
+	g.ReplaceTypes(fresh, "securitygroup")
+
+	if got, want := len(g.AsRDFGraphSnaphot().WithPredObj(rdf.RdfType, tstore.Resource(rdf.NetFirewallRule))), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	res, err = g.GetResource("securitygroup", "sg_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := res.Properties()["InboundRules"].([]*FirewallRule)
+	if got, want := rules[0].PortRange.FromPort, int64(443); got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if !g.AsRDFGraphSnaphot().Contains(tstore.SubjPred("vpc_1", rdf.ParentOf).Resource("sub_1")) {
+		t.Fatal("expected relations of other types to be kept")
+	}
+}
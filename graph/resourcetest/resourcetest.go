@@ -106,6 +106,10 @@ func Record(id string) *rBuilder {
 	return new("record", id)
 }
 
+func HealthCheck(id string) *rBuilder {
+	return new("healthcheck", id)
+}
+
 func ScalingGroup(id string) *rBuilder {
 	return new("scalinggroup", id)
 }
@@ -130,6 +134,18 @@ func Function(id string) *rBuilder {
 	return new("function", id)
 }
 
+func RestApi(id string) *rBuilder {
+	return new("restapi", id)
+}
+
+func Stage(id string) *rBuilder {
+	return new("stage", id)
+}
+
+func StateMachine(id string) *rBuilder {
+	return new("statemachine", id)
+}
+
 func Alarm(id string) *rBuilder {
 	return new("alarm", id)
 }
@@ -138,6 +154,10 @@ func Metric(id string) *rBuilder {
 	return new("metric", id)
 }
 
+func LogGroup(id string) *rBuilder {
+	return new("loggroup", id)
+}
+
 func Image(id string) *rBuilder {
 	return new("image", id)
 }
@@ -150,6 +170,22 @@ func Stack(id string) *rBuilder {
 	return new("stack", id)
 }
 
+func Table(id string) *rBuilder {
+	return new("table", id)
+}
+
+func Key(id string) *rBuilder {
+	return new("key", id)
+}
+
+func Parameter(id string) *rBuilder {
+	return new("parameter", id)
+}
+
+func GlobalTable(id string) *rBuilder {
+	return new("globaltable", id)
+}
+
 func Repository(id string) *rBuilder {
 	return new("repository", id)
 }
@@ -174,10 +210,34 @@ func NetworkInterface(id string) *rBuilder {
 	return new("networkinterface", id)
 }
 
+func VpcPeering(id string) *rBuilder {
+	return new("vpcpeering", id)
+}
+
+func VpcEndpoint(id string) *rBuilder {
+	return new("vpcendpoint", id)
+}
+
 func Certificate(id string) *rBuilder {
 	return new("certificate", id)
 }
 
+func CacheCluster(id string) *rBuilder {
+	return new("cachecluster", id)
+}
+
+func ReplicationGroup(id string) *rBuilder {
+	return new("replicationgroup", id)
+}
+
+func FileSystem(id string) *rBuilder {
+	return new("filesystem", id)
+}
+
+func MountTarget(id string) *rBuilder {
+	return new("mounttarget", id)
+}
+
 func AccessKey(id string) *rBuilder {
 	return new("accesskey", id)
 }
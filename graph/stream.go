@@ -0,0 +1,50 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"io"
+	"sync"
+
+	tstore "github.com/wallix/triplestore"
+)
+
+// TripleWriter incrementally writes resources as N-Triples, so that they do not need to be held in a graph.
+// It is safe for concurrent use.
+type TripleWriter struct {
+	mu  sync.Mutex
+	enc tstore.Encoder
+}
+
+func NewTripleWriter(w io.Writer) *TripleWriter {
+	return &TripleWriter{enc: tstore.NewLenientNTEncoder(w)}
+}
+
+// WriteResources writes the triples of the resources and their relations, as they would be in a graph
+func (w *TripleWriter) WriteResources(resources ...*Resource) error {
+	var all []tstore.Triple
+	for _, res := range resources {
+		triples, err := resourceTriples(res)
+		if err != nil {
+			return err
+		}
+		all = append(all, triples...)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.enc.Encode(all...)
+}
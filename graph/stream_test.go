@@ -0,0 +1,41 @@
+package graph
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/cloud/rdf"
+)
+
+func TestTripleWriter(t *testing.T) {
+	vpc := vpcResource("vpc_1").build()
+	sub := subResource("sub_1").prop("CIDR", "10.0.0.0/24").build()
+	sub.AddRelation(rdf.ChildrenOfRel, vpc)
+	inst := instResource("inst_1").prop("Name", "redis").build()
+	inst.AddRelation(rdf.ChildrenOfRel, sub)
+	inst.AddRelation(rdf.DependingOnRel, sGrpResource("sg_1").build())
+
+	g := NewGraph()
+	g.AddResource(vpc, sub, inst)
+
+	var buff bytes.Buffer
+	w := NewTripleWriter(&buff)
+	if err := w.WriteResources(vpc, sub); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteResources(inst); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := sortedLines(buff.String()), sortedLines(g.MustMarshal()); got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func sortedLines(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
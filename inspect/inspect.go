@@ -29,6 +29,7 @@ func init() {
 	all := []Inspector{
 		&inspectors.Pricer{}, &inspectors.BucketSizer{},
 		&inspectors.PortScanner{}, &inspectors.OpenBuckets{},
+		&inspectors.TagsCompliance{},
 	}
 
 	InspectorsRegister = make(map[string]Inspector)
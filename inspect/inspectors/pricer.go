@@ -20,21 +20,35 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
-	"net/http"
-	"net/url"
-	"strconv"
-	"sync"
+	"sort"
 	"text/tabwriter"
 
+	"github.com/wallix/awless/aws/pricing"
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
 )
 
-var pricesURL = "http://ec2-price.com"
+const (
+	noVpc    = "(no vpc)"
+	untagged = "(untagged)"
+)
 
+// Pricer estimates the monthly cost of the resources from the local price list of the region
+// (see `awless pricing update`), broken down per resource type, VPC and tag
 type Pricer struct {
-	total float64
-	count map[string]int
+	// Dir holds the price lists (default: ~/.awless/pricing)
+	Dir string
+
+	region                string
+	updated               string
+	total                 float64
+	unpriced              int
+	byType, byVpc, byTags map[string]*cost
+}
+
+type cost struct {
+	count   int
+	monthly float64
 }
 
 func (p *Pricer) Name() string {
@@ -46,96 +60,112 @@ func (p *Pricer) Inspect(g cloud.GraphAPI) error {
 	if err != nil {
 		return err
 	}
+	dir := p.Dir
+	if dir == "" {
+		dir = pricing.DefaultDir()
+	}
+	list, err := pricing.Load(dir, region)
+	if err != nil {
+		return err
+	}
+	p.region, p.updated = region, list.Updated.Format("2006-01-02")
+	p.total, p.unpriced = 0, 0
+	p.byType, p.byVpc, p.byTags = make(map[string]*cost), make(map[string]*cost), make(map[string]*cost)
 
+	instancesVpc := make(map[string]string)
 	instances, err := g.Find(cloud.NewQuery(cloud.Instance))
 	if err != nil {
 		return err
 	}
-
-	p.count = make(map[string]int)
-	pricePerType := make(map[string]float64)
-
 	for _, inst := range instances {
-		typ := inst.Properties()["Type"].(string)
-		pricePerType[typ] = 0.0
-		p.count[typ] = p.count[typ] + 1
+		if vpc, ok := inst.Properties()[properties.Vpc].(string); ok {
+			instancesVpc[inst.Id()] = vpc
+		}
 	}
 
-	fmt.Printf("Fetching prices at %s for region %s\n\n", pricesURL, region)
-
-	type result struct {
-		typ   string
-		price float64
-	}
-
-	var wg sync.WaitGroup
-	resultC := make(chan result)
-
-	for ty := range pricePerType {
-		wg.Add(1)
-		go func(t string) {
-			defer wg.Done()
-			price, err := fetchPrice(t, region)
-			if err != nil {
-				fmt.Printf("fetching price for '%s': %s\n", t, err)
-				return
+	for _, typ := range pricing.PricedTypes {
+		resources, err := g.Find(cloud.NewQuery(typ))
+		if err != nil {
+			return err
+		}
+		for _, res := range resources {
+			monthly, ok := list.MonthlyCost(res)
+			if !ok {
+				p.unpriced++
+				continue
 			}
-
-			resultC <- result{typ: t, price: price}
-		}(ty)
-	}
-
-	go func() {
-		wg.Wait()
-		close(resultC)
-	}()
-
-	for r := range resultC {
-		pricePerType[r.typ] = r.price
-	}
-
-	for typ, count := range p.count {
-		p.total = p.total + (float64(count) * pricePerType[typ])
+			p.total += monthly
+			add(p.byType, typ, monthly)
+			add(p.byVpc, resourceVpc(res, instancesVpc), monthly)
+			tags, _ := res.Properties()[properties.Tags].([]string)
+			if len(tags) == 0 {
+				add(p.byTags, untagged, monthly)
+			}
+			for _, tag := range tags {
+				add(p.byTags, tag, monthly)
+			}
+		}
 	}
 
 	return nil
 }
 
 func (p *Pricer) Print(w io.Writer) {
-	tabw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
-
-	fmt.Fprintln(tabw, "Instance\tCount\tEstimated total/day (no EBS)\t")
-	fmt.Fprintln(tabw, "--------\t-----\t----------------------------\t")
-
-	for instType, count := range p.count {
-		fmt.Fprintf(tabw, "%s\t%d\t%s\t\n", instType, count, "")
+	fmt.Fprintf(w, "Estimated monthly cost in %s (on-demand prices of %s): $%.2f\n", p.region, p.updated, p.total)
+	if p.unpriced > 0 {
+		fmt.Fprintf(w, "%d resource(s) not priced: unknown price (run `awless pricing update`)\n", p.unpriced)
 	}
 
-	fmt.Fprintf(tabw, "%s\t%s\t$%.2f\t\n", "", "", p.total*24)
-
+	tabw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
+	printCosts(tabw, "Type", p.byType)
+	printCosts(tabw, "VPC", p.byVpc)
+	printCosts(tabw, "Tag", p.byTags)
 	tabw.Flush()
 }
 
-func fetchPrice(instType, region string) (float64, error) {
-	resp, err := http.PostForm(
-		pricesURL,
-		url.Values{"instance_type": {instType}, "location": {region}},
-	)
-	if err != nil {
-		return 0.0, err
+func printCosts(w io.Writer, title string, costs map[string]*cost) {
+	var keys []string
+	for k := range costs {
+		keys = append(keys, k)
 	}
-	defer resp.Body.Close()
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return 0.0, err
+	sort.Slice(keys, func(i, j int) bool {
+		if costs[keys[i]].monthly == costs[keys[j]].monthly {
+			return keys[i] < keys[j]
+		}
+		return costs[keys[i]].monthly > costs[keys[j]].monthly
+	})
+
+	fmt.Fprintf(w, "\n%s\tCount\tMonthly\t\n", title)
+	fmt.Fprintln(w, "----\t-----\t-------\t")
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s\t%d\t$%.2f\t\n", k, costs[k].count, costs[k].monthly)
 	}
-	price, err := strconv.ParseFloat(string(body), 64)
-	if err != nil {
-		return 0.0, err
+}
+
+func add(costs map[string]*cost, key string, monthly float64) {
+	c, ok := costs[key]
+	if !ok {
+		c = new(cost)
+		costs[key] = c
 	}
+	c.count++
+	c.monthly += monthly
+}
 
-	return price, nil
+// resourceVpc returns the VPC of a resource, volumes being in the VPC of their instance
+func resourceVpc(res cloud.Resource, instancesVpc map[string]string) string {
+	if vpc, ok := res.Properties()[properties.Vpc].(string); ok && vpc != "" {
+		return vpc
+	}
+	if res.Type() == cloud.Volume {
+		attached, _ := res.Properties()[properties.Instances].([]string)
+		for _, inst := range attached {
+			if vpc, ok := instancesVpc[inst]; ok {
+				return vpc
+			}
+		}
+	}
+	return noVpc
 }
 
 func getRegion(g cloud.GraphAPI) (string, error) {
@@ -0,0 +1,82 @@
+package inspectors
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/aws/pricing"
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestPricer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	list := pricing.NewPriceList("eu-west-1")
+	list.Hourly["instance/t2.micro"] = 0.01
+	list.Hourly["instance/t2.large"] = 0.1
+	list.Hourly["natgateway"] = 0.05
+	list.Monthly["volume/gp2"] = 0.1
+	if err = list.Save(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	vol := graph.InitResource(cloud.Volume, "vol_1")
+	vol.Properties()["Type"] = "gp2"
+	vol.Properties()["Size"] = 100
+	vol.Properties()["Instances"] = []string{"inst_2"}
+
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Region("eu-west-1").Build(),
+		resourcetest.Instance("inst_1").Prop("Type", "t2.micro").Prop("State", "running").Prop("Vpc", "vpc_1").Prop("Tags", []string{"Env=prod"}).Build(),
+		resourcetest.Instance("inst_2").Prop("Type", "t2.large").Prop("State", "running").Prop("Vpc", "vpc_2").Prop("Tags", []string{"Env=prod", "Team=web"}).Build(),
+		resourcetest.Instance("inst_3").Prop("Type", "m4.16xlarge").Prop("State", "running").Build(),
+		resourcetest.NatGw("nat_1").Prop("Vpc", "vpc_1").Build(),
+		vol,
+	)
+
+	pricer := &Pricer{Dir: dir}
+	if err = pricer.Inspect(g); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := pricer.unpriced, 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	var buff bytes.Buffer
+	pricer.Print(&buff)
+	var lines []string
+	for _, line := range strings.Split(buff.String(), "\n") {
+		lines = append(lines, strings.Join(strings.Fields(line), " "))
+	}
+	out := strings.Join(lines, "\n")
+	expected := []string{
+		"Estimated monthly cost in eu-west-1 (on-demand prices of 0001-01-01): $126.80",
+		"instance 2 $80.30",
+		"natgateway 1 $36.50",
+		"volume 1 $10.00",
+		"vpc_2 2 $83.00",
+		"vpc_1 2 $43.80",
+		"Env=prod 2 $80.30",
+		"Team=web 1 $73.00",
+		"(untagged) 2 $46.50",
+	}
+	for _, e := range expected {
+		if !strings.Contains(out, e+"\n") {
+			t.Fatalf("expected %q in output:\n%s", e, out)
+		}
+	}
+
+	if err = (&Pricer{Dir: dir}).Inspect(graph.NewGraph()); err == nil {
+		t.Fatal("expected error without region")
+	}
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspectors
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"gopkg.in/yaml.v2"
+)
+
+const DefaultTagsPolicyFilename = "tags-policy.yml"
+
+// Resource types on which tags can be created with the 'create tag' command (EC2 API)
+var ec2TaggableTypes = []string{
+	cloud.Instance, cloud.Volume, cloud.Snapshot, cloud.Image, cloud.Vpc, cloud.Subnet,
+	cloud.SecurityGroup, cloud.InternetGateway, cloud.NatGateway, cloud.RouteTable, cloud.NetworkInterface,
+}
+
+// TagsPolicy defines the tags every resource must carry.
+// The default rule applies to all EC2 taggable resources,
+// unless a specific rule is given for a resource type.
+//
+// Example of policy file:
+//
+//	default:
+//	  required: [Owner, CostCenter, Env]
+//	  allowed:
+//	    Env: [prod, staging, dev]
+//	types:
+//	  bucket:
+//	    required: [Owner]
+type TagsPolicy struct {
+	Default *TagsRule            `yaml:"default"`
+	Types   map[string]*TagsRule `yaml:"types"`
+}
+
+type TagsRule struct {
+	Required []string            `yaml:"required"`
+	Allowed  map[string][]string `yaml:"allowed"`
+}
+
+func LoadTagsPolicy(path string) (*TagsPolicy, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("tags policy: %s", err)
+	}
+	policy := &TagsPolicy{}
+	if err = yaml.Unmarshal(b, policy); err != nil {
+		return nil, fmt.Errorf("tags policy: %s: %s", path, err)
+	}
+	return policy, nil
+}
+
+func (p *TagsPolicy) rules() map[string]*TagsRule {
+	all := make(map[string]*TagsRule)
+	if p.Default != nil {
+		for _, t := range ec2TaggableTypes {
+			all[t] = p.Default
+		}
+	}
+	for t, r := range p.Types {
+		all[t] = r
+	}
+	return all
+}
+
+type tagViolation struct {
+	resource cloud.Resource
+	key      string
+	value    string
+	missing  bool
+	allowed  []string
+}
+
+func (v *tagViolation) String() string {
+	if v.missing {
+		return fmt.Sprintf("missing '%s'", v.key)
+	}
+	return fmt.Sprintf("invalid '%s=%s' (allowed: %s)", v.key, v.value, strings.Join(v.allowed, ", "))
+}
+
+type TagsCompliance struct {
+	PolicyFile string
+	Fix        bool
+
+	checked    map[string]int
+	violations []*tagViolation
+}
+
+func (*TagsCompliance) Name() string {
+	return "tags"
+}
+
+func (t *TagsCompliance) Inspect(g cloud.GraphAPI) error {
+	if t.PolicyFile == "" {
+		t.PolicyFile = filepath.Join(os.Getenv("__AWLESS_HOME"), DefaultTagsPolicyFilename)
+	}
+	policy, err := LoadTagsPolicy(t.PolicyFile)
+	if err != nil {
+		return err
+	}
+
+	t.checked = make(map[string]int)
+	t.violations = nil
+
+	rules := policy.rules()
+	var types []string
+	for typ := range rules {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	for _, typ := range types {
+		resources, err := g.Find(cloud.NewQuery(typ))
+		if err != nil {
+			return err
+		}
+		sort.Slice(resources, func(i, j int) bool { return resources[i].Id() < resources[j].Id() })
+		for _, res := range resources {
+			t.checked[typ]++
+			t.violations = append(t.violations, checkTags(res, rules[typ])...)
+		}
+	}
+
+	return nil
+}
+
+func (t *TagsCompliance) Print(w io.Writer) {
+	if t.Fix {
+		t.printFixTemplate(w)
+		return
+	}
+
+	nonCompliant := make(map[string]map[string]bool)
+	for _, v := range t.violations {
+		typ := v.resource.Type()
+		if nonCompliant[typ] == nil {
+			nonCompliant[typ] = make(map[string]bool)
+		}
+		nonCompliant[typ][v.resource.Id()] = true
+	}
+
+	tabw := tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
+	fmt.Fprintln(tabw, "Type\tChecked\tNon compliant\t")
+	fmt.Fprintln(tabw, "--------\t-------\t-------------\t")
+	var types []string
+	for typ := range t.checked {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	for _, typ := range types {
+		fmt.Fprintf(tabw, "%s\t%d\t%d\t\n", typ, t.checked[typ], len(nonCompliant[typ]))
+	}
+	tabw.Flush()
+
+	if len(t.violations) == 0 {
+		fmt.Fprintln(w, "\nAll resources are compliant")
+		return
+	}
+
+	fmt.Fprintln(w)
+	tabw = tabwriter.NewWriter(w, 0, 8, 0, '\t', 0)
+	fmt.Fprintln(tabw, "Resource\tViolation\t")
+	fmt.Fprintln(tabw, "--------\t---------\t")
+	for _, v := range t.violations {
+		fmt.Fprintf(tabw, "%s\t%s\t\n", v.resource, v)
+	}
+	tabw.Flush()
+	fmt.Fprintln(w, "\nRun with --fix to generate an awless template fixing those violations")
+}
+
+func (t *TagsCompliance) printFixTemplate(w io.Writer) {
+	if len(t.violations) == 0 {
+		fmt.Fprintln(w, "# all resources are compliant: nothing to fix")
+		return
+	}
+	for _, v := range t.violations {
+		if !isEC2Taggable(v.resource.Type()) {
+			fmt.Fprintf(w, "# cannot fix %s on %s: not an EC2 resource\n", v, v.resource)
+			continue
+		}
+		value := fmt.Sprintf("{tag.%s}", strings.ToLower(v.key))
+		if len(v.allowed) == 1 {
+			value = v.allowed[0]
+		}
+		fmt.Fprintf(w, "create tag resource=%s key=%s value=%s\n", v.resource.Id(), v.key, value)
+	}
+}
+
+func checkTags(res cloud.Resource, rule *TagsRule) (violations []*tagViolation) {
+	if rule == nil {
+		return
+	}
+	tags := make(map[string]string)
+	if list, ok := res.Properties()[properties.Tags].([]string); ok {
+		for _, t := range list {
+			splits := strings.SplitN(t, "=", 2)
+			if len(splits) == 2 {
+				tags[splits[0]] = splits[1]
+			} else {
+				tags[splits[0]] = ""
+			}
+		}
+	}
+
+	for _, key := range rule.Required {
+		if _, ok := tags[key]; !ok {
+			violations = append(violations, &tagViolation{resource: res, key: key, missing: true, allowed: rule.Allowed[key]})
+		}
+	}
+
+	var keys []string
+	for key := range rule.Allowed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		val, ok := tags[key]
+		if !ok || contains(rule.Allowed[key], val) {
+			continue
+		}
+		violations = append(violations, &tagViolation{resource: res, key: key, value: val, allowed: rule.Allowed[key]})
+	}
+
+	return
+}
+
+func isEC2Taggable(typ string) bool {
+	return contains(ec2TaggableTypes, typ)
+}
+
+func contains(arr []string, s string) bool {
+	for _, a := range arr {
+		if a == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package inspectors
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+)
+
+func TestTagsCompliance(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-tags-policy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	policyFile := filepath.Join(dir, DefaultTagsPolicyFilename)
+	policy := `
+default:
+  required: [Owner, Env]
+  allowed:
+    Env: [prod, staging]
+types:
+  bucket:
+    required: [Owner]
+    allowed:
+      Owner: [ops]
+`
+	if err = ioutil.WriteFile(policyFile, []byte(policy), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	g := graph.NewGraph()
+	g.AddResource(
+		resourcetest.Instance("inst_1").Prop("Tags", []string{"Owner=john", "Env=prod"}).Build(),
+		resourcetest.Instance("inst_2").Prop("Tags", []string{"Env=test"}).Build(),
+		resourcetest.Subnet("sub_1").Build(),
+		resourcetest.Bucket("buck_1").Build(),
+	)
+
+	inspector := &TagsCompliance{PolicyFile: policyFile}
+	if err = inspector.Inspect(g); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, v := range inspector.violations {
+		got = append(got, v.resource.Id()+": "+v.String())
+	}
+	expected := []string{
+		"buck_1: missing 'Owner'",
+		"inst_2: missing 'Owner'",
+		"inst_2: invalid 'Env=test' (allowed: prod, staging)",
+		"sub_1: missing 'Owner'",
+		"sub_1: missing 'Env'",
+	}
+	if g, w := strings.Join(got, "\n"), strings.Join(expected, "\n"); g != w {
+		t.Fatalf("got\n%s\n\nwant\n%s", g, w)
+	}
+	if got, want := inspector.checked["instance"], 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	inspector.Fix = true
+	var buff bytes.Buffer
+	inspector.Print(&buff)
+	expectedTpl := `# cannot fix missing 'Owner' on buck_1[bucket]: not an EC2 resource
+create tag resource=inst_2 key=Owner value={tag.owner}
+create tag resource=inst_2 key=Env value={tag.env}
+create tag resource=sub_1 key=Owner value={tag.owner}
+create tag resource=sub_1 key=Env value={tag.env}
+`
+	if got, want := buff.String(), expectedTpl; got != want {
+		t.Fatalf("got\n%s\n\nwant\n%s", got, want)
+	}
+}
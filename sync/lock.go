@@ -0,0 +1,49 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+const lockFilename = "sync.lock"
+
+// LockTimeout is how long a sync waits for another awless process (ex: a sync daemon) to release the sync lock
+var LockTimeout = 30 * time.Second
+
+// Lock takes the inter-process lock guarding the local sync repository, waiting at most the given timeout.
+// The lock relies on the file lock of a bolt file, so it is released by the system if the process dies.
+func Lock(timeout time.Duration) (unlock func() error, err error) {
+	home := os.Getenv("__AWLESS_HOME")
+	if home == "" {
+		return func() error { return nil }, nil
+	}
+	path := filepath.Join(home, lockFilename)
+	lock, err := bolt.Open(path, 0600, &bolt.Options{Timeout: timeout})
+	if err == bolt.ErrTimeout {
+		return nil, fmt.Errorf("sync is locked by another awless process (ex: sync daemon) since more than %s", timeout)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("taking sync lock at %s: %s", path, err)
+	}
+	return lock.Close, nil
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+// RetentionPolicy defines the revisions kept when compacting the sync history:
+// the last revision of each of the KeepDaily latest days and of each of the KeepWeekly latest weeks.
+// The latest revision is always kept.
+type RetentionPolicy struct {
+	KeepDaily, KeepWeekly int
+}
+
+func (p RetentionPolicy) String() string {
+	return fmt.Sprintf("keeping %d daily and %d weekly revisions", p.KeepDaily, p.KeepWeekly)
+}
+
+type GCStats struct {
+	RevisionsBefore, RevisionsAfter int
+	SizeBefore, SizeAfter           int64
+}
+
+func (s *GCStats) Reclaimed() int64 {
+	return s.SizeBefore - s.SizeAfter
+}
+
+// Apply returns the revisions (sorted by date) to keep among the given revisions sorted by date
+func (p RetentionPolicy) Apply(revs []*Rev) []*Rev {
+	if len(revs) == 0 {
+		return revs
+	}
+	keep := make(map[*Rev]bool)
+	keep[revs[len(revs)-1]] = true
+
+	var lastDay, lastWeek string
+	daily, weekly := p.KeepDaily, p.KeepWeekly
+	for i := len(revs) - 1; i >= 0; i-- {
+		rev := revs[i]
+		day := rev.Date.Format("2006-01-02")
+		year, w := rev.Date.ISOWeek()
+		week := fmt.Sprintf("%d-%d", year, w)
+		if day != lastDay && daily > 0 {
+			keep[rev] = true
+			daily--
+		}
+		if week != lastWeek && weekly > 0 {
+			keep[rev] = true
+			weekly--
+		}
+		lastDay, lastWeek = day, week
+	}
+
+	var kept []*Rev
+	for _, rev := range revs {
+		if keep[rev] {
+			kept = append(kept, rev)
+		}
+	}
+	return kept
+}
+
+// ErrSharedHistory is returned when compacting a sync history shared with a remote
+var ErrSharedHistory = errors.New("sync history is shared with a remote: gc only compacts local histories")
+
+// GC rewrites the sync history keeping only the revisions of the retention policy,
+// then deletes the loose objects no longer reachable.
+// GC is local only: the remote is never rewritten and a shared history would be merged back
+// on next pull/push, so it returns ErrSharedHistory once the history has been pulled or pushed.
+// Packed objects only come from remotes, so a local history has only loose objects to reclaim.
+func (r *gitRepo) GC(policy RetentionPolicy) (*GCStats, error) {
+	stats := &GCStats{}
+	var err error
+	if _, err = r.repo.Reference(plumbing.ReferenceName(sharedRemoteRef), false); err == nil {
+		return stats, ErrSharedHistory
+	} else if err != plumbing.ErrReferenceNotFound {
+		return stats, err
+	}
+	gitDir := filepath.Join(r.basedir, ".git")
+	if stats.SizeBefore, err = dirSize(gitDir); err != nil {
+		return stats, err
+	}
+	stats.SizeAfter = stats.SizeBefore
+
+	head, err := r.head()
+	if err != nil || head.IsZero() {
+		return stats, err
+	}
+
+	ancestors, err := r.ancestors(head)
+	if err != nil {
+		return stats, err
+	}
+	var revs []*Rev
+	for h := range ancestors {
+		commit, err := r.repo.CommitObject(h)
+		if err != nil {
+			return stats, err
+		}
+		revs = append(revs, &Rev{Id: h.String(), Date: commit.Committer.When})
+	}
+	sort.Slice(revs, func(i, j int) bool { return revs[i].Date.Before(revs[j].Date) })
+	stats.RevisionsBefore = len(revs)
+
+	kept := policy.Apply(revs)
+	stats.RevisionsAfter = len(kept)
+
+	newHead := plumbing.ZeroHash
+	for _, rev := range kept {
+		commit, err := r.repo.CommitObject(plumbing.NewHash(rev.Id))
+		if err != nil {
+			return stats, err
+		}
+		rewritten := &object.Commit{
+			Author:    commit.Author,
+			Committer: commit.Committer,
+			Message:   commit.Message,
+			TreeHash:  commit.TreeHash,
+		}
+		if !newHead.IsZero() {
+			rewritten.ParentHashes = []plumbing.Hash{newHead}
+		}
+		obj := r.repo.Storer.NewEncodedObject()
+		if err := rewritten.Encode(obj); err != nil {
+			return stats, err
+		}
+		if newHead, err = r.repo.Storer.SetEncodedObject(obj); err != nil {
+			return stats, err
+		}
+	}
+
+	if err = r.repo.Storer.SetReference(plumbing.NewHashReference(plumbing.Master, newHead)); err != nil {
+		return stats, err
+	}
+
+	if err = r.pruneLooseObjects(newHead); err != nil {
+		return stats, err
+	}
+
+	stats.SizeAfter, err = dirSize(gitDir)
+	return stats, err
+}
+
+func (r *gitRepo) pruneLooseObjects(head plumbing.Hash) error {
+	reachable := make(map[plumbing.Hash]bool)
+	ancestors, err := r.ancestors(head)
+	if err != nil {
+		return err
+	}
+	for h := range ancestors {
+		reachable[h] = true
+		commit, err := r.repo.CommitObject(h)
+		if err != nil {
+			return err
+		}
+		tree, err := commit.Tree()
+		if err != nil {
+			return err
+		}
+		reachable[tree.Hash] = true
+		walker := object.NewTreeWalker(tree, true)
+		for {
+			_, entry, err := walker.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				walker.Close()
+				return err
+			}
+			reachable[entry.Hash] = true
+		}
+		walker.Close()
+	}
+
+	objectsDir := filepath.Join(r.basedir, ".git", "objects")
+	dirs, err := filepath.Glob(filepath.Join(objectsDir, "[0-9a-f][0-9a-f]"))
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		files, err := filepath.Glob(filepath.Join(dir, "*"))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if hash := plumbing.NewHash(filepath.Base(dir) + filepath.Base(file)); !reachable[hash] {
+				if err := os.Remove(file); err != nil {
+					return err
+				}
+			}
+		}
+		os.Remove(dir) // only when empty
+	}
+	return nil
+}
+
+func dirSize(dir string) (size int64, err error) {
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return
+}
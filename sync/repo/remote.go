@@ -0,0 +1,545 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	git "gopkg.in/src-d/go-git.v4"
+	gitconfig "gopkg.in/src-d/go-git.v4/config"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
+)
+
+const (
+	sharedRemoteName = "shared"
+	sharedRemoteRef  = "refs/remotes/shared/master"
+)
+
+var ErrObjectNotFound = errors.New("object not found")
+
+// Remote is a shared store where synced revisions are pushed to and pulled from
+type Remote interface {
+	// fetch brings the remote objects in the local repo and returns the remote head (zero hash when empty)
+	fetch(r *gitRepo) (plumbing.Hash, error)
+	// push uploads the local objects and sets the remote head to the given commit
+	push(r *gitRepo, head plumbing.Hash) error
+	String() string
+}
+
+// ObjectStore is a flat key/value store (ex: a directory, a S3 bucket) holding a copy of the sync repo objects
+type ObjectStore interface {
+	List(prefix string) ([]string, error)
+	Get(key string) ([]byte, error)
+	Put(key string, content []byte) error
+	String() string
+}
+
+// Pull brings the revisions of the remote in the local repo.
+// When local and remote histories diverged, they are merged file by file:
+// when a file changed on both sides, the one of the latest revision is kept.
+func (r *gitRepo) Pull(remote Remote) error {
+	remoteHead, err := remote.fetch(r)
+	if err != nil {
+		return fmt.Errorf("pull from %s: %s", remote, err)
+	}
+	if remoteHead.IsZero() {
+		return nil
+	}
+	if err = r.reopen(); err != nil {
+		return err
+	}
+	if err = r.reconcile(remoteHead); err != nil {
+		return fmt.Errorf("pull from %s: %s", remote, err)
+	}
+	return nil
+}
+
+// Push sends the local revisions to the remote, pulling and merging remote revisions first
+func (r *gitRepo) Push(remote Remote) error {
+	if err := r.Pull(remote); err != nil {
+		return err
+	}
+	head, err := r.head()
+	if err != nil || head.IsZero() {
+		return err
+	}
+	if err = remote.push(r, head); err != nil {
+		return fmt.Errorf("push to %s: %s", remote, err)
+	}
+	return r.repo.Storer.SetReference(plumbing.NewHashReference(plumbing.ReferenceName(sharedRemoteRef), head))
+}
+
+func (r *gitRepo) head() (plumbing.Hash, error) {
+	ref, err := r.repo.Reference(plumbing.Master, true)
+	if err == plumbing.ErrReferenceNotFound {
+		return plumbing.ZeroHash, nil
+	}
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return ref.Hash(), nil
+}
+
+// Objects may have been added behind the back of the opened repo (ex: new packfiles)
+func (r *gitRepo) reopen() error {
+	repo, err := git.PlainOpen(r.basedir)
+	if err != nil {
+		return err
+	}
+	r.repo = repo
+	return nil
+}
+
+func (r *gitRepo) reconcile(remoteHead plumbing.Hash) error {
+	localHead, err := r.head()
+	if err != nil {
+		return err
+	}
+	if localHead == remoteHead {
+		return nil
+	}
+
+	if localHead.IsZero() {
+		return r.fastForward(remoteHead)
+	}
+
+	remoteAncestors, err := r.ancestors(remoteHead)
+	if err != nil {
+		return err
+	}
+	if remoteAncestors[localHead] {
+		return r.fastForward(remoteHead)
+	}
+	localAncestors, err := r.ancestors(localHead)
+	if err != nil {
+		return err
+	}
+	if localAncestors[remoteHead] {
+		return nil
+	}
+
+	return r.merge(localHead, remoteHead, r.mergeBase(localHead, remoteAncestors))
+}
+
+func (r *gitRepo) fastForward(to plumbing.Hash) error {
+	if err := r.repo.Storer.SetReference(plumbing.NewHashReference(plumbing.Master, to)); err != nil {
+		return err
+	}
+	wt, err := r.repo.Worktree()
+	if err != nil {
+		return err
+	}
+	return wt.Reset(&git.ResetOptions{Commit: to, Mode: git.HardReset})
+}
+
+func (r *gitRepo) ancestors(from plumbing.Hash) (map[plumbing.Hash]bool, error) {
+	all := make(map[plumbing.Hash]bool)
+	queue := []plumbing.Hash{from}
+	for len(queue) > 0 {
+		h := queue[0]
+		queue = queue[1:]
+		if all[h] {
+			continue
+		}
+		all[h] = true
+		commit, err := r.repo.CommitObject(h)
+		if err != nil {
+			return all, err
+		}
+		queue = append(queue, commit.ParentHashes...)
+	}
+	return all, nil
+}
+
+// Closest ancestor of 'from' (breadth first) also ancestor of the other side. Zero hash for unrelated histories.
+func (r *gitRepo) mergeBase(from plumbing.Hash, otherAncestors map[plumbing.Hash]bool) plumbing.Hash {
+	visited := make(map[plumbing.Hash]bool)
+	queue := []plumbing.Hash{from}
+	for len(queue) > 0 {
+		h := queue[0]
+		queue = queue[1:]
+		if visited[h] {
+			continue
+		}
+		visited[h] = true
+		if otherAncestors[h] {
+			return h
+		}
+		if commit, err := r.repo.CommitObject(h); err == nil {
+			queue = append(queue, commit.ParentHashes...)
+		}
+	}
+	return plumbing.ZeroHash
+}
+
+func (r *gitRepo) merge(localHead, remoteHead, base plumbing.Hash) error {
+	local, err := r.repo.CommitObject(localHead)
+	if err != nil {
+		return err
+	}
+	remote, err := r.repo.CommitObject(remoteHead)
+	if err != nil {
+		return err
+	}
+	localFiles, err := blobsOf(local)
+	if err != nil {
+		return err
+	}
+	remoteFiles, err := blobsOf(remote)
+	if err != nil {
+		return err
+	}
+	baseFiles := make(map[string]plumbing.Hash)
+	if !base.IsZero() {
+		baseCommit, err := r.repo.CommitObject(base)
+		if err != nil {
+			return err
+		}
+		if baseFiles, err = blobsOf(baseCommit); err != nil {
+			return err
+		}
+	}
+
+	remoteIsLatest := remote.Committer.When.After(local.Committer.When)
+
+	names := make(map[string]bool)
+	for name := range localFiles {
+		names[name] = true
+	}
+	for name := range remoteFiles {
+		names[name] = true
+	}
+
+	wt, err := r.repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	var merged []string
+	for name := range names {
+		l, rem, b := localFiles[name], remoteFiles[name], baseFiles[name]
+		takeRemote := l != rem && (l == b || (rem != b && remoteIsLatest))
+		if !takeRemote {
+			continue
+		}
+		merged = append(merged, name)
+		if rem.IsZero() {
+			if _, err := wt.Remove(name); err != nil {
+				return err
+			}
+			continue
+		}
+		file, err := remote.File(name)
+		if err != nil {
+			return err
+		}
+		contents, err := file.Contents()
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(r.basedir, name)
+		os.MkdirAll(filepath.Dir(path), 0700)
+		if err := ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+			return err
+		}
+		if _, err := wt.Add(name); err != nil {
+			return err
+		}
+	}
+
+	msg := fmt.Sprintf("merging shared revision %s", remoteHead.String()[:7])
+	if len(merged) > 0 {
+		msg = fmt.Sprintf("%s: %s", msg, strings.Join(merged, ", "))
+	}
+	committer := &object.Signature{Name: "awlessCLI", When: time.Now(), Email: "git@awless.io"}
+
+	_, err = wt.Commit(msg, &git.CommitOptions{Author: committer, Parents: []plumbing.Hash{localHead, remoteHead}})
+	return err
+}
+
+func blobsOf(commit *object.Commit) (map[string]plumbing.Hash, error) {
+	blobs := make(map[string]plumbing.Hash)
+	files, err := commit.Files()
+	if err != nil {
+		return blobs, err
+	}
+	err = files.ForEach(func(f *object.File) error {
+		blobs[f.Name] = f.Hash
+		return nil
+	})
+	return blobs, err
+}
+
+type gitRemote struct {
+	url string
+}
+
+// NewGitRemote returns a remote for the git repository at given url (ssh, https, git or file)
+func NewGitRemote(url string) Remote {
+	return &gitRemote{url: url}
+}
+
+func (g *gitRemote) String() string {
+	return g.url
+}
+
+func (g *gitRemote) fetch(r *gitRepo) (plumbing.Hash, error) {
+	if err := g.ensureConfigured(r); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	err := r.repo.Fetch(&git.FetchOptions{RemoteName: sharedRemoteName})
+	switch err {
+	case nil, git.NoErrAlreadyUpToDate:
+	case transport.ErrEmptyRemoteRepository:
+		return plumbing.ZeroHash, nil
+	default:
+		return plumbing.ZeroHash, err
+	}
+	ref, err := r.repo.Reference(plumbing.ReferenceName(sharedRemoteRef), true)
+	if err == plumbing.ErrReferenceNotFound {
+		return plumbing.ZeroHash, nil
+	}
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return ref.Hash(), nil
+}
+
+func (g *gitRemote) push(r *gitRepo, head plumbing.Hash) error {
+	if err := g.ensureConfigured(r); err != nil {
+		return err
+	}
+	err := r.repo.Push(&git.PushOptions{
+		RemoteName: sharedRemoteName,
+		RefSpecs:   []gitconfig.RefSpec{gitconfig.RefSpec(fmt.Sprintf("%s:%s", plumbing.Master, plumbing.Master))},
+	})
+	if err == git.NoErrAlreadyUpToDate {
+		return nil
+	}
+	return err
+}
+
+func (g *gitRemote) ensureConfigured(r *gitRepo) error {
+	existing, err := r.repo.Remote(sharedRemoteName)
+	switch {
+	case err == git.ErrRemoteNotFound:
+	case err != nil:
+		return err
+	case existing.Config().URL == g.url:
+		return nil
+	default:
+		if err = r.repo.DeleteRemote(sharedRemoteName); err != nil {
+			return err
+		}
+	}
+	_, err = r.repo.CreateRemote(&gitconfig.RemoteConfig{
+		Name:  sharedRemoteName,
+		URL:   g.url,
+		Fetch: []gitconfig.RefSpec{gitconfig.RefSpec(fmt.Sprintf("+%s:%s", plumbing.Master, sharedRemoteRef))},
+	})
+	return err
+}
+
+// The object store holds a copy of the git objects files (immutable and content addressed)
+// and the head of the shared history
+type objectStoreRemote struct {
+	store ObjectStore
+}
+
+const objectStoreHeadKey = "refs/heads/master"
+
+// NewObjectStoreRemote returns a remote keeping revisions in the given object store
+func NewObjectStoreRemote(store ObjectStore) Remote {
+	return &objectStoreRemote{store: store}
+}
+
+func (o *objectStoreRemote) String() string {
+	return o.store.String()
+}
+
+func (o *objectStoreRemote) head() (plumbing.Hash, error) {
+	head, err := o.store.Get(objectStoreHeadKey)
+	if err == ErrObjectNotFound {
+		return plumbing.ZeroHash, nil
+	}
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return plumbing.NewHash(strings.TrimSpace(string(head))), nil
+}
+
+func (o *objectStoreRemote) fetch(r *gitRepo) (plumbing.Hash, error) {
+	remoteHead, err := o.head()
+	if err != nil || remoteHead.IsZero() {
+		return plumbing.ZeroHash, err
+	}
+
+	local, err := localObjectKeys(r)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	remote, err := o.store.List("objects/")
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	for _, key := range remote {
+		if local[key] {
+			continue
+		}
+		content, err := o.store.Get(key)
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+		path := filepath.Join(r.basedir, ".git", filepath.FromSlash(key))
+		os.MkdirAll(filepath.Dir(path), 0700)
+		if err := ioutil.WriteFile(path, content, 0400); err != nil {
+			return plumbing.ZeroHash, err
+		}
+	}
+
+	return remoteHead, r.repo.Storer.SetReference(plumbing.NewHashReference(plumbing.ReferenceName(sharedRemoteRef), remoteHead))
+}
+
+func (o *objectStoreRemote) push(r *gitRepo, head plumbing.Hash) error {
+	local, err := localObjectKeys(r)
+	if err != nil {
+		return err
+	}
+	remote, err := o.store.List("objects/")
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]bool)
+	for _, key := range remote {
+		existing[key] = true
+	}
+	for key := range local {
+		if existing[key] {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(r.basedir, ".git", filepath.FromSlash(key)))
+		if err != nil {
+			return err
+		}
+		if err := o.store.Put(key, content); err != nil {
+			return err
+		}
+	}
+
+	// Object stores have no conditional write: check the head merged on pull is still the remote one,
+	// then read back the written head to detect a concurrent push
+	merged := plumbing.ZeroHash
+	ref, err := r.repo.Reference(plumbing.ReferenceName(sharedRemoteRef), true)
+	switch err {
+	case nil:
+		merged = ref.Hash()
+	case plumbing.ErrReferenceNotFound:
+	default:
+		return err
+	}
+	current, err := o.head()
+	if err != nil {
+		return err
+	}
+	if current != merged {
+		return fmt.Errorf("shared head moved to %s since last pull, push again", current)
+	}
+	if err = o.store.Put(objectStoreHeadKey, []byte(head.String()+"\n")); err != nil {
+		return err
+	}
+	if written, err := o.head(); err != nil {
+		return err
+	} else if written != head {
+		return fmt.Errorf("concurrent push overwrote shared head with %s, push again", written)
+	}
+	return nil
+}
+
+func localObjectKeys(r *gitRepo) (map[string]bool, error) {
+	keys := make(map[string]bool)
+	gitDir := filepath.Join(r.basedir, ".git")
+	err := filepath.Walk(filepath.Join(gitDir, "objects"), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(gitDir, path)
+		if err != nil {
+			return err
+		}
+		keys[filepath.ToSlash(rel)] = true
+		return nil
+	})
+	return keys, err
+}
+
+type fsObjectStore struct {
+	dir string
+}
+
+// NewFSObjectStore returns an object store in the given directory (ex: a shared network filesystem)
+func NewFSObjectStore(dir string) ObjectStore {
+	return &fsObjectStore{dir: dir}
+}
+
+func (f *fsObjectStore) String() string {
+	return "fs://" + f.dir
+}
+
+func (f *fsObjectStore) List(prefix string) ([]string, error) {
+	var keys []string
+	err := filepath.Walk(f.dir, func(path string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(f.dir, path)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	return keys, err
+}
+
+func (f *fsObjectStore) Get(key string) ([]byte, error) {
+	content, err := ioutil.ReadFile(filepath.Join(f.dir, filepath.FromSlash(key)))
+	if os.IsNotExist(err) {
+		return nil, ErrObjectNotFound
+	}
+	return content, err
+}
+
+func (f *fsObjectStore) Put(key string, content []byte) error {
+	path := filepath.Join(f.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, content, 0600)
+}
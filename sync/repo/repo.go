@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,17 +40,94 @@ type Rev struct {
 
 	Infra  *graph.Graph
 	Access *graph.Graph
+
+	// Synced graphs indexed by their path in repo (ex: default/eu-west-1/infra.nt)
+	Graphs map[string]*graph.Graph
 }
 
 func (r *Rev) DateString() string {
 	return r.Date.Format("Mon Jan 2 15:04:05")
 }
 
+// Graph returns the graphs of this revision synced for the given profile and region (including global services)
+func (r *Rev) Graph(profile, region string) *graph.Graph {
+	g := graph.NewGraph()
+	for p, gph := range r.Graphs {
+		if dir := path.Dir(p); dir == path.Join(profile, "global") || dir == path.Join(profile, region) {
+			g.AddGraph(gph)
+		}
+	}
+	return g
+}
+
+var revDateLayouts = []string{"2006-01-02", "2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02 15:04:05", time.RFC3339}
+
+// FindRev returns the revision matching the given ref among revisions sorted by date.
+// The ref is either a revision id prefix, a date (ex: 2017-01-18, 2017-01-18 15:04)
+// or a duration ago (ex: 36h, 7d). For dates and durations, the last revision synced
+// before (or on) it is returned.
+func FindRev(revs []*Rev, ref string) (*Rev, error) {
+	if len(ref) >= 4 && strings.Trim(ref, "0123456789abcdef") == "" {
+		var found []*Rev
+		for _, rev := range revs {
+			if strings.HasPrefix(rev.Id, ref) {
+				found = append(found, rev)
+			}
+		}
+		switch len(found) {
+		case 1:
+			return found[0], nil
+		case 0:
+		default:
+			return nil, fmt.Errorf("ambiguous revision '%s': %d revisions found", ref, len(found))
+		}
+	}
+
+	at, err := parseRevDate(ref)
+	if err != nil {
+		return nil, err
+	}
+	var last *Rev
+	for _, rev := range revs {
+		if rev.Date.After(at) {
+			break
+		}
+		last = rev
+	}
+	if last == nil {
+		return nil, fmt.Errorf("no revision synced before %s", at.Format("Mon Jan 2 15:04:05 2006"))
+	}
+	return last, nil
+}
+
+func parseRevDate(s string) (time.Time, error) {
+	if strings.HasSuffix(s, "d") {
+		if days, err := strconv.Atoi(strings.TrimSuffix(s, "d")); err == nil {
+			return time.Now().Add(-time.Duration(days) * 24 * time.Hour), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	for _, layout := range revDateLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			if layout == revDateLayouts[0] {
+				t = t.Add(24*time.Hour - time.Nanosecond)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid revision '%s': expecting a revision id, a date (ex: 2017-01-18, '2017-01-18 15:04') or a duration ago (ex: 36h, 7d)", s)
+}
+
 type Repo interface {
 	Commit(files ...string) error
 	List() ([]*Rev, error)
 	LoadRev(version string) (*Rev, error)
 	BaseDir() string
+	Pull(Remote) error
+	Push(Remote) error
+	GC(RetentionPolicy) (*GCStats, error)
 }
 
 type NullRepo struct{}
@@ -57,6 +136,9 @@ func (NullRepo) Commit(files ...string) error         { return nil }
 func (NullRepo) List() ([]*Rev, error)                { return nil, nil }
 func (NullRepo) LoadRev(version string) (*Rev, error) { return nil, nil }
 func (NullRepo) BaseDir() string                      { return "" }
+func (NullRepo) Pull(Remote) error                    { return nil }
+func (NullRepo) Push(Remote) error                    { return nil }
+func (NullRepo) GC(RetentionPolicy) (*GCStats, error) { return &GCStats{}, nil }
 
 type gitRepo struct {
 	repo    *git.Repository
@@ -97,6 +179,7 @@ func (r *gitRepo) List() ([]*Rev, error) {
 	}
 	defer iter.Close()
 
+	parents := make(map[string][]string)
 	for {
 		commit, err := iter.Next()
 		if err != nil {
@@ -107,13 +190,37 @@ func (r *gitRepo) List() ([]*Rev, error) {
 		}
 
 		all = append(all, &Rev{Id: commit.Hash.String(), Date: commit.Committer.When})
+		for _, p := range commit.ParentHashes {
+			parents[commit.Hash.String()] = append(parents[commit.Hash.String()], p.String())
+		}
 	}
 
-	sort.Slice(all, func(i, j int) bool { return all[i].Date.Before(all[j].Date) })
+	// Revisions within the same second (ex: merges) are ordered after their ancestors
+	generations := make(map[string]int)
+	sort.Slice(all, func(i, j int) bool {
+		if !all[i].Date.Equal(all[j].Date) {
+			return all[i].Date.Before(all[j].Date)
+		}
+		return generation(all[i].Id, parents, generations) < generation(all[j].Id, parents, generations)
+	})
 
 	return all, nil
 }
 
+func generation(id string, parents map[string][]string, memo map[string]int) int {
+	if gen, ok := memo[id]; ok {
+		return gen
+	}
+	var gen int
+	for _, p := range parents[id] {
+		if g := generation(p, parents, memo) + 1; g > gen {
+			gen = g
+		}
+	}
+	memo[id] = gen
+	return gen
+}
+
 func reduceToLastRevOfEachDay(revs []*Rev) []*Rev {
 	perDay := make(map[string][]*Rev)
 
@@ -151,7 +258,28 @@ func (r *gitRepo) LoadRev(version string) (*Rev, error) {
 		return rev, err
 	}
 
-	return rev, nil
+	rev.Graphs = make(map[string]*graph.Graph)
+	files, err := commit.Files()
+	if err != nil {
+		return rev, err
+	}
+	err = files.ForEach(func(f *object.File) error {
+		if path.Ext(f.Name) != ".nt" {
+			return nil
+		}
+		contents, err := f.Contents()
+		if err != nil {
+			return err
+		}
+		g := graph.NewGraph()
+		if err := g.Unmarshal([]byte(contents)); err != nil {
+			return fmt.Errorf("loading %s: %s", f.Name, err)
+		}
+		rev.Graphs[f.Name] = g
+		return nil
+	})
+
+	return rev, err
 }
 
 func unmarshalIntoGraph(g *graph.Graph, commit *object.Commit, filename string) error {
@@ -17,9 +17,20 @@ limitations under the License.
 package repo
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"sort"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	git "gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
 )
 
 func TestReduceToLastRevOfEachDay(t *testing.T) {
@@ -50,6 +61,483 @@ func TestReduceToLastRevOfEachDay(t *testing.T) {
 	}
 }
 
+func TestFindRev(t *testing.T) {
+	revs := []*Rev{
+		{Id: "a1b2c3d4e5", Date: mustParseLocal("2017-01-17 10:05")},
+		{Id: "a1b2ffffff", Date: mustParseLocal("2017-01-17 21:05")},
+		{Id: "0c1d2e3f4a", Date: mustParseLocal("2017-01-18 15:05")},
+	}
+
+	tcases := []struct {
+		ref, expect string
+	}{
+		{ref: "a1b2c3", expect: "a1b2c3d4e5"},
+		{ref: "0c1d", expect: "0c1d2e3f4a"},
+		{ref: "2017-01-17", expect: "a1b2ffffff"},
+		{ref: "2017-01-17 12:00", expect: "a1b2c3d4e5"},
+		{ref: "2017-01-20", expect: "0c1d2e3f4a"},
+		{ref: "1h", expect: "0c1d2e3f4a"},
+	}
+	for _, tc := range tcases {
+		rev, err := FindRev(revs, tc.ref)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.ref, err)
+		}
+		if got, want := rev.Id, tc.expect; got != want {
+			t.Fatalf("%s: got %s, want %s", tc.ref, got, want)
+		}
+	}
+
+	for _, ref := range []string{"a1b2", "2017-01-16", "yesterday"} {
+		if _, err := FindRev(revs, ref); err == nil {
+			t.Fatalf("%s: expected error", ref)
+		}
+	}
+}
+
+func TestLoadRevGraphs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r, err := newGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeGraph := func(relPath string, res *graph.Resource) {
+		g := graph.NewGraph()
+		g.AddResource(res)
+		os.MkdirAll(filepath.Join(dir, filepath.Dir(relPath)), 0700)
+		if err := ioutil.WriteFile(filepath.Join(dir, relPath), []byte(g.MustMarshal()), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeGraph("default/eu-west-1/infra.nt", resourcetest.Instance("inst_1").Build())
+	writeGraph("default/us-east-1/infra.nt", resourcetest.Instance("inst_2").Build())
+	writeGraph("default/global/access.nt", resourcetest.User("user_1").Build())
+	if err = r.Commit("default/eu-west-1/infra.nt", "default/us-east-1/infra.nt", "default/global/access.nt"); err != nil {
+		t.Fatal(err)
+	}
+
+	revs, err := r.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(revs), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	rev, err := r.LoadRev(revs[0].Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(rev.Graphs), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	g := rev.Graph("default", "eu-west-1")
+	all, err := g.GetAllResources("instance", "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, res := range all {
+		ids = append(ids, res.Id())
+	}
+	sort.Strings(ids)
+	if got, want := len(ids), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if ids[0] != "inst_1" || ids[1] != "user_1" {
+		t.Fatalf("got %v", ids)
+	}
+}
+
+func TestPushPullWithObjectStore(t *testing.T) {
+	var dirs []string
+	tempDir := func() string {
+		dir, err := ioutil.TempDir("", "awless-repo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		dirs = append(dirs, dir)
+		return dir
+	}
+	defer func() {
+		for _, dir := range dirs {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	remote := NewObjectStoreRemote(NewFSObjectStore(tempDir()))
+	alice, err := newGitRepo(tempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, err := newGitRepo(tempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commitGraph := func(r Repo, relPath string, res *graph.Resource) {
+		g := graph.NewGraph()
+		g.AddResource(res)
+		os.MkdirAll(filepath.Join(r.BaseDir(), filepath.Dir(relPath)), 0700)
+		if err := ioutil.WriteFile(filepath.Join(r.BaseDir(), relPath), []byte(g.MustMarshal()), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := r.Commit(relPath); err != nil {
+			t.Fatal(err)
+		}
+	}
+	instancesIn := func(r Repo, relPath string) []string {
+		g := graph.NewGraph()
+		content, err := ioutil.ReadFile(filepath.Join(r.BaseDir(), relPath))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = g.Unmarshal(content); err != nil {
+			t.Fatal(err)
+		}
+		all, err := g.GetAllResources("instance")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, res := range all {
+			ids = append(ids, res.Id())
+		}
+		return ids
+	}
+
+	if err = bob.Pull(remote); err != nil {
+		t.Fatal(err)
+	}
+
+	commitGraph(alice, "default/eu-west-1/infra.nt", resourcetest.Instance("inst_1").Build())
+	if err = alice.Push(remote); err != nil {
+		t.Fatal(err)
+	}
+	if err = bob.Pull(remote); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := instancesIn(bob, "default/eu-west-1/infra.nt"), []string{"inst_1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	commitGraph(alice, "default/eu-west-1/infra.nt", resourcetest.Instance("inst_2").Build())
+	time.Sleep(1100 * time.Millisecond) // revision dates have a one second resolution
+	commitGraph(bob, "default/eu-west-1/infra.nt", resourcetest.Instance("inst_3").Build())
+	commitGraph(bob, "default/global/access.nt", resourcetest.User("user_1").Build())
+	if err = bob.Push(remote); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = alice.Push(remote); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := instancesIn(alice, "default/eu-west-1/infra.nt"), []string{"inst_3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("latest revision should win: got %v, want %v", got, want)
+	}
+	if _, err = os.Stat(filepath.Join(alice.BaseDir(), "default/global/access.nt")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = bob.Pull(remote); err != nil {
+		t.Fatal(err)
+	}
+	revs, err := bob.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(revs), 5; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	rev, err := bob.LoadRev(revs[len(revs)-1].Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(rev.Graphs), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+type racingObjectStore struct {
+	ObjectStore
+	concurrentHead string
+}
+
+func (s *racingObjectStore) Put(key string, content []byte) error {
+	if err := s.ObjectStore.Put(key, content); err != nil {
+		return err
+	}
+	if key == objectStoreHeadKey && s.concurrentHead != "" {
+		return s.ObjectStore.Put(key, []byte(s.concurrentHead+"\n"))
+	}
+	return nil
+}
+
+func TestPushDetectsConcurrentHeadUpdates(t *testing.T) {
+	var dirs []string
+	tempDir := func() string {
+		dir, err := ioutil.TempDir("", "awless-repo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		dirs = append(dirs, dir)
+		return dir
+	}
+	defer func() {
+		for _, dir := range dirs {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	store := &racingObjectStore{ObjectStore: NewFSObjectStore(tempDir())}
+	remote := NewObjectStoreRemote(store)
+	alice, err := newGitRepo(tempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, err := newGitRepo(tempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitGraph := func(r Repo, res *graph.Resource) {
+		g := graph.NewGraph()
+		g.AddResource(res)
+		if err := ioutil.WriteFile(filepath.Join(r.BaseDir(), "infra.nt"), []byte(g.MustMarshal()), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := r.Commit("infra.nt"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	commitGraph(alice, resourcetest.Instance("inst_1").Build())
+	if err = alice.Push(remote); err != nil {
+		t.Fatal(err)
+	}
+	if err = bob.Pull(remote); err != nil {
+		t.Fatal(err)
+	}
+
+	commitGraph(bob, resourcetest.Instance("inst_2").Build())
+	if err = bob.Push(remote); err != nil {
+		t.Fatal(err)
+	}
+	commitGraph(alice, resourcetest.Instance("inst_3").Build())
+	aliceHead, err := alice.(*gitRepo).head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = remote.push(alice.(*gitRepo), aliceHead)
+	if err == nil || !strings.Contains(err.Error(), "since last pull") {
+		t.Fatalf("expected stale head error, got %v", err)
+	}
+
+	bobHead, err := bob.(*gitRepo).head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.concurrentHead = bobHead.String()
+	if err = alice.Push(remote); err == nil || !strings.Contains(err.Error(), "concurrent push") {
+		t.Fatalf("expected concurrent push error, got %v", err)
+	}
+
+	store.concurrentHead = ""
+	if err = alice.Push(remote); err != nil {
+		t.Fatal(err)
+	}
+	if err = bob.Pull(remote); err != nil {
+		t.Fatal(err)
+	}
+	aliceHead, err = alice.(*gitRepo).head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bobHead, err = bob.(*gitRepo).head(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := bobHead, aliceHead; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestRetentionPolicy(t *testing.T) {
+	revs := []*Rev{
+		{Id: "1", Date: mustParse("2017-01-02 10:00")}, // week 1
+		{Id: "2", Date: mustParse("2017-01-04 10:00")}, // week 1
+		{Id: "3", Date: mustParse("2017-01-09 10:00")}, // week 2
+		{Id: "4", Date: mustParse("2017-01-10 10:00")}, // week 2
+		{Id: "5", Date: mustParse("2017-01-16 09:00")}, // week 3
+		{Id: "6", Date: mustParse("2017-01-16 10:00")}, // week 3
+		{Id: "7", Date: mustParse("2017-01-17 08:00")}, // week 3
+		{Id: "8", Date: mustParse("2017-01-17 10:00")}, // week 3
+	}
+
+	tcases := []struct {
+		policy RetentionPolicy
+		expect []string
+	}{
+		{policy: RetentionPolicy{}, expect: []string{"8"}},
+		{policy: RetentionPolicy{KeepDaily: 2}, expect: []string{"6", "8"}},
+		{policy: RetentionPolicy{KeepWeekly: 2}, expect: []string{"4", "8"}},
+		{policy: RetentionPolicy{KeepDaily: 2, KeepWeekly: 3}, expect: []string{"2", "4", "6", "8"}},
+		{policy: RetentionPolicy{KeepDaily: 100}, expect: []string{"1", "2", "3", "4", "6", "8"}},
+	}
+	for i, tc := range tcases {
+		var ids []string
+		for _, rev := range tc.policy.Apply(revs) {
+			ids = append(ids, rev.Id)
+		}
+		if got, want := ids, tc.expect; !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: got %v, want %v", i+1, got, want)
+		}
+	}
+}
+
+func TestGC(t *testing.T) {
+	dir, err := ioutil.TempDir("", "awless-repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r, err := newGitRepo(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := r.(*gitRepo).repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, date := range []string{"2017-01-02 10:00", "2017-01-02 11:00", "2017-01-03 10:00", "2017-01-03 11:00"} {
+		g := graph.NewGraph()
+		g.AddResource(resourcetest.Instance(fmt.Sprintf("inst_%d", i)).Build())
+		if err := ioutil.WriteFile(filepath.Join(dir, "infra.nt"), []byte(g.MustMarshal()), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := wt.Add("infra.nt"); err != nil {
+			t.Fatal(err)
+		}
+		sig := &object.Signature{Name: "awlessCLI", Email: "git@awless.io", When: mustParse(date)}
+		if _, err := wt.Commit("syncing", &git.CommitOptions{Author: sig}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := r.GC(RetentionPolicy{KeepDaily: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := stats.RevisionsBefore, 4; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := stats.RevisionsAfter, 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if stats.Reclaimed() <= 0 {
+		t.Fatalf("expected reclaimed space, got %d", stats.Reclaimed())
+	}
+
+	revs, err := r.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(revs), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	rev, err := r.LoadRev(revs[0].Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rev.Date, mustParse("2017-01-03 11:00"); !got.Equal(want) {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if _, err := rev.Graphs["infra.nt"].GetResource("instance", "inst_3"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGCRefusesSharedHistory(t *testing.T) {
+	var dirs []string
+	tempDir := func() string {
+		dir, err := ioutil.TempDir("", "awless-repo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		dirs = append(dirs, dir)
+		return dir
+	}
+	defer func() {
+		for _, dir := range dirs {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	remote := NewObjectStoreRemote(NewFSObjectStore(tempDir()))
+	alice, err := newGitRepo(tempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, err := newGitRepo(tempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"inst_1", "inst_2"} {
+		g := graph.NewGraph()
+		g.AddResource(resourcetest.Instance(id).Build())
+		if err := ioutil.WriteFile(filepath.Join(alice.BaseDir(), "infra.nt"), []byte(g.MustMarshal()), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := alice.Commit("infra.nt"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err = alice.GC(RetentionPolicy{}); err != nil {
+		t.Fatalf("local history: %s", err)
+	}
+
+	if err = alice.Push(remote); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = alice.GC(RetentionPolicy{}); err != ErrSharedHistory {
+		t.Fatalf("after push: got %v, want %v", err, ErrSharedHistory)
+	}
+
+	if err = bob.Pull(remote); err != nil {
+		t.Fatal(err)
+	}
+	before, err := bob.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = bob.GC(RetentionPolicy{}); err != ErrSharedHistory {
+		t.Fatalf("after pull: got %v, want %v", err, ErrSharedHistory)
+	}
+	after, err := bob.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(after), len(before); got != want {
+		t.Fatalf("history should be untouched: got %d, want %d", got, want)
+	}
+}
+
+func mustParseLocal(s string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04", s, time.Local)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
 func mustParse(s string) time.Time {
 	layout := "2006-01-02 15:04"
 	t, err := time.Parse(layout, s)
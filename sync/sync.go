@@ -14,11 +14,13 @@ limitations under the License.
 package sync
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	gosync "sync"
 	"time"
@@ -26,18 +28,25 @@ import (
 	"runtime"
 
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/fetch"
 	"github.com/wallix/awless/graph"
 	"github.com/wallix/awless/logger"
 	"github.com/wallix/awless/sync/repo"
 )
 
-const fileExt = ".nt"
+const (
+	fileExt    = ".nt"
+	partialExt = ".partial"
+)
 
 var DefaultSyncer Syncer
 
 type Syncer interface {
 	repo.Repo
 	Sync(...cloud.Service) (map[string]cloud.GraphAPI, error)
+	SyncTypes(map[cloud.Service][]string) (map[string]cloud.GraphAPI, error)
+	SyncPairs(map[string][]cloud.Service, int) (map[string]map[string]cloud.GraphAPI, map[string]error, error)
 }
 
 type noopsyncer struct {
@@ -50,6 +59,14 @@ func (s *noopsyncer) Sync(services ...cloud.Service) (map[string]cloud.GraphAPI,
 	return map[string]cloud.GraphAPI{}, nil
 }
 
+func (s *noopsyncer) SyncTypes(map[cloud.Service][]string) (map[string]cloud.GraphAPI, error) {
+	return map[string]cloud.GraphAPI{}, nil
+}
+
+func (s *noopsyncer) SyncPairs(map[string][]cloud.Service, int) (map[string]map[string]cloud.GraphAPI, map[string]error, error) {
+	return map[string]map[string]cloud.GraphAPI{}, map[string]error{}, nil
+}
+
 type syncer struct {
 	repo.Repo
 	logger *logger.Logger
@@ -72,14 +89,82 @@ func NewSyncer(l ...*logger.Logger) Syncer {
 	return s
 }
 
+// Sync fetches the services and commits their graphs. Resources fetched as a stream (ex: s3 objects, records)
+// are written to the service files as they arrive and are not held in the returned graphs:
+// their count is available through the graphs freshness.
 func (s *syncer) Sync(services ...cloud.Service) (map[string]cloud.GraphAPI, error) {
+	unlock, err := Lock(LockTimeout)
+	if err != nil {
+		return map[string]cloud.GraphAPI{}, err
+	}
+	defer unlock()
+
+	fetched, written, allErrors := s.fetchServices(services...)
+
+	allErrors = append(allErrors, s.commit(written)...)
+
+	return graphsByName(fetched), concatErrors(allErrors)
+}
+
+// SyncPairs syncs the services of many profile/region pairs (keyed by "profile/region"),
+// fetching at most maxConcurrent pairs at the same time, and commits once all pairs are written.
+// It returns the fetched graphs and the errors per pair, and any error related to writing and committing.
+func (s *syncer) SyncPairs(pairs map[string][]cloud.Service, maxConcurrent int) (map[string]map[string]cloud.GraphAPI, map[string]error, error) {
+	unlock, err := Lock(LockTimeout)
+	if err != nil {
+		return map[string]map[string]cloud.GraphAPI{}, map[string]error{}, err
+	}
+	defer unlock()
+
+	if maxConcurrent < 1 {
+		maxConcurrent = 1
+	}
+	var workers gosync.WaitGroup
+	var mu gosync.Mutex
+	semaphore := make(chan struct{}, maxConcurrent)
+
+	var allWritten []string
+	graphs := make(map[string]map[string]cloud.GraphAPI)
+	pairErrors := make(map[string]error)
+
+	for pair, services := range pairs {
+		workers.Add(1)
+		go func(pair string, services []cloud.Service) {
+			defer workers.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			start := time.Now()
+			fetched, written, errs := s.fetchServices(services...)
+			s.logger.ExtraVerbosef("sync: fetched %s took %s", pair, time.Since(start))
+
+			mu.Lock()
+			defer mu.Unlock()
+			allWritten = append(allWritten, written...)
+			graphs[pair] = graphsByName(fetched)
+			if err := concatErrors(errs); err != nil {
+				pairErrors[pair] = err
+			}
+		}(pair, services)
+	}
+
+	workers.Wait()
+
+	return graphs, pairErrors, concatErrors(s.commit(allWritten))
+}
+
+// fetchServices fetches the services, streaming their resources to their files,
+// and returns the fetched graphs and the relative paths of the written files
+func (s *syncer) fetchServices(services ...cloud.Service) (map[cloud.Service]cloud.GraphAPI, []string, []error) {
 	var workers gosync.WaitGroup
 
 	type result struct {
-		service cloud.Service
-		gph     cloud.GraphAPI
-		start   time.Time
-		err     error
+		service  cloud.Service
+		gph      cloud.GraphAPI
+		start    time.Time
+		err      error
+		relPath  string
+		writeErr error
 	}
 
 	resultc := make(chan *result, len(services))
@@ -92,9 +177,21 @@ func (s *syncer) Sync(services ...cloud.Service) (map[string]cloud.GraphAPI, err
 		workers.Add(1)
 		go func(srv cloud.Service) {
 			defer workers.Done()
-			start := time.Now()
-			g, err := srv.Fetch(context.Background())
-			resultc <- &result{service: srv, gph: g, start: start, err: err}
+			res := &result{service: srv, start: time.Now()}
+			defer func() { resultc <- res }()
+
+			file, err := s.createServiceFile(srv)
+			if err != nil {
+				res.writeErr = err
+				res.gph, res.err = srv.Fetch(context.Background())
+				return
+			}
+			res.gph, res.err = srv.Fetch(fetch.WithStream(context.Background(), file.WriteResources))
+			if res.gph == nil {
+				file.discard()
+				return
+			}
+			res.relPath, res.writeErr = file.save(res.gph)
 		}(service)
 	}
 
@@ -104,71 +201,229 @@ func (s *syncer) Sync(services ...cloud.Service) (map[string]cloud.GraphAPI, err
 	}()
 
 	var allErrors []error
-	graphs := make(map[string]cloud.GraphAPI)
-	servicesByName := make(map[string]cloud.Service)
-Loop:
-	for {
-		select {
-		case res, ok := <-resultc:
-			if !ok {
-				break Loop
-			}
-			if res.err != nil {
-				allErrors = append(allErrors, fmt.Errorf("syncing %s: %s", res.service.Name(), res.err))
-			} else {
-				s.logger.ExtraVerbosef("sync: fetched %s service took %s", res.service.Name(), time.Since(res.start))
-			}
-			if serv := res.service; serv != nil {
-				servicesByName[serv.Name()] = serv
-				if res.gph != nil {
-					graphs[serv.Name()] = res.gph
-				}
-			}
+	var written []string
+	graphs := make(map[cloud.Service]cloud.GraphAPI)
+	for res := range resultc {
+		if res.err != nil {
+			allErrors = append(allErrors, fmt.Errorf("syncing %s: %s", res.service.Name(), res.err))
+		} else {
+			s.logger.ExtraVerbosef("sync: fetched %s service took %s", res.service.Name(), time.Since(res.start))
+		}
+		if res.writeErr != nil {
+			allErrors = append(allErrors, res.writeErr)
+		}
+		if res.relPath != "" {
+			written = append(written, res.relPath)
+		}
+		if serv := res.service; serv != nil && res.gph != nil {
+			graphs[serv] = res.gph
 		}
 	}
 
-	var filepaths []string
+	return graphs, written, allErrors
+}
 
-	for name, g := range graphs {
-		serviceRegion := servicesByName[name].Region()
-		serviceProfile := servicesByName[name].Profile()
-		serviceDir := filepath.Join(s.BaseDir(), serviceProfile, serviceRegion)
-		os.MkdirAll(serviceDir, 0700)
+// SyncTypes only fetches the given resource types of each service and merges them
+// into the stored graph of the service, replacing only the resources of those types.
+// A service without stored graph yet is fully fetched.
+func (s *syncer) SyncTypes(types map[cloud.Service][]string) (map[string]cloud.GraphAPI, error) {
+	unlock, err := Lock(LockTimeout)
+	if err != nil {
+		return map[string]cloud.GraphAPI{}, err
+	}
+	defer unlock()
 
-		fullpath := filepath.Join(serviceDir, fmt.Sprintf("%s%s", name, fileExt))
-		f, err := os.OpenFile(fullpath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
-		if err != nil {
-			allErrors = append(allErrors, fmt.Errorf("opening %s: %s", fullpath, err))
+	var workers gosync.WaitGroup
+
+	type result struct {
+		service cloud.Service
+		gph     cloud.GraphAPI
+		errs    []error
+	}
+
+	resultc := make(chan *result, len(types))
+
+	for service, typs := range types {
+		if service.IsSyncDisabled() {
+			s.logger.Verbosef("sync: *disabled* for service %s", service.Name())
 			continue
 		}
-		closeFile := func() {
-			if err := f.Close(); err != nil {
-				allErrors = append(allErrors, fmt.Errorf("closing file %s: %s", fullpath, err))
+		workers.Add(1)
+		go func(srv cloud.Service, typs []string) {
+			defer workers.Done()
+			res := &result{service: srv}
+			defer func() { resultc <- res }()
+
+			stored, err := graph.NewGraphFromFiles(s.servicePath(srv))
+			if err != nil {
+				s.logger.ExtraVerbosef("sync: no stored graph for %s service, fetching it fully", srv.Name())
+				if res.gph, err = srv.Fetch(context.Background()); err != nil {
+					res.errs = append(res.errs, fmt.Errorf("syncing %s: %s", srv.Name(), err))
+				}
+				return
 			}
+
+			// fetching by type of a service is done sequentially as types may share the service fetch cache
+			for _, t := range typs {
+				start := time.Now()
+				fresh, err := srv.FetchByType(context.Background(), t)
+				if err != nil {
+					res.errs = append(res.errs, fmt.Errorf("syncing %s %s: %s", srv.Name(), cloud.PluralizeResource(t), err))
+					stored.(*graph.Graph).SetFreshness(&graph.Freshness{ResourceType: t, FetchedAt: time.Now().UTC(), Err: err.Error()})
+					continue
+				}
+				freshGraph, ok := fresh.(*graph.Graph)
+				if !ok {
+					res.errs = append(res.errs, fmt.Errorf("syncing %s %s: unexpected graph type %T", srv.Name(), cloud.PluralizeResource(t), fresh))
+					continue
+				}
+				s.logger.ExtraVerbosef("sync: fetched %s %s took %s", srv.Name(), cloud.PluralizeResource(t), time.Since(start))
+				stored.(*graph.Graph).ReplaceTypes(freshGraph, t)
+			}
+			res.gph = stored
+		}(service, typs)
+	}
+
+	go func() {
+		workers.Wait()
+		close(resultc)
+	}()
+
+	var allErrors []error
+	fetched := make(map[cloud.Service]cloud.GraphAPI)
+	for res := range resultc {
+		allErrors = append(allErrors, res.errs...)
+		if res.gph != nil {
+			fetched[res.service] = res.gph
 		}
-		if err := g.MarshalTo(f); err != nil {
-			allErrors = append(allErrors, fmt.Errorf("marshal to %s: %s", fullpath, err))
-			closeFile()
+	}
+
+	allErrors = append(allErrors, s.writeAndCommit(fetched)...)
+
+	return graphsByName(fetched), concatErrors(allErrors)
+}
+
+// Pull, Push and GC write to the local sync repository, so they are guarded by the sync lock as syncs are
+
+func (s *syncer) Pull(remote repo.Remote) error {
+	return s.locked(func() error { return s.Repo.Pull(remote) })
+}
+
+func (s *syncer) Push(remote repo.Remote) error {
+	return s.locked(func() error { return s.Repo.Push(remote) })
+}
+
+func (s *syncer) GC(policy repo.RetentionPolicy) (stats *repo.GCStats, err error) {
+	err = s.locked(func() error {
+		stats, err = s.Repo.GC(policy)
+		return err
+	})
+	return
+}
+
+func (s *syncer) locked(fn func() error) error {
+	unlock, err := Lock(LockTimeout)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return fn()
+}
+
+func (s *syncer) servicePath(srv cloud.Service) string {
+	return filepath.Join(s.BaseDir(), srv.Profile(), srv.Region(), fmt.Sprintf("%s%s", srv.Name(), fileExt))
+}
+
+func graphsByName(graphs map[cloud.Service]cloud.GraphAPI) map[string]cloud.GraphAPI {
+	byName := make(map[string]cloud.GraphAPI)
+	for srv, g := range graphs {
+		byName[srv.Name()] = g
+	}
+	return byName
+}
+
+func (s *syncer) writeAndCommit(graphs map[cloud.Service]cloud.GraphAPI) (allErrors []error) {
+	var filepaths []string
+
+	for srv, g := range graphs {
+		file, err := s.createServiceFile(srv)
+		if err != nil {
+			allErrors = append(allErrors, err)
 			continue
 		}
-		relPath, err := filepath.Rel(s.BaseDir(), fullpath)
+		relPath, err := file.save(g)
 		if err != nil {
 			allErrors = append(allErrors, err)
-			closeFile()
 			continue
 		}
-
 		filepaths = append(filepaths, relPath)
-		closeFile()
 	}
 
+	return append(allErrors, s.commit(filepaths)...)
+}
+
+func (s *syncer) commit(filepaths []string) (allErrors []error) {
 	if runtime.GOOS != "windows" { // https://github.com/wallix/awless/issues/119
 		if err := s.Commit(filepaths...); err != nil {
 			allErrors = append(allErrors, fmt.Errorf("committing %s: %s", strings.Join(filepaths, ", "), err))
 		}
 	}
+	return
+}
 
-	return graphs, concatErrors(allErrors)
+// serviceFile is written to a temporary file, renamed to the service file once complete,
+// so that an interrupted sync never leaves a truncated service file
+type serviceFile struct {
+	*graph.TripleWriter
+	fullpath, relPath string
+	f                 *os.File
+	buf               *bufio.Writer
+}
+
+func (s *syncer) createServiceFile(srv cloud.Service) (*serviceFile, error) {
+	fullpath := s.servicePath(srv)
+	relPath, err := filepath.Rel(s.BaseDir(), fullpath)
+	if err != nil {
+		return nil, err
+	}
+	os.MkdirAll(filepath.Dir(fullpath), 0700)
+
+	f, err := os.OpenFile(fullpath+partialExt, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %s", fullpath, err)
+	}
+	buf := bufio.NewWriter(f)
+	return &serviceFile{TripleWriter: graph.NewTripleWriter(buf), fullpath: fullpath, relPath: relPath, f: f, buf: buf}, nil
+}
+
+// save appends the graph to the already streamed resources and moves the file in place
+func (sf *serviceFile) save(g cloud.GraphAPI) (string, error) {
+	marshal := g.MarshalTo
+	if gph, ok := g.(*graph.Graph); ok {
+		marshal = gph.MarshalSyncedTo
+	}
+	if err := marshal(sf.buf); err != nil {
+		sf.discard()
+		return "", fmt.Errorf("marshal to %s: %s", sf.fullpath, err)
+	}
+	if err := sf.buf.Flush(); err != nil {
+		sf.discard()
+		return "", fmt.Errorf("marshal to %s: %s", sf.fullpath, err)
+	}
+	if err := sf.f.Close(); err != nil {
+		os.Remove(sf.f.Name())
+		return "", fmt.Errorf("closing file %s: %s", sf.fullpath, err)
+	}
+	if err := os.Rename(sf.f.Name(), sf.fullpath); err != nil {
+		os.Remove(sf.f.Name())
+		return "", err
+	}
+	return sf.relPath, nil
+}
+
+func (sf *serviceFile) discard() {
+	sf.f.Close()
+	os.Remove(sf.f.Name())
 }
 
 func concatErrors(errs []error) error {
@@ -184,9 +439,12 @@ func concatErrors(errs []error) error {
 	return errors.New(strings.Join(lines, "\n"))
 }
 
+// GlobalServices are synced in the "global" directory of a profile, whatever the region
+var GlobalServices = map[string]bool{"access": true, "dns": true, "cdn": true}
+
 func LoadLocalGraphForService(serviceName, profile, region string) cloud.GraphAPI {
 	regionDir := region
-	if serviceName == "access" || serviceName == "dns" || serviceName == "cdn" {
+	if GlobalServices[serviceName] {
 		regionDir = "global"
 	}
 	path := filepath.Join(repo.BaseDir(), profile, regionDir, fmt.Sprintf("%s%s", serviceName, fileExt))
@@ -208,6 +466,69 @@ func LoadLocalGraphs(profile, region string) (cloud.GraphAPI, error) {
 	return graph.NewGraphFromFiles(files...)
 }
 
+// LoadAllRegionsLocalGraphs loads the local graphs of all synced regions of a profile (global services included).
+// Resources without region get the Region property of the region they were synced in.
+func LoadAllRegionsLocalGraphs(profile string) (cloud.GraphAPI, error) {
+	g := graph.NewGraph()
+	dirs, _ := filepath.Glob(filepath.Join(repo.BaseDir(), profile, "*"))
+	for _, dir := range dirs {
+		files, _ := filepath.Glob(filepath.Join(dir, fmt.Sprintf("*%s", fileExt)))
+		if len(files) == 0 {
+			continue
+		}
+		regionGraph, err := graph.NewGraphFromFiles(files...)
+		if err != nil {
+			return g, err
+		}
+		if region := filepath.Base(dir); region != "global" {
+			if err := regionGraph.(*graph.Graph).FillProperty(properties.Region, region); err != nil {
+				return g, err
+			}
+		}
+		g.AddGraph(regionGraph.(*graph.Graph))
+	}
+	return g, nil
+}
+
+// FreshnessStatus is the freshness of a resource type in the local graph of a service synced in a region
+type FreshnessStatus struct {
+	Region, Service string
+	*graph.Freshness
+}
+
+// LoadFreshness returns the freshness of all resource types locally synced for a profile, sorted by region, service and type
+func LoadFreshness(profile string) ([]*FreshnessStatus, error) {
+	var all []*FreshnessStatus
+	files, _ := filepath.Glob(filepath.Join(repo.BaseDir(), profile, "*", fmt.Sprintf("*%s", fileExt)))
+	for _, file := range files {
+		g, err := graph.NewGraphFromFiles(file)
+		if err != nil {
+			return all, err
+		}
+		freshness, err := g.(*graph.Graph).Freshness()
+		if err != nil {
+			return all, fmt.Errorf("%s: %s", file, err)
+		}
+		for _, f := range freshness {
+			all = append(all, &FreshnessStatus{
+				Region:    filepath.Base(filepath.Dir(file)),
+				Service:   strings.TrimSuffix(filepath.Base(file), fileExt),
+				Freshness: f,
+			})
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Region != all[j].Region {
+			return all[i].Region < all[j].Region
+		}
+		if all[i].Service != all[j].Service {
+			return all[i].Service < all[j].Service
+		}
+		return all[i].ResourceType < all[j].ResourceType
+	})
+	return all, nil
+}
+
 func LoadAllLocalGraphs(profile string) (cloud.GraphAPI, error) {
 	path := filepath.Join(repo.BaseDir(), profile, "*", fmt.Sprintf("*%s", fileExt))
 	files, _ := filepath.Glob(path)
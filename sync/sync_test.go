@@ -2,16 +2,20 @@ package sync
 
 import (
 	"context"
+	"errors"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/wallix/awless/cloud"
+	"github.com/wallix/awless/fetch"
 
 	"io/ioutil"
 
 	"path/filepath"
 
 	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
 )
 
 func TestSyncTripleFiles(t *testing.T) {
@@ -65,17 +69,193 @@ func TestSyncTripleFiles(t *testing.T) {
 	}
 }
 
+func TestSyncStreamedResources(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	os.Setenv("__AWLESS_HOME", tmpDir)
+
+	g := graph.NewGraph()
+	g.AddResource(resourcetest.Zone("zone_1").Build())
+	srv := &mockService{
+		g:        g,
+		streamed: []*graph.Resource{resourcetest.Record("rec_1").Build(), resourcetest.Record("rec_2").Build()},
+		name:     "dns",
+		region:   "global",
+		profile:  "default",
+	}
+
+	graphs, err := NewSyncer().Sync(srv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recs, _ := graphs["dns"].Find(cloud.NewQuery("record")); len(recs) != 0 {
+		t.Fatalf("expected streamed records not held in graph, got %d", len(recs))
+	}
+
+	local := LoadLocalGraphForService("dns", "default", "global")
+	if recs, _ := local.Find(cloud.NewQuery("record")); len(recs) != 2 {
+		t.Fatalf("got %d records, want 2", len(recs))
+	}
+	if zones, _ := local.Find(cloud.NewQuery("zone")); len(zones) != 1 {
+		t.Fatalf("got %d zones, want 1", len(zones))
+	}
+	if partials, _ := filepath.Glob(filepath.Join(tmpDir, "aws", "rdf", "default", "global", "*"+partialExt)); len(partials) != 0 {
+		t.Fatalf("unexpected partial files %v", partials)
+	}
+}
+
+func TestSyncTypes(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	os.Setenv("__AWLESS_HOME", tmpDir)
+
+	stored := graph.NewGraph()
+	stored.AddResource(resourcetest.Instance("inst_1").Prop("State", "running").Build(), resourcetest.Subnet("sub_1").Build())
+	fresh := graph.NewGraph()
+	fresh.AddResource(resourcetest.Instance("inst_2").Prop("State", "pending").Build())
+
+	srv := &mockService{
+		g:       stored,
+		byType:  map[string]*graph.Graph{"instance": fresh},
+		name:    "infra",
+		region:  "eu-west-1",
+		profile: "default",
+	}
+
+	syncer := NewSyncer()
+	if _, err := syncer.Sync(srv); err != nil {
+		t.Fatal(err)
+	}
+	graphs, err := syncer.SyncTypes(map[cloud.Service][]string{srv: {"instance"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := srv.fullFetches, 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	for _, g := range []cloud.GraphAPI{graphs["infra"], LoadLocalGraphForService("infra", "default", "eu-west-1")} {
+		instances, err := g.Find(cloud.NewQuery(cloud.Instance))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(instances), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+		if got, want := instances[0].Id(), "inst_2"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		subnets, err := g.Find(cloud.NewQuery(cloud.Subnet))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := len(subnets), 1; got != want {
+			t.Fatalf("got %d, want %d", got, want)
+		}
+	}
+
+	revs, err := syncer.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(revs), 2; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+func TestSyncPairs(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	os.Setenv("__AWLESS_HOME", tmpDir)
+
+	newGraph := func(id string) *graph.Graph {
+		g := graph.NewGraph()
+		g.AddResource(resourcetest.Instance(id).Build())
+		return g
+	}
+	pairs := map[string][]cloud.Service{
+		"admin/eu-west-1": {&mockService{g: newGraph("inst_1"), name: "infra", region: "eu-west-1", profile: "admin"}},
+		"admin/us-east-1": {&mockService{g: newGraph("inst_2"), name: "infra", region: "us-east-1", profile: "admin"}},
+		"dev/eu-west-1":   {&mockService{g: newGraph("inst_3"), name: "infra", region: "eu-west-1", profile: "dev", err: errors.New("access denied")}},
+	}
+
+	syncer := NewSyncer()
+	graphs, pairErrors, err := syncer.SyncPairs(pairs, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(graphs), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := len(pairErrors), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if _, ok := pairErrors["dev/eu-west-1"]; !ok {
+		t.Fatalf("expected error for pair, got %v", pairErrors)
+	}
+
+	revs, err := syncer.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(revs), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+
+	g, err := LoadAllRegionsLocalGraphs("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	instances, err := g.Find(cloud.NewQuery(cloud.Instance))
+	if err != nil {
+		t.Fatal(err)
+	}
+	regions := make(map[string]interface{})
+	for _, inst := range instances {
+		regions[inst.Id()] = inst.Properties()["Region"]
+	}
+	if got, want := regions, map[string]interface{}{"inst_1": "eu-west-1", "inst_2": "us-east-1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
 type mockService struct {
 	name, region, profile string
 	g                     *graph.Graph
+	byType                map[string]*graph.Graph
+	fullFetches           int
+	err                   error
+	streamed              []*graph.Resource
 }
 
-func (s *mockService) Region() string                                { return s.region }
-func (s *mockService) Profile() string                               { return s.profile }
-func (s *mockService) Name() string                                  { return s.name }
-func (s *mockService) ResourceTypes() []string                       { return []string{} }
-func (s *mockService) Fetch(context.Context) (cloud.GraphAPI, error) { return s.g, nil }
-func (s *mockService) IsSyncDisabled() bool                          { return false }
-func (s *mockService) FetchByType(context.Context, string) (cloud.GraphAPI, error) {
-	return nil, nil
+func (s *mockService) Region() string          { return s.region }
+func (s *mockService) Profile() string         { return s.profile }
+func (s *mockService) Name() string            { return s.name }
+func (s *mockService) ResourceTypes() []string { return []string{} }
+func (s *mockService) IsSyncDisabled() bool    { return false }
+func (s *mockService) Fetch(ctx context.Context) (cloud.GraphAPI, error) {
+	s.fullFetches++
+	for _, res := range s.streamed {
+		if streamed, err := fetch.Stream(ctx, res); err != nil {
+			return s.g, err
+		} else if !streamed {
+			s.g.AddResource(res)
+		}
+	}
+	return s.g, s.err
+}
+func (s *mockService) FetchByType(ctx context.Context, t string) (cloud.GraphAPI, error) {
+	if g, ok := s.byType[t]; ok {
+		return g, nil
+	}
+	return graph.NewGraph(), nil
 }
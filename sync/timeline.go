@@ -0,0 +1,151 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/sync/repo"
+)
+
+const (
+	Appeared    = "appeared"
+	Changed     = "changed"
+	Disappeared = "disappeared"
+)
+
+// Properties tracked by default in a resource timeline
+var TimelineProperties = []string{properties.State, properties.Type, properties.SecurityGroups, properties.Tags}
+
+// TimelineEvent is a change of a resource detected between two consecutive sync revisions
+type TimelineEvent struct {
+	Kind     string
+	From, To *repo.Rev
+	Resource *graph.Resource
+	Changes  []*PropertyChange
+}
+
+type PropertyChange struct {
+	Key  string      `json:"key"`
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+func (c *PropertyChange) String() string {
+	format := func(i interface{}) string {
+		if i == nil {
+			return "<none>"
+		}
+		return fmt.Sprint(i)
+	}
+	return fmt.Sprintf("%s: %s → %s", c.Key, format(c.From), format(c.To))
+}
+
+// BuildTimeline walks the given revisions (sorted by date) from the latest one and returns, oldest first, when
+// a resource appeared, disappeared and when its given properties changed (all properties when none given).
+// The resolveId function returns the id of the resource in the graph of a revision, or an empty string:
+// the resource is tracked with the id found in the latest revision, which is returned.
+// The loadFn function returns the graph of a revision, graphs being released once compared to the previous revision.
+// Revisions in which the fetch of the resource type failed are skipped.
+func BuildTimeline(revs []*repo.Rev, loadFn func(*repo.Rev) (*graph.Graph, error), resolveId func(*graph.Graph) string, props ...string) (string, []*TimelineEvent, error) {
+	var id string
+	var events []*TimelineEvent
+	// the resource in the next (more recent) revision in which it is known, if any,
+	// and the types whose fetch failed in this revision
+	var next *graph.Resource
+	var nextRev *repo.Rev
+	var nextFailed map[string]bool
+	// kept until the id is resolved, to look for the resource in the revision after the one resolving it
+	var nextGraph *graph.Graph
+
+	for i := len(revs) - 1; i >= 0; i-- {
+		rev := revs[i]
+		g, err := loadFn(rev)
+		if err != nil {
+			return id, events, fmt.Errorf("timeline: revision %s: %s", rev.Id, err)
+		}
+		failed, err := failedTypes(g)
+		if err != nil {
+			return id, events, fmt.Errorf("timeline: revision %s: %s", rev.Id, err)
+		}
+		if id == "" {
+			if id = resolveId(g); id == "" {
+				nextRev, nextFailed, nextGraph = rev, failed, g
+				continue
+			}
+			if nextGraph != nil {
+				if next, err = findResource(nextGraph, id); err != nil {
+					return id, events, fmt.Errorf("timeline: revision %s: %s", nextRev.Id, err)
+				}
+				nextGraph = nil
+			}
+		}
+
+		current, err := findResource(g, id)
+		if err != nil {
+			return id, events, fmt.Errorf("timeline: revision %s: %s", rev.Id, err)
+		}
+		typ := resourceType(current, next)
+		if failed[typ] {
+			continue
+		}
+		if nextFailed[typ] {
+			nextRev = nil
+		}
+
+		switch {
+		case nextRev == nil:
+		case current == nil && next != nil:
+			events = append(events, &TimelineEvent{Kind: Appeared, From: rev, To: nextRev, Resource: next})
+		case current != nil && next == nil:
+			events = append(events, &TimelineEvent{Kind: Disappeared, From: rev, To: nextRev, Resource: current})
+		case current != nil && next != nil:
+			if changes := propertyChanges(current, next, props...); len(changes) > 0 {
+				events = append(events, &TimelineEvent{Kind: Changed, From: rev, To: nextRev, Resource: next, Changes: changes})
+			}
+		}
+
+		next, nextRev, nextFailed = current, rev, failed
+	}
+	if next != nil {
+		events = append(events, &TimelineEvent{Kind: Appeared, To: nextRev, Resource: next})
+	}
+
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return id, events, nil
+}
+
+func findResource(g *graph.Graph, id string) (*graph.Resource, error) {
+	res, err := g.FindResource(id)
+	if err != nil || res == nil {
+		return nil, err
+	}
+	return g.GetResource(res.Type(), id)
+}
+
+func resourceType(resources ...*graph.Resource) string {
+	for _, res := range resources {
+		if res != nil {
+			return res.Type()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,164 @@
+package sync
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/sync/repo"
+)
+
+func TestBuildTimeline(t *testing.T) {
+	now := time.Now()
+	graphs := map[string]*graph.Graph{
+		"1": graph.NewGraph(),
+		"2": graph.NewGraph(),
+		"3": graph.NewGraph(),
+		"4": graph.NewGraph(),
+		"5": graph.NewGraph(),
+	}
+	graphs["2"].AddResource(resourcetest.Instance("inst_1").Prop("State", "running").Prop("Tags", []string{"Env=prod", "Owner=me"}).Build())
+	graphs["3"].AddResource(resourcetest.Instance("inst_1").Prop("State", "running").Prop("Tags", []string{"Owner=me", "Env=prod"}).Prop("PublicIP", "1.2.3.4").Build())
+	graphs["4"].AddResource(resourcetest.Instance("inst_1").Prop("State", "stopped").Prop("Tags", []string{"Owner=me", "Env=prod"}).Build())
+
+	var revs []*repo.Rev
+	for i := 1; i <= 5; i++ {
+		revs = append(revs, &repo.Rev{Id: fmt.Sprint(i), Date: now.Add(time.Duration(i) * time.Hour)})
+	}
+	loadFn := func(r *repo.Rev) (*graph.Graph, error) { return graphs[r.Id], nil }
+
+	byId := func(*graph.Graph) string { return "inst_1" }
+
+	id, events, err := BuildTimeline(revs, loadFn, byId, TimelineProperties...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := id, "inst_1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := len(events), 3; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := events[0].Kind, Appeared; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := events[0].From.Id, "1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := events[1].Kind, Changed; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := events[1].To.Id, "4"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := len(events[1].Changes), 1; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := events[1].Changes[0].String(), "State: running → stopped"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := events[2].Kind, Disappeared; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	_, events, err = BuildTimeline(revs, loadFn, byId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(events), 4; got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+	if got, want := events[1].Changes[0].String(), "PublicIP: <none> → 1.2.3.4"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestBuildTimelineSkipsFailedFetches(t *testing.T) {
+	now := time.Now()
+	graphs := map[string]*graph.Graph{
+		"1": graph.NewGraph(),
+		"2": graph.NewGraph(),
+		"3": graph.NewGraph(),
+		"4": graph.NewGraph(),
+	}
+	graphs["1"].AddResource(resourcetest.Instance("inst_1").Prop("State", "running").Build())
+	graphs["2"].SetFreshness(&graph.Freshness{ResourceType: "instance", FetchedAt: now, Err: "throttled"})
+	graphs["3"].AddResource(resourcetest.Instance("inst_1").Prop("State", "stopped").Build())
+	graphs["4"].SetFreshness(&graph.Freshness{ResourceType: "instance", FetchedAt: now, Err: "throttled"})
+
+	var revs []*repo.Rev
+	for i := 1; i <= 4; i++ {
+		revs = append(revs, &repo.Rev{Id: fmt.Sprint(i), Date: now.Add(time.Duration(i) * time.Hour)})
+	}
+	loadFn := func(r *repo.Rev) (*graph.Graph, error) { return graphs[r.Id], nil }
+
+	_, events, err := BuildTimeline(revs, loadFn, func(*graph.Graph) string { return "inst_1" }, TimelineProperties...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kinds []string
+	for _, e := range events {
+		kinds = append(kinds, e.Kind)
+	}
+	if got, want := kinds, []string{Appeared, Changed}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if got, want := events[1].From.Id, "1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := events[1].To.Id, "3"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestBuildTimelineResolvesIdFromLatestRevision(t *testing.T) {
+	now := time.Now()
+	graphs := map[string]*graph.Graph{
+		"1": graph.NewGraph(),
+		"2": graph.NewGraph(),
+		"3": graph.NewGraph(),
+	}
+	graphs["1"].AddResource(resourcetest.Instance("inst_1").Prop("Name", "redis").Build())
+	graphs["2"].AddResource(resourcetest.Instance("inst_1").Prop("Name", "cache").Build())
+
+	var revs []*repo.Rev
+	for i := 1; i <= 3; i++ {
+		revs = append(revs, &repo.Rev{Id: fmt.Sprint(i), Date: now.Add(time.Duration(i) * time.Hour)})
+	}
+	var loaded []string
+	loadFn := func(r *repo.Rev) (*graph.Graph, error) {
+		loaded = append(loaded, r.Id)
+		return graphs[r.Id], nil
+	}
+	byName := func(g *graph.Graph) string {
+		resources, _ := g.FindResourcesByProperty("Name", "cache")
+		if len(resources) == 1 {
+			return resources[0].Id()
+		}
+		return ""
+	}
+
+	id, events, err := BuildTimeline(revs, loadFn, byName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := id, "inst_1"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	if got, want := loaded, []string{"3", "2", "1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	var kinds []string
+	for _, e := range events {
+		kinds = append(kinds, e.Kind)
+	}
+	if got, want := kinds, []string{Appeared, Changed, Disappeared}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if events[0].From != nil {
+		t.Fatalf("expected first event to be the first sync, got %v", events[0].From)
+	}
+}
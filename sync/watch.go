@@ -0,0 +1,188 @@
+/*
+Copyright 2017 WALLIX
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wallix/awless/cloud/properties"
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/sync/repo"
+)
+
+// ChangeEvent is a change of a resource detected between two sync revisions (ex: by the sync daemon)
+type ChangeEvent struct {
+	Date     time.Time         `json:"date"`
+	Revision string            `json:"revision"`
+	Profile  string            `json:"profile"`
+	Region   string            `json:"region"`
+	Kind     string            `json:"kind"`
+	Type     string            `json:"type"`
+	Id       string            `json:"id"`
+	Name     string            `json:"name,omitempty"`
+	Changes  []*PropertyChange `json:"changes,omitempty"`
+	Message  string            `json:"message"`
+}
+
+// String describes the event in a line (ex: instance i-123 state running→stopped)
+func (e *ChangeEvent) String() string {
+	ref := fmt.Sprintf("%s %s", e.Type, e.Id)
+	if e.Kind != Changed {
+		return fmt.Sprintf("%s %s", ref, e.Kind)
+	}
+	format := func(i interface{}) string {
+		if i == nil {
+			return "<none>"
+		}
+		return fmt.Sprint(i)
+	}
+	var changes []string
+	for _, c := range e.Changes {
+		changes = append(changes, fmt.Sprintf("%s %s→%s", strings.ToLower(c.Key), format(c.From), format(c.To)))
+	}
+	return fmt.Sprintf("%s %s", ref, strings.Join(changes, ", "))
+}
+
+// DiffRevisions returns the resources of the given types that appeared, disappeared or whose given properties
+// changed (all properties when none given) between two revisions, per synced profile and region.
+// Types whose fetch failed in either revision are skipped, as their resources would wrongly disappear.
+func DiffRevisions(from, to *repo.Rev, types []string, props ...string) ([]*ChangeEvent, error) {
+	paths := make(map[string]bool)
+	for p := range from.Graphs {
+		paths[p] = true
+	}
+	for p := range to.Graphs {
+		paths[p] = true
+	}
+	var sorted []string
+	for p := range paths {
+		sorted = append(sorted, p)
+	}
+	sort.Strings(sorted)
+
+	var events []*ChangeEvent
+	for _, p := range sorted {
+		region := path.Base(path.Dir(p))
+		profile := path.Dir(path.Dir(p))
+		newEvent := func(kind string, res *graph.Resource, changes []*PropertyChange) *ChangeEvent {
+			e := &ChangeEvent{Date: to.Date, Revision: to.Id, Profile: profile, Region: region, Kind: kind, Type: res.Type(), Id: res.Id(), Changes: changes}
+			if name, ok := res.Properties()[properties.Name].(string); ok {
+				e.Name = name
+			}
+			e.Message = e.String()
+			return e
+		}
+
+		fromG, toG := from.Graphs[p], to.Graphs[p]
+		failed, err := failedTypes(fromG, toG)
+		if err != nil {
+			return events, fmt.Errorf("diff: %s: %s", p, err)
+		}
+		var fetched []string
+		for _, typ := range types {
+			if !failed[typ] {
+				fetched = append(fetched, typ)
+			}
+		}
+		if len(fetched) == 0 {
+			continue
+		}
+
+		before, err := resourcesById(fromG, fetched)
+		if err != nil {
+			return events, fmt.Errorf("diff: revision %s: %s: %s", from.Id, p, err)
+		}
+		after, err := resourcesById(toG, fetched)
+		if err != nil {
+			return events, fmt.Errorf("diff: revision %s: %s: %s", to.Id, p, err)
+		}
+
+		for _, res := range sortedResources(after) {
+			if previous, ok := before[res.Id()]; !ok {
+				events = append(events, newEvent(Appeared, res, nil))
+			} else if changes := propertyChanges(previous, res, props...); len(changes) > 0 {
+				events = append(events, newEvent(Changed, res, changes))
+			}
+		}
+		for _, res := range sortedResources(before) {
+			if _, ok := after[res.Id()]; !ok {
+				events = append(events, newEvent(Disappeared, res, nil))
+			}
+		}
+	}
+	return events, nil
+}
+
+// failedTypes returns the resource types whose fetch failed in any of the given graphs
+func failedTypes(graphs ...*graph.Graph) (map[string]bool, error) {
+	failed := make(map[string]bool)
+	for _, g := range graphs {
+		if g == nil {
+			continue
+		}
+		all, err := g.Freshness()
+		if err != nil {
+			return failed, err
+		}
+		for typ, f := range all {
+			if f.Failed() {
+				failed[typ] = true
+			}
+		}
+	}
+	return failed, nil
+}
+
+// propertyChanges returns the changes of the given properties (all properties when none given) between two versions of a resource
+func propertyChanges(from, to *graph.Resource, keys ...string) (changes []*PropertyChange) {
+	for _, k := range graph.DiffProperties(from, to, keys...) {
+		changes = append(changes, &PropertyChange{Key: k, From: from.Properties()[k], To: to.Properties()[k]})
+	}
+	return
+}
+
+func resourcesById(g *graph.Graph, types []string) (map[string]*graph.Resource, error) {
+	byId := make(map[string]*graph.Resource)
+	if g == nil {
+		return byId, nil
+	}
+	resources, err := g.GetAllResources(types...)
+	if err != nil {
+		return byId, err
+	}
+	for _, res := range resources {
+		byId[res.Id()] = res
+	}
+	return byId, nil
+}
+
+func sortedResources(byId map[string]*graph.Resource) (sorted []*graph.Resource) {
+	for _, res := range byId {
+		sorted = append(sorted, res)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Type() != sorted[j].Type() {
+			return sorted[i].Type() < sorted[j].Type()
+		}
+		return sorted[i].Id() < sorted[j].Id()
+	})
+	return
+}
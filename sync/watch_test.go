@@ -0,0 +1,148 @@
+package sync
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wallix/awless/graph"
+	"github.com/wallix/awless/graph/resourcetest"
+	"github.com/wallix/awless/sync/repo"
+)
+
+func TestDiffRevisions(t *testing.T) {
+	before, after := graph.NewGraph(), graph.NewGraph()
+	before.AddResource(
+		resourcetest.Instance("i-123").Prop("Name", "redis").Prop("State", "running").Build(),
+		resourcetest.Instance("i-456").Prop("State", "running").Build(),
+		resourcetest.Subnet("sub_1").Build(),
+	)
+	after.AddResource(
+		resourcetest.Instance("i-123").Prop("Name", "redis").Prop("State", "stopped").Prop("PublicIP", "1.2.3.4").Build(),
+		resourcetest.Subnet("sub_1").Build(),
+		resourcetest.Subnet("sub_2").Build(),
+	)
+	resourcetest.AddParents(before, "eu-west-1 -> sub_1", "sub_1 -> i-123", "sub_1 -> i-456")
+	resourcetest.AddParents(after, "eu-west-1 -> sub_1", "eu-west-1 -> sub_2", "sub_2 -> i-123")
+	global := graph.NewGraph()
+	global.AddResource(resourcetest.User("usr_1").Build())
+
+	from := &repo.Rev{Id: "1", Graphs: map[string]*graph.Graph{"default/eu-west-1/infra.nt": before, "default/global/access.nt": global}}
+	to := &repo.Rev{Id: "2", Date: time.Now(), Graphs: map[string]*graph.Graph{"default/eu-west-1/infra.nt": after, "default/global/access.nt": global}}
+
+	events, err := DiffRevisions(from, to, []string{"instance", "subnet", "user"}, TimelineProperties...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var messages []string
+	for _, e := range events {
+		messages = append(messages, e.Message)
+		if got, want := e.Profile, "default"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+		if got, want := e.Region, "eu-west-1"; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+	expected := []string{"instance i-123 state running→stopped", "subnet sub_2 appeared", "instance i-456 disappeared"}
+	if got, want := len(messages), len(expected); got != want {
+		t.Fatalf("got %d (%v), want %d", got, messages, want)
+	}
+	for i := range expected {
+		if got, want := messages[i], expected[i]; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+	if got, want := events[0].Name, "redis"; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	b, err := json.Marshal(events[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := decoded["kind"], Changed; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+	if got, want := decoded["changes"].([]interface{})[0].(map[string]interface{})["to"], "stopped"; got != want {
+		t.Fatalf("got %v, want %s", got, want)
+	}
+}
+
+func TestDiffRevisionsOfResourcesWithoutRegionParent(t *testing.T) {
+	before, after := graph.NewGraph(), graph.NewGraph()
+	before.AddResource(resourcetest.User("usr_1").Build(), resourcetest.Queue("queue_1").Build())
+	after.AddResource(resourcetest.User("usr_1").Build(), resourcetest.User("usr_2").Prop("Name", "jdoe").Build())
+
+	from := &repo.Rev{Id: "1", Graphs: map[string]*graph.Graph{"default/global/access.nt": before}}
+	to := &repo.Rev{Id: "2", Date: time.Now(), Graphs: map[string]*graph.Graph{"default/global/access.nt": after}}
+
+	events, err := DiffRevisions(from, to, []string{"user", "queue"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var messages []string
+	for _, e := range events {
+		messages = append(messages, e.Message)
+	}
+	if got, want := messages, []string{"user usr_2 appeared", "queue queue_1 disappeared"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestDiffRevisionsSkipsFailedTypes(t *testing.T) {
+	before, after := graph.NewGraph(), graph.NewGraph()
+	before.AddResource(resourcetest.Instance("i-123").Build(), resourcetest.Subnet("sub_1").Build())
+	resourcetest.AddParents(before, "eu-west-1 -> sub_1", "sub_1 -> i-123")
+	before.SetFreshness(&graph.Freshness{ResourceType: "instance", FetchedAt: time.Now()}, &graph.Freshness{ResourceType: "subnet", FetchedAt: time.Now()})
+	after.AddResource(resourcetest.Subnet("sub_2").Build())
+	resourcetest.AddParents(after, "eu-west-1 -> sub_2")
+	after.SetFreshness(&graph.Freshness{ResourceType: "instance", FetchedAt: time.Now(), Err: "throttled"}, &graph.Freshness{ResourceType: "subnet", FetchedAt: time.Now()})
+
+	from := &repo.Rev{Id: "1", Graphs: map[string]*graph.Graph{"default/eu-west-1/infra.nt": before}}
+	to := &repo.Rev{Id: "2", Date: time.Now(), Graphs: map[string]*graph.Graph{"default/eu-west-1/infra.nt": after}}
+
+	events, err := DiffRevisions(from, to, []string{"instance", "subnet"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var messages []string
+	for _, e := range events {
+		messages = append(messages, e.Message)
+	}
+	if got, want := messages, []string{"subnet sub_2 appeared", "subnet sub_1 disappeared"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestLock(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "awlessunittest_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	os.Setenv("__AWLESS_HOME", tmpDir)
+
+	unlock, err := Lock(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Lock(100 * time.Millisecond); err == nil {
+		t.Fatal("expected error while locked")
+	}
+	if err := unlock(); err != nil {
+		t.Fatal(err)
+	}
+	unlock, err = Lock(100 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/wallix/awless/template/env"
 	"github.com/wallix/awless/template/internal/ast"
@@ -18,12 +19,14 @@ var (
 		failOnDeclarationWithNoResultPass,
 		processAndValidateParamsPass,
 		checkInvalidReferenceDeclarationsPass,
+		resolveFillSourcesPass,
 		resolveHolesPass,
 		resolveMissingHolesPass,
 		removeOptionalHolesPass,
 		resolveAliasPass,
 		inlineVariableValuePass,
 		resolveParamsAndExtractRefsPass,
+		maskSecretParamsPass,
 	}
 
 	PreRevertCompileMode = []compileFunc{
@@ -35,6 +38,7 @@ var (
 		failOnDeclarationWithNoResultPass,
 		processAndValidateParamsPass,
 		checkInvalidReferenceDeclarationsPass,
+		resolveFillSourcesPass,
 		resolveHolesPass,
 		resolveMissingHolesPass,
 		removeOptionalHolesPass,
@@ -44,6 +48,7 @@ var (
 		failOnUnresolvedAliasPass,
 		resolveParamsAndExtractRefsPass,
 		convertParamsPass,
+		maskSecretParamsPass,
 		validateCommandsPass,
 	}
 )
@@ -248,6 +253,28 @@ func validateCommandsPass(tpl *Template, cenv env.Compiling) (*Template, env.Com
 	return tpl, cenv, err
 }
 
+func maskSecretParamsPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
+	maskSecretParams(tpl, cenv.LookupCommandFunc())
+	return tpl, cenv, nil
+}
+
+// maskSecretParams masks the params that commands declare as secrets given their values
+func maskSecretParams(tpl *Template, lookupCommandFunc func(...string) interface{}) {
+	type SP interface {
+		SecretParams(map[string]interface{}) []string
+	}
+	if lookupCommandFunc == nil {
+		return
+	}
+	for _, node := range tpl.CommandNodesIterator() {
+		if cmd, ok := lookupCommandFunc(fmt.Sprintf("%s%s", node.Action, node.Entity)).(SP); ok {
+			for _, k := range cmd.SecretParams(node.ParamNodes) {
+				node.MaskParam(k)
+			}
+		}
+	}
+}
+
 func checkInvalidReferenceDeclarationsPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
 	return tpl, cenv, ast.VerifyRefs(tpl.AST)
 }
@@ -276,7 +303,46 @@ func inlineVariableValuePass(tpl *Template, cenv env.Compiling) (*Template, env.
 	return newTpl, cenv, nil
 }
 
+func resolveFillSourcesPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
+	if len(cenv.FillSources()) == 0 {
+		return tpl, cenv, nil
+	}
+
+	fillers := cenv.Get(env.FILLERS)
+	var holes []string
+	for hole := range ast.CollectUniqueHoles(tpl.AST) {
+		if _, done := fillers[hole.Hole()]; !done {
+			holes = append(holes, hole.Hole())
+		}
+	}
+	sort.Strings(holes)
+
+	for _, source := range cenv.FillSources() {
+		prefix := source.Prefix() + ":"
+		var prefixed []string
+		for _, hole := range holes {
+			if strings.HasPrefix(hole, prefix) {
+				prefixed = append(prefixed, hole)
+			}
+		}
+		if len(prefixed) == 0 {
+			continue
+		}
+		values, masked, err := source.Fill(prefixed)
+		if err != nil {
+			return tpl, cenv, fmt.Errorf("%s holes: %s", source.Prefix(), err)
+		}
+		cenv.Push(env.FILLERS, values)
+		for _, hole := range masked {
+			cenv.Push(env.MASKED_FILLERS, map[string]interface{}{hole: values[hole]})
+		}
+	}
+
+	return tpl, cenv, nil
+}
+
 func resolveHolesPass(tpl *Template, cenv env.Compiling) (*Template, env.Compiling, error) {
+	ast.MaskHoles(tpl.AST, cenv.Get(env.MASKED_FILLERS))
 	processed := ast.ProcessHoles(tpl.AST, cenv.Get(env.FILLERS))
 	cenv.Push(env.PROCESSED_FILLERS, processed)
 
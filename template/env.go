@@ -60,6 +60,7 @@ type compileEnv struct {
 	lookupCommandFunc func(...string) interface{}
 	aliasFunc         func(paramPath, alias string) string
 	missingHolesFunc  func(string, []string, bool) string
+	fillSources       []env.FillSource
 	log               *logger.Logger
 	paramsSuggested   int
 }
@@ -76,6 +77,10 @@ func (e *compileEnv) MissingHolesFunc() func(string, []string, bool) string {
 	return e.missingHolesFunc
 }
 
+func (e *compileEnv) FillSources() []env.FillSource {
+	return e.fillSources
+}
+
 func (e *compileEnv) ParamsMode() int {
 	return e.paramsSuggested
 }
@@ -89,6 +94,7 @@ type noopCompileEnv struct{}
 func (*noopCompileEnv) LookupCommandFunc() func(...string) interface{}        { return nil }
 func (*noopCompileEnv) AliasFunc() func(paramPath, alias string) string       { return nil }
 func (*noopCompileEnv) MissingHolesFunc() func(string, []string, bool) string { return nil }
+func (*noopCompileEnv) FillSources() []env.FillSource                         { return nil }
 func (*noopCompileEnv) ParamsMode() int                                       { return -1 }
 func (*noopCompileEnv) Log() *logger.Logger                                   { return logger.DiscardLogger }
 func (*noopCompileEnv) Push(int, ...map[string]interface{})                   {}
@@ -150,6 +156,11 @@ func (b *envBuilder) WithMissingHolesFunc(fn func(string, []string, bool) string
 	return b
 }
 
+func (b *envBuilder) WithFillSources(sources ...env.FillSource) *envBuilder {
+	b.E.fillSources = sources
+	return b
+}
+
 func (b *envBuilder) WithLookupCommandFunc(fn func(...string) interface{}) *envBuilder {
 	b.E.lookupCommandFunc = fn
 	return b
@@ -8,6 +8,7 @@ const (
 	FILLERS = iota
 	PROCESSED_FILLERS
 	RESOLVED_VARS
+	MASKED_FILLERS
 )
 
 const (
@@ -16,6 +17,13 @@ const (
 	ALL_PARAMS
 )
 
+// FillSource resolves at compile time the holes prefixed with its name (ex: {ssm:/golden/ami/latest}).
+// Values that must not appear in the logs are returned as masked holes.
+type FillSource interface {
+	Prefix() string
+	Fill(holes []string) (fillers map[string]interface{}, masked []string, err error)
+}
+
 type log interface {
 	Log() *logger.Logger
 }
@@ -32,6 +40,7 @@ type Compiling interface {
 	LookupCommandFunc() func(...string) interface{}
 	AliasFunc() func(paramPath, alias string) string
 	MissingHolesFunc() func(string, []string, bool) string
+	FillSources() []FillSource
 	ParamsMode() int
 	Push(int, ...map[string]interface{})
 	Get(int) map[string]interface{}
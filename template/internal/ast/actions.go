@@ -21,6 +21,8 @@ const (
 
 	Copy Action = "copy"
 
+	Accept Action = "accept"
+
 	Import       Action = "import"
 	Authenticate Action = "authenticate"
 )
@@ -37,6 +39,7 @@ var actions = map[Action]struct{}{
 	Attach:       {},
 	Detach:       {},
 	Copy:         {},
+	Accept:       {},
 	Import:       {},
 	Authenticate: {},
 }
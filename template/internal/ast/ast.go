@@ -71,10 +71,38 @@ func (c *CommandNode) Keys() (keys []string) {
 	return
 }
 
+// MaskedValue replaces the values of masked params
+const MaskedValue = "******"
+
 func (c *CommandNode) String() string {
+	return c.string(false)
+}
+
+// MaskedString is the command line with the values of masked params hidden
+func (c *CommandNode) MaskedString() string {
+	return c.string(true)
+}
+
+// MaskParam hides the value of the given param in MaskedString
+func (c *CommandNode) MaskParam(key string) {
+	if c.masked == nil {
+		c.masked = make(map[string]bool)
+	}
+	c.masked[key] = true
+}
+
+func (c *CommandNode) IsMaskedParam(key string) bool {
+	return c.masked[key]
+}
+
+func (c *CommandNode) string(mask bool) string {
 	var all []string
 
 	for k, v := range c.ParamNodes {
+		if mask && c.masked[k] {
+			all = append(all, fmt.Sprintf("%s=%s", k, MaskedValue))
+			continue
+		}
 		switch vv := v.(type) {
 		case string:
 			all = append(all, fmt.Sprintf("%s=%v", k, quoteStringIfNeeded(vv)))
@@ -94,6 +122,10 @@ func (c *CommandNode) String() string {
 		}
 	}
 	for k, v := range c.Refs {
+		if mask && c.masked[k] {
+			all = append(all, fmt.Sprintf("%s=%s", k, MaskedValue))
+			continue
+		}
 		all = append(all, fmt.Sprintf("%s=%v", k, v))
 	}
 
@@ -124,6 +156,9 @@ func (c *CommandNode) clone() Node {
 	for k, v := range c.Refs {
 		cmd.Refs[k] = v
 	}
+	for k := range c.masked {
+		cmd.MaskParam(k)
+	}
 	return cmd
 }
 
@@ -214,6 +249,26 @@ func (a *AST) String() string {
 	return strings.Join(all, "\n")
 }
 
+// MaskedString is the template source with the values of masked params hidden
+func (a *AST) MaskedString() string {
+	var all []string
+	for _, stat := range a.Statements {
+		switch n := stat.Node.(type) {
+		case *CommandNode:
+			all = append(all, n.MaskedString())
+		case *DeclarationNode:
+			if cmd, ok := n.Expr.(*CommandNode); ok {
+				all = append(all, fmt.Sprintf("%s = %s", n.Ident, cmd.MaskedString()))
+			} else {
+				all = append(all, n.String())
+			}
+		default:
+			all = append(all, stat.String())
+		}
+	}
+	return strings.Join(all, "\n")
+}
+
 func (n *DeclarationNode) clone() Node {
 	decl := &DeclarationNode{
 		Ident: n.Ident,
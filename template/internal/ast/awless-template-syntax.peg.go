@@ -42,6 +42,7 @@ const (
 	ruleAliasValue
 	ruleHoleValue
 	ruleHole
+	ruleHoleIdentifier
 	ruleHolesStringValue
 	ruleHoleWithSuffixValue
 	ruleComment
@@ -112,6 +113,7 @@ var rul3s = [...]string{
 	"AliasValue",
 	"HoleValue",
 	"Hole",
+	"HoleIdentifier",
 	"HolesStringValue",
 	"HoleWithSuffixValue",
 	"Comment",
@@ -266,7 +268,7 @@ type Peg struct {
 
 	Buffer string
 	buffer []rune
-	rules  [67]func() bool
+	rules  [68]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -2075,8 +2077,122 @@ func (p *Peg) Init() {
 					}
 					{
 						position236 := position
-						if !_rules[ruleIdentifier]() {
-							goto l233
+						{
+							position237 := position
+							{
+								switch buffer[position] {
+								case '/':
+									if buffer[position] != rune('/') {
+										goto l233
+									}
+									position++
+									break
+								case ':':
+									if buffer[position] != rune(':') {
+										goto l233
+									}
+									position++
+									break
+								case '.':
+									if buffer[position] != rune('.') {
+										goto l233
+									}
+									position++
+									break
+								case '_':
+									if buffer[position] != rune('_') {
+										goto l233
+									}
+									position++
+									break
+								case '-':
+									if buffer[position] != rune('-') {
+										goto l233
+									}
+									position++
+									break
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l233
+									}
+									position++
+									break
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									if c := buffer[position]; c < rune('A') || c > rune('Z') {
+										goto l233
+									}
+									position++
+									break
+								default:
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l233
+									}
+									position++
+									break
+								}
+							}
+
+						l238:
+							{
+								position239, tokenIndex239 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '/':
+										if buffer[position] != rune('/') {
+											goto l239
+										}
+										position++
+										break
+									case ':':
+										if buffer[position] != rune(':') {
+											goto l239
+										}
+										position++
+										break
+									case '.':
+										if buffer[position] != rune('.') {
+											goto l239
+										}
+										position++
+										break
+									case '_':
+										if buffer[position] != rune('_') {
+											goto l239
+										}
+										position++
+										break
+									case '-':
+										if buffer[position] != rune('-') {
+											goto l239
+										}
+										position++
+										break
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l239
+										}
+										position++
+										break
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										if c := buffer[position]; c < rune('A') || c > rune('Z') {
+											goto l239
+										}
+										position++
+										break
+									default:
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l239
+										}
+										position++
+										break
+									}
+								}
+
+								goto l238
+							l239:
+								position, tokenIndex = position239, tokenIndex239
+							}
+							add(ruleHoleIdentifier, position237)
 						}
 						add(rulePegText, position236)
 					}
@@ -2099,15 +2215,17 @@ func (p *Peg) Init() {
 			position, tokenIndex = position233, tokenIndex233
 			return false
 		},
-		/* 27 Hole <- <('{' WhiteSpacing <Identifier> WhiteSpacing '}')> */
+		/* 27 Hole <- <('{' WhiteSpacing <HoleIdentifier> WhiteSpacing '}')> */
+		nil,
+		/* 28 HoleIdentifier <- <((&('/') '/') | (&(':') ':') | (&('.') '.') | (&('_') '_') | (&('-') '-') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> */
 		nil,
-		/* 28 HolesStringValue <- <(Action21 <(UnquotedParamValue? HoleValue UnquotedParamValue?)+> Action22)> */
+		/* 29 HolesStringValue <- <(Action21 <(UnquotedParamValue? HoleValue UnquotedParamValue?)+> Action22)> */
 		nil,
-		/* 29 HoleWithSuffixValue <- <(Action23 <(HoleValue UnquotedParamValue+ (UnquotedParamValue? HoleValue UnquotedParamValue?)*)> Action24)> */
+		/* 30 HoleWithSuffixValue <- <(Action23 <(HoleValue UnquotedParamValue+ (UnquotedParamValue? HoleValue UnquotedParamValue?)*)> Action24)> */
 		nil,
-		/* 30 Comment <- <(('#' (!EndOfLine .)*) / ('/' '/' (!EndOfLine .)*))> */
+		/* 31 Comment <- <(('#' (!EndOfLine .)*) / ('/' '/' (!EndOfLine .)*))> */
 		nil,
-		/* 31 SingleQuote <- <'\''> */
+		/* 32 SingleQuote <- <'\''> */
 		func() bool {
 			position242, tokenIndex242 := position, tokenIndex
 			{
@@ -2123,7 +2241,7 @@ func (p *Peg) Init() {
 			position, tokenIndex = position242, tokenIndex242
 			return false
 		},
-		/* 32 DoubleQuote <- <'"'> */
+		/* 33 DoubleQuote <- <'"'> */
 		func() bool {
 			position244, tokenIndex244 := position, tokenIndex
 			{
@@ -2139,7 +2257,7 @@ func (p *Peg) Init() {
 			position, tokenIndex = position244, tokenIndex244
 			return false
 		},
-		/* 33 WhiteSpacing <- <Whitespace*> */
+		/* 34 WhiteSpacing <- <Whitespace*> */
 		func() bool {
 			{
 				position247 := position
@@ -2157,7 +2275,7 @@ func (p *Peg) Init() {
 			}
 			return true
 		},
-		/* 34 MustWhiteSpacing <- <Whitespace+> */
+		/* 35 MustWhiteSpacing <- <Whitespace+> */
 		func() bool {
 			position250, tokenIndex250 := position, tokenIndex
 			{
@@ -2182,7 +2300,7 @@ func (p *Peg) Init() {
 			position, tokenIndex = position250, tokenIndex250
 			return false
 		},
-		/* 35 Equal <- <(WhiteSpacing '=' WhiteSpacing)> */
+		/* 36 Equal <- <(WhiteSpacing '=' WhiteSpacing)> */
 		func() bool {
 			position254, tokenIndex254 := position, tokenIndex
 			{
@@ -2204,7 +2322,7 @@ func (p *Peg) Init() {
 			position, tokenIndex = position254, tokenIndex254
 			return false
 		},
-		/* 36 BlankLine <- <(WhiteSpacing EndOfLine)> */
+		/* 37 BlankLine <- <(WhiteSpacing EndOfLine)> */
 		func() bool {
 			position256, tokenIndex256 := position, tokenIndex
 			{
@@ -2222,7 +2340,7 @@ func (p *Peg) Init() {
 			position, tokenIndex = position256, tokenIndex256
 			return false
 		},
-		/* 37 Whitespace <- <(' ' / '\t')> */
+		/* 38 Whitespace <- <(' ' / '\t')> */
 		func() bool {
 			position258, tokenIndex258 := position, tokenIndex
 			{
@@ -2249,7 +2367,7 @@ func (p *Peg) Init() {
 			position, tokenIndex = position258, tokenIndex258
 			return false
 		},
-		/* 38 EndOfLine <- <(('\r' '\n') / '\n' / '\r')> */
+		/* 39 EndOfLine <- <(('\r' '\n') / '\n' / '\r')> */
 		func() bool {
 			position262, tokenIndex262 := position, tokenIndex
 			{
@@ -2287,7 +2405,7 @@ func (p *Peg) Init() {
 			position, tokenIndex = position262, tokenIndex262
 			return false
 		},
-		/* 39 EndOfFile <- <!.> */
+		/* 40 EndOfFile <- <!.> */
 		nil,
 		/* 41 Action0 <- <{ p.NewStatement() }> */
 		nil,
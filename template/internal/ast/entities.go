@@ -9,8 +9,11 @@ var entities = map[Entity]struct{}{
 	"alarm":               {},
 	"appscalingtarget":    {},
 	"appscalingpolicy":    {},
+	"backup":              {},
 	"scalinggroup":        {},
 	"bucket":              {},
+	"cachecluster":        {},
+	"cachesubnetgroup":    {},
 	"certificate":         {},
 	"classicloadbalancer": {},
 	"container":           {},
@@ -21,25 +24,32 @@ var entities = map[Entity]struct{}{
 	"distribution":        {},
 	"dbsubnetgroup":       {},
 	"elasticip":           {},
+	"filesystem":          {},
 	"function":            {},
 	"group":               {},
+	"healthcheck":         {},
 	"instance":            {},
 	"image":               {},
 	"internetgateway":     {},
 	"mfadevice":           {},
+	"mounttarget":         {},
 	"natgateway":          {},
 	"networkinterface":    {},
 	"instanceprofile":     {},
+	"key":                 {},
 	"keypair":             {},
 	"launchconfiguration": {},
 	"listener":            {},
 	"loadbalancer":        {},
 	"loginprofile":        {},
+	"parameter":           {},
 	"policy":              {},
 	"queue":               {},
 	"record":              {},
+	"replicationgroup":    {},
 	"registry":            {},
 	"repository":          {},
+	"restapi":             {},
 	"role":                {},
 	"route":               {},
 	"routetable":          {},
@@ -48,14 +58,19 @@ var entities = map[Entity]struct{}{
 	"securitygroup":       {},
 	"snapshot":            {},
 	"stack":               {},
+	"stage":               {},
+	"statemachine":        {},
 	"subnet":              {},
 	"subscription":        {},
+	"table":               {},
 	"tag":                 {},
 	"targetgroup":         {},
 	"topic":               {},
 	"user":                {},
 	"volume":              {},
 	"vpc":                 {},
+	"vpcendpoint":         {},
+	"vpcpeering":          {},
 	"zone":                {},
 }
 
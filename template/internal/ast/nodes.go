@@ -78,6 +78,9 @@ type CommandNode struct {
 	Action, Entity string
 	ParamNodes     map[string]interface{}
 	Refs           map[string]interface{}
+
+	// params whose values are hidden when logged (ex: secrets)
+	masked map[string]bool
 }
 
 type RefNode struct {
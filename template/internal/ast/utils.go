@@ -155,6 +155,69 @@ func ProcessHoles(tree Node, fillers map[string]interface{}) map[string]interfac
 	return processed
 }
 
+// MaskHoles masks the params of commands filled with the given holes,
+// directly or through the variables declared with them
+func MaskHoles(tree *AST, holes map[string]interface{}) {
+	if len(holes) == 0 {
+		return
+	}
+	maskedVars := make(map[string]bool)
+	isMasked := func(i interface{}) bool {
+		return containsMaskedHole(i, holes, maskedVars)
+	}
+	maskCmd := func(cmd *CommandNode) {
+		for k, v := range cmd.ParamNodes {
+			if isMasked(v) {
+				cmd.MaskParam(k)
+			}
+		}
+		for k, v := range cmd.Refs {
+			if isMasked(v) {
+				cmd.MaskParam(k)
+			}
+		}
+	}
+
+	for _, st := range tree.Statements {
+		switch n := st.Node.(type) {
+		case *CommandNode:
+			maskCmd(n)
+		case *DeclarationNode:
+			switch expr := n.Expr.(type) {
+			case *CommandNode:
+				maskCmd(expr)
+			case *RightExpressionNode:
+				if isMasked(expr.i) {
+					maskedVars[n.Ident] = true
+				}
+			}
+		}
+	}
+}
+
+func containsMaskedHole(i interface{}, holes map[string]interface{}, maskedVars map[string]bool) bool {
+	switch n := i.(type) {
+	case HoleNode:
+		_, ok := holes[n.key]
+		return ok
+	case RefNode:
+		return maskedVars[n.key]
+	case ListNode:
+		for _, e := range n.arr {
+			if containsMaskedHole(e, holes, maskedVars) {
+				return true
+			}
+		}
+	case ConcatenationNode:
+		for _, e := range n.arr {
+			if containsMaskedHole(e, holes, maskedVars) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func CollectAliases(tree Node) (aliases []AliasNode) {
 	v := newVisitor()
 	v.onAliases = func(parent interface{}, node AliasNode) {
@@ -48,6 +48,16 @@ func (t *TemplateExecution) SetMessage(s string) {
 	t.Message = out
 }
 
+// mask hides the values of the given fillers (ex: SSM SecureString parameters)
+// from the fillers persisted in the logs
+func (t *TemplateExecution) mask(fillers map[string]interface{}) {
+	for hole := range fillers {
+		if _, ok := t.Fillers[hole]; ok {
+			t.Fillers[hole] = ast.MaskedValue
+		}
+	}
+}
+
 func (t *TemplateExecution) MarshalJSON() ([]byte, error) {
 	out := &toJSON{}
 	out.ID = t.ID
@@ -65,7 +75,7 @@ func (t *TemplateExecution) MarshalJSON() ([]byte, error) {
 
 	for _, cmd := range t.CommandNodesIterator() {
 		newCmd := command{}
-		newCmd.Line = cmd.String()
+		newCmd.Line = cmd.MaskedString()
 		if cmd.CmdErr != nil {
 			newCmd.Errors = append(newCmd.Errors, cmd.CmdErr.Error())
 		}
@@ -308,6 +308,37 @@ func TestTemplateExecutionMarshalToJSON(t *testing.T) {
 	}
 }
 
+func TestTemplateExecutionMarshalMaskedFillersToJSON(t *testing.T) {
+	tplExec := TemplateExecution{
+		Template: MustParse("create database password=admin username=admin\ncreate instance image=ami-1234"),
+		Source:   "create database password={ssm:/db/password} username=admin\ncreate instance image={ssm:/golden/ami}",
+		Fillers:  map[string]interface{}{"ssm:/db/password": "admin", "ssm:/golden/ami": "ami-1234"},
+	}
+	for _, cmd := range tplExec.CommandNodesIterator() {
+		if cmd.Entity == "database" {
+			cmd.MaskParam("password")
+		}
+	}
+	tplExec.mask(map[string]interface{}{"ssm:/db/password": "admin"})
+
+	actual, err := tplExec.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"source": "create database password={ssm:/db/password} username=admin\ncreate instance image={ssm:/golden/ami}",
+	  "fillers": {"ssm:/db/password": "******", "ssm:/golden/ami": "ami-1234"},
+	  "locale": "",
+	  "id": "",
+	  "commands": [
+	    {"line": "create database password=****** username=admin"},
+	    {"line": "create instance image=ami-1234"}
+	  ]
+	}`
+	if got, want := identJSON(actual), identJSON([]byte(expected)); got != want {
+		t.Fatalf("\ngot\n\n%s\nwant\n\n%s\n", got, want)
+	}
+}
+
 func identJSON(content []byte) string {
 	var v interface{}
 	err := json.Unmarshal(content, &v)
@@ -70,6 +70,8 @@ func TestParseVariousTemplatesCorrectly(t *testing.T) {
 		{"support prefix/suffixes around holes (values)", "name = prefix-{instance.name}-{instance.version}-suffix", "name = 'prefix-'+{instance.name}+'-'+{instance.version}+'-suffix'"},
 		{"support prefix/suffixes around holes (params)", "instance = create instance name=prefix-{instance.name}-{instance.version}-suffix", "instance = create instance name='prefix-'+{instance.name}+'-'+{instance.version}+'-suffix'"},
 		{"support suffix after holes", "instance = create instance name={instance.name}-suffix", "instance = create instance name={instance.name}+'-suffix'"},
+		{"support holes of fill sources", "instance = create instance image={ssm:/golden/ami/latest}", ""},
+		{"support prefix around holes of fill sources", "create subnet cidr=prefix-{ ssm:/net/cidr }", "create subnet cidr='prefix-'+{ssm:/net/cidr}"},
 		{"retro-compatibility with old lists without []", "create loadbalancer subnets=subnet-1,subnet-2", "create loadbalancer subnets=[subnet-1,subnet-2]"},
 		{"support concatenation with '+' of quoted string and holes", "instance = create instance name='prefix-'+{instance.name}+{instance.version}+'-suffix'", ""},
 		{"support concatenation with '+' of quoted string and holes", "instance = create instance name='pre${}fix-' + {instance.name}+'middle-' +{instance.version}+ '-suffix'", "instance = create instance name='pre${}fix-'+{instance.name}+'middle-'+{instance.version}+'-suffix'"},
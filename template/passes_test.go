@@ -371,6 +371,100 @@ func TestResolveHolesPass(t *testing.T) {
 	assertCmdParams(t, tpl, map[string]interface{}{"type": "t2.micro", "count": 3})
 }
 
+type mockFillSource struct {
+	values map[string]interface{}
+	masked []string
+	filled []string
+}
+
+func (m *mockFillSource) Prefix() string { return "ssm" }
+
+func (m *mockFillSource) Fill(holes []string) (map[string]interface{}, []string, error) {
+	m.filled = append(m.filled, holes...)
+	out := make(map[string]interface{})
+	for _, hole := range holes {
+		v, ok := m.values[hole]
+		if !ok {
+			return nil, nil, errors.New("unknown " + hole)
+		}
+		out[hole] = v
+	}
+	return out, m.masked, nil
+}
+
+func TestResolveFillSourcesPass(t *testing.T) {
+	tpl := MustParse("create instance image={ssm:/golden/ami} type={instance.type} subnet={ssm:/net/subnet}\ncreate database password={ssm:/db/password}")
+
+	source := &mockFillSource{
+		values: map[string]interface{}{"ssm:/golden/ami": "ami-1234", "ssm:/net/subnet": "sub-1234", "ssm:/db/password": "secret"},
+		masked: []string{"ssm:/db/password"},
+	}
+	cenv := NewEnv().WithFillSources(source).Build()
+	cenv.Push(env.FILLERS, map[string]interface{}{"instance.type": "t2.micro", "ssm:/net/subnet": "sub-9876"})
+
+	pass := newMultiPass(resolveFillSourcesPass, resolveHolesPass)
+
+	tpl, _, err := pass.compile(tpl, cenv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := source.filled, []string{"ssm:/db/password", "ssm:/golden/ami"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	assertCmdParams(t, tpl,
+		map[string]interface{}{"image": "ami-1234", "type": "t2.micro", "subnet": "sub-9876"},
+		map[string]interface{}{"password": "secret"},
+	)
+	if got, want := cenv.Get(env.MASKED_FILLERS), map[string]interface{}{"ssm:/db/password": "secret"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	_, _, err = resolveFillSourcesPass(MustParse("create instance image={ssm:/unknown}"), NewEnv().WithFillSources(source).Build())
+	if err == nil || !strings.Contains(err.Error(), "unknown ssm:/unknown") {
+		t.Fatalf("expected unknown hole error, got %v", err)
+	}
+}
+
+func TestMaskSecretParams(t *testing.T) {
+	t.Run("filled with masked holes", func(t *testing.T) {
+		tpl := MustParse("pass = {ssm:/db/password}\ncreate database password=$pass username={ssm:/db/user}\ncreate user name=jdoe password={ssm:/db/password}")
+		source := &mockFillSource{
+			values: map[string]interface{}{"ssm:/db/password": "admin", "ssm:/db/user": "admin"},
+			masked: []string{"ssm:/db/password"},
+		}
+		cenv := NewEnv().WithFillSources(source).Build()
+
+		pass := newMultiPass(resolveFillSourcesPass, resolveHolesPass, inlineVariableValuePass, resolveParamsAndExtractRefsPass)
+		tpl, _, err := pass.compile(tpl, cenv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := tpl.MaskedString(), "create database password=****** username=admin\ncreate user name=jdoe password=******"; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+		if got, want := tpl.String(), "create database password=admin username=admin\ncreate user name=jdoe password=admin"; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("declared by commands", func(t *testing.T) {
+		tpl := MustParse("create parameter name=pass type=securestring value=s3cr3t\ncreate parameter name=ami type=String value=ami-1234\nupdate parameter name=pass value=n3ws3cr3t")
+		cenv := NewEnv().WithLookupCommandFunc(func(tokens ...string) interface{} {
+			return awsspec.MockAWSSessionFactory.Build(strings.Join(tokens, ""))()
+		}).Build()
+
+		pass := newMultiPass(resolveParamsAndExtractRefsPass, maskSecretParamsPass)
+		tpl, _, err := pass.compile(tpl, cenv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := "create parameter name=pass type=securestring value=******\ncreate parameter name=ami type=String value=ami-1234\nupdate parameter name=pass value=******"
+		if got, want := tpl.MaskedString(), expected; got != want {
+			t.Fatalf("got\n%s\nwant\n%s", got, want)
+		}
+	})
+}
+
 func TestInlineVariableWithValue(t *testing.T) {
 	env := NewEnv().Build()
 	tcases := []struct {
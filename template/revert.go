@@ -106,7 +106,7 @@ func (temp *Template) Revert() (*Template, error) {
 					}
 				case "route":
 					for k, v := range cmd.ParamNodes {
-						if k == "gateway" {
+						if k == "gateway" || k == "peering" {
 							continue
 						}
 						params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
@@ -114,7 +114,7 @@ func (temp *Template) Revert() (*Template, error) {
 				case "database":
 					params = append(params, fmt.Sprintf("id=%s", quoteParamIfNeeded(cmd.CmdResult)))
 					params = append(params, "skip-snapshot=true")
-				case "certificate":
+				case "certificate", "backup":
 					params = append(params, fmt.Sprintf("arn=%s", quoteParamIfNeeded(cmd.CmdResult)))
 				case "policy":
 					params = append(params, fmt.Sprintf("arn=%s", quoteParamIfNeeded(cmd.CmdResult)))
@@ -124,6 +124,9 @@ func (temp *Template) Revert() (*Template, error) {
 				case "s3object":
 					params = append(params, fmt.Sprintf("name=%s", quoteParamIfNeeded(cmd.CmdResult)))
 					params = append(params, fmt.Sprintf("bucket=%s", printItem(cmd.ParamNodes["bucket"])))
+				case "stage":
+					params = append(params, fmt.Sprintf("name=%s", quoteParamIfNeeded(cmd.CmdResult)))
+					params = append(params, fmt.Sprintf("restapi=%s", printItem(cmd.ParamNodes["restapi"])))
 				case "role", "group", "user", "stack", "instanceprofile", "repository", "classicloadbalancer":
 					params = append(params, fmt.Sprintf("name=%s", printItem(cmd.ParamNodes["name"])))
 				case "accesskey":
@@ -140,7 +143,7 @@ func (temp *Template) Revert() (*Template, error) {
 					params = append(params, fmt.Sprintf("service-namespace=%s", printItem(cmd.ParamNodes["service-namespace"])))
 				case "loginprofile":
 					params = append(params, fmt.Sprintf("username=%s", printItem(cmd.ParamNodes["username"])))
-				case "bucket", "launchconfiguration", "scalinggroup", "alarm", "dbsubnetgroup", "keypair":
+				case "bucket", "launchconfiguration", "scalinggroup", "alarm", "dbsubnetgroup", "keypair", "table", "parameter":
 					params = append(params, fmt.Sprintf("name=%s", quoteParamIfNeeded(cmd.CmdResult)))
 					if cmd.Entity == "scalinggroup" {
 						params = append(params, "force=true")
@@ -179,6 +182,15 @@ func (temp *Template) Revert() (*Template, error) {
 						}
 						params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
 					}
+				case "record":
+					if previous := fmt.Sprint(cmd.CmdResult); previous != "" {
+						params = append(params, previous)
+					} else {
+						revertAction = "delete"
+						for k, v := range cmd.ParamNodes {
+							params = append(params, fmt.Sprintf("%s=%v", k, printItem(v)))
+						}
+					}
 				}
 			}
 
@@ -221,6 +233,16 @@ func (temp *Template) Revert() (*Template, error) {
 			lines = append(lines, fmt.Sprintf("%s %s %s", revertAction, cmd.Entity, strings.Join(params, " ")))
 
 			// Postchecks
+			if cmd.Action == "create" && (cmd.Entity == "cachecluster" || cmd.Entity == "replicationgroup") {
+				// the subnet group derived from the subnets is named after the resource and deleted once the resource is gone
+				_, derivedSubnetGroup := cmd.ParamNodes["subnets"]
+				if notLastCommand || derivedSubnetGroup {
+					lines = append(lines, fmt.Sprintf("check %s id=%s state=not-found timeout=900", cmd.Entity, quoteParamIfNeeded(cmd.CmdResult)))
+				}
+				if derivedSubnetGroup {
+					lines = append(lines, fmt.Sprintf("delete cachesubnetgroup name=%s", quoteParamIfNeeded(cmd.CmdResult)))
+				}
+			}
 			if notLastCommand {
 				if cmd.Action == "create" && cmd.Entity == "instance" {
 					lines = append(lines, fmt.Sprintf("check instance id=%s state=terminated timeout=180", quoteParamIfNeeded(cmd.CmdResult)))
@@ -228,6 +250,9 @@ func (temp *Template) Revert() (*Template, error) {
 				if cmd.Action == "create" && cmd.Entity == "database" {
 					lines = append(lines, fmt.Sprintf("check database id=%s state=not-found timeout=900", quoteParamIfNeeded(cmd.CmdResult)))
 				}
+				if cmd.Action == "create" && cmd.Entity == "mounttarget" {
+					lines = append(lines, fmt.Sprintf("check mounttarget id=%s state=not-found timeout=180", quoteParamIfNeeded(cmd.CmdResult)))
+				}
 				if cmd.Action == "create" && cmd.Entity == "loadbalancer" {
 					lines = append(lines, fmt.Sprintf("check loadbalancer id=%s state=not-found timeout=180", quoteParamIfNeeded(cmd.CmdResult)))
 				}
@@ -277,6 +302,19 @@ func isRevertible(cmd *ast.CommandNode) bool {
 		return true
 	}
 
+	if cmd.Entity == "record" && cmd.Action == "update" {
+		// the result holds the params of the record before the update, or is empty when it did not exist
+		v, ok := cmd.CmdResult.(string)
+		if !ok || v == "" {
+			return ok
+		}
+		if !strings.Contains(v, "=") {
+			return false
+		}
+		_, err := ParseParams(v)
+		return err == nil
+	}
+
 	if cmd.Entity == "instanceprofile" && (cmd.Action == "create" || cmd.Action == "delete") {
 		return true
 	}
@@ -237,6 +237,19 @@ attach volume device=/dev/sdh id=vol-12345 instance=i-12345`
 		}
 	})
 
+	t.Run("Revert create route to peering", func(t *testing.T) {
+		tpl := MustParse("create route cidr=10.1.0.0/16 peering=pcx-12345 table=rtb-12345")
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete route cidr=10.1.0.0/16 table=rtb-12345`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
 	t.Run("Revert attach instance", func(t *testing.T) {
 		tpl := MustParse("attach instance id=i-123456 port=80 targetgroup=mytargetgrouparn")
 		reverted, err := tpl.Revert()
@@ -329,6 +342,38 @@ delete scalinggroup force=true name=my-scalinggroup`
 		}
 	})
 
+	t.Run("Revert update record", func(t *testing.T) {
+		tpl := MustParse("update record name=www.awlesstest.io ttl=60 type=A value=2.3.4.5 zone=/hostedzone/Z29L20HGD4CX07 set-identifier=blue weight=0")
+		for _, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = "healthcheck=hc-1234 name=www.awlesstest.io set-identifier=blue ttl=300 type=A values=[1.2.3.4,1.2.3.5] weight=100 zone=/hostedzone/Z29L20HGD4CX07"
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `update record healthcheck=hc-1234 name=www.awlesstest.io set-identifier=blue ttl=300 type=A values=[1.2.3.4,1.2.3.5] weight=100 zone=/hostedzone/Z29L20HGD4CX07`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Revert update record that did not exist", func(t *testing.T) {
+		tpl := MustParse("update record name=www.awlesstest.io ttl=60 type=A value=2.3.4.5 zone=/hostedzone/Z29L20HGD4CX07 set-identifier=green weight=100")
+		for _, cmd := range tpl.CommandNodesIterator() {
+			cmd.CmdResult = ""
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete record name=www.awlesstest.io set-identifier=green ttl=60 type=A value=2.3.4.5 weight=100 zone=/hostedzone/Z29L20HGD4CX07`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
 	t.Run("Revert create database", func(t *testing.T) {
 		tpl := MustParse("dbsubgroup = create dbsubnetgroup\ncreate database subnetgroup=$dbsubgroup")
 		for i, cmd := range tpl.CommandNodesIterator() {
@@ -352,6 +397,101 @@ delete dbsubnetgroup name=my-dbsubgroup`
 		}
 	})
 
+	t.Run("Revert create filesystem with mounttarget", func(t *testing.T) {
+		tpl := MustParse("fs = create filesystem name=shared\ncreate mounttarget filesystem=$fs subnet=sub-1234")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i == 0 {
+				cmd.CmdResult = "fs-1234"
+			}
+			if i == 1 {
+				cmd.CmdResult = "fsmt-1234"
+			}
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete mounttarget id=fsmt-1234
+check mounttarget id=fsmt-1234 state=not-found timeout=180
+delete filesystem id=fs-1234`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Revert create restapi with stage", func(t *testing.T) {
+		tpl := MustParse("create restapi name=shop\ncreate stage restapi=api-1234 name=prod")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i == 0 {
+				cmd.CmdResult = "api-1234"
+			}
+			if i == 1 {
+				cmd.CmdResult = "prod"
+			}
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete stage name=prod restapi=api-1234
+delete restapi id=api-1234`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Revert create cachecluster", func(t *testing.T) {
+		tpl := MustParse("sg = create securitygroup vpc=vpc-1234 description=cache name=cache-sg\ncreate cachecluster id=my-cache engine=redis type=cache.t2.micro securitygroups=$sg subnets=[sub-1234,sub-2345]")
+		for i, cmd := range tpl.CommandNodesIterator() {
+			if i == 0 {
+				cmd.CmdResult = "sg-1234"
+			}
+			if i == 1 {
+				cmd.CmdResult = "my-cache"
+			}
+		}
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete cachecluster id=my-cache
+check cachecluster id=my-cache state=not-found timeout=900
+delete cachesubnetgroup name=my-cache
+check securitygroup id=sg-1234 state=unused timeout=300
+delete securitygroup id=sg-1234`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
+	t.Run("Revert create replicationgroup deletes its derived subnet group", func(t *testing.T) {
+		tpl := MustParse("create replicationgroup id=my-redis description=sessions engine=redis type=cache.t2.micro subnets=[sub-1234,sub-2345]")
+		tpl.CommandNodesIterator()[0].CmdResult = "my-redis"
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := `delete replicationgroup id=my-redis
+check replicationgroup id=my-redis state=not-found timeout=900
+delete cachesubnetgroup name=my-redis`
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+
+		tpl = MustParse("create replicationgroup id=my-redis description=sessions engine=redis type=cache.t2.micro subnetgroup=my-subnets")
+		tpl.CommandNodesIterator()[0].CmdResult = "my-redis"
+		if reverted, err = tpl.Revert(); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := reverted.String(), "delete replicationgroup id=my-redis"; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
 	t.Run("Revert start containertask type=service", func(t *testing.T) {
 		tpl := MustParse("start containertask cluster=cl desired-count=2 name=taskname deployment-name=dpname type=service")
 		reverted, err := tpl.Revert()
@@ -394,6 +534,21 @@ stop containertask cluster=cl deployment-name=dpname type=service`
 		}
 	})
 
+	t.Run("Revert create table and backup", func(t *testing.T) {
+		tpl := MustParse("create table name=mytable hash-key=id:S read-capacity=5 write-capacity=5\ncreate backup table=mytable name=mybackup")
+		tpl.CommandNodesIterator()[0].CmdResult = "mytable"
+		tpl.CommandNodesIterator()[1].CmdResult = "my-backup-arn"
+		reverted, err := tpl.Revert()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exp := "delete backup arn=my-backup-arn\ndelete table name=mytable"
+		if got, want := reverted.String(), exp; got != want {
+			t.Fatalf("got: %s\nwant: %s\n", got, want)
+		}
+	})
+
 	t.Run("Revert create policy", func(t *testing.T) {
 		tpl := MustParse("create policy name=mypolicy")
 		tpl.CommandNodesIterator()[0].CmdResult = "my-policy-arn"
@@ -427,6 +582,10 @@ func TestCmdNodeIsRevertible(t *testing.T) {
 		{line: "detach policy", revertible: true},
 		{line: "create record", revertible: true},
 		{line: "delete record", revertible: true},
+		{line: "update record", revertible: true},
+		{line: "update record", result: "name=my.domain.com ttl=60 type=A values=[1.2.3.4] zone=/hostedzone/1234", revertible: true},
+		{line: "update record", result: "/change/C2682N5HXP0BZ4", revertible: false},
+		{line: "update record", result: `name=my.domain.com ttl=60 type=TXT values=['"it's"'] zone=/hostedzone/1234`, revertible: false},
 		{line: "copy image", result: "any", revertible: true},
 		{line: "detach routetable", revertible: false},
 		{line: "start alarm", revertible: true},
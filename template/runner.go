@@ -16,6 +16,7 @@ type Runner struct {
 	Fillers                                []map[string]interface{}
 	AliasFunc                              func(paramPath, alias string) string
 	MissingHolesFunc                       func(string, []string, bool) string
+	FillSources                            []env.FillSource
 	CmdLookuper                            func(tokens ...string) interface{}
 	Validators                             []Validator
 	ParamsSuggested                        int
@@ -30,11 +31,12 @@ func (ru *Runner) Run() error {
 		Path:     ru.TemplatePath,
 		Locale:   ru.Locale,
 		Profile:  ru.Profile,
-		Source:   ru.Template.String(),
 	}
+	maskSecretParams(ru.Template, ru.CmdLookuper)
+	tplExec.Source = ru.Template.MaskedString()
 	tplExec.SetMessage(ru.Message)
 
-	cenv := NewEnv().WithAliasFunc(ru.AliasFunc).WithMissingHolesFunc(ru.MissingHolesFunc).
+	cenv := NewEnv().WithAliasFunc(ru.AliasFunc).WithMissingHolesFunc(ru.MissingHolesFunc).WithFillSources(ru.FillSources...).
 		WithLookupCommandFunc(ru.CmdLookuper).WithLog(ru.Log).WithParamsMode(ru.ParamsSuggested).Build()
 	cenv.Push(env.FILLERS, ru.Fillers...)
 
@@ -45,6 +47,7 @@ func (ru *Runner) Run() error {
 	}
 
 	tplExec.Fillers = cenv.Get(env.PROCESSED_FILLERS)
+	tplExec.mask(cenv.Get(env.MASKED_FILLERS))
 
 	errs := tplExec.Template.Validate(ru.Validators...)
 	if len(errs) > 0 {
@@ -74,6 +77,8 @@ func (ru *Runner) Run() error {
 		return errors.New("Dry run failed")
 	}
 
+	tplExec.Template.ID = NewID()
+
 	ok, err := ru.BeforeRun(tplExec)
 	if err != nil {
 		return err
@@ -0,0 +1,33 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/wallix/awless/logger"
+)
+
+func TestRunnerKeepsTemplateIDFromBeforeRunToAfterRun(t *testing.T) {
+	var beforeRunID, afterRunID string
+	runner := &Runner{
+		Template:    MustParse("create instance"),
+		Log:         logger.DiscardLogger,
+		CmdLookuper: func(...string) interface{} { return &mockCommand{} },
+		BeforeRun: func(tplExec *TemplateExecution) (bool, error) {
+			beforeRunID = tplExec.Template.ID
+			return true, nil
+		},
+		AfterRun: func(tplExec *TemplateExecution) error {
+			afterRunID = tplExec.Template.ID
+			return nil
+		},
+	}
+	if err := runner.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if beforeRunID == "" {
+		t.Fatal("expected template ID to be set before run")
+	}
+	if got, want := afterRunID, beforeRunID; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
@@ -33,6 +33,11 @@ type Template struct {
 	*ast.AST
 }
 
+// NewID returns a new unique template identifier, sortable by creation date
+func NewID() string {
+	return ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String()
+}
+
 func (s *Template) DryRun(renv env.Running) (tpl *Template, err error) {
 	renv.SetDryRun(true)
 	defer renv.SetDryRun(false)
@@ -59,8 +64,10 @@ func (s *Template) DryRun(renv env.Running) (tpl *Template, err error) {
 func (s *Template) Run(renv env.Running) (*Template, error) {
 	vars := map[string]interface{}{}
 
-	current := &Template{AST: &ast.AST{}}
-	current.ID = ulid.MustNew(ulid.Timestamp(time.Now()), rand.Reader).String()
+	current := &Template{ID: s.ID, AST: &ast.AST{}}
+	if current.ID == "" {
+		current.ID = NewID()
+	}
 
 	for _, sts := range s.Statements {
 		clone := sts.Clone()